@@ -3,6 +3,7 @@ module github.com/mongodb/terraform-provider-mongodbatlas
 go 1.20
 
 require (
+	github.com/BurntSushi/toml v1.3.2
 	github.com/aws/aws-sdk-go v1.45.27
 	github.com/go-test/deep v1.1.0
 	github.com/gruntwork-io/terratest v0.46.0
@@ -25,6 +26,8 @@ require (
 	go.mongodb.org/atlas-sdk/v20231001001 v20231001001.1.0
 	go.mongodb.org/realm v0.1.0
 	golang.org/x/exp v0.0.0-20230809150735-7b3493d9a819
+	golang.org/x/oauth2 v0.7.0
+	golang.org/x/sync v0.1.0
 )
 
 require github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
@@ -126,8 +129,6 @@ require (
 	golang.org/x/crypto v0.14.0 // indirect
 	golang.org/x/mod v0.12.0 // indirect
 	golang.org/x/net v0.17.0 // indirect
-	golang.org/x/oauth2 v0.7.0 // indirect
-	golang.org/x/sync v0.1.0 // indirect
 	golang.org/x/sys v0.13.0 // indirect
 	golang.org/x/term v0.13.0 // indirect
 	golang.org/x/text v0.13.0 // indirect
@@ -1,19 +1,59 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas"
 )
 
 func main() {
-	var debugMode bool
+	var (
+		debugMode        bool
+		migrateCluster   string
+		generateConfig   string
+		orgID            string
+		projectID        string
+		searchNamespaces string
+		profile          string
+		configFile       string
+	)
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&migrateCluster, "migrate-cluster", "", "`path` to the output of terraform show -json, or - for stdin, "+
+		"to print the mongodbatlas_advanced_cluster configuration equivalent to each mongodbatlas_cluster instead of running the provider")
+	flag.StringVar(&generateConfig, "generate-config", "", "`directory` to write the configuration and import blocks of the Atlas project "+
+		"or organization to, with the credentials of the provider environment variables, instead of running the provider")
+	flag.StringVar(&orgID, "org-id", "", "organization to generate the configuration of, all its projects are included")
+	flag.StringVar(&projectID, "project-id", "", "project to generate the configuration of")
+	flag.StringVar(&profile, "profile", "", "Atlas CLI profile to read the credentials, the base URL and the default -org-id of the configuration generator from")
+	flag.StringVar(&configFile, "config-file", "", "`path` of the Atlas CLI configuration file to read -profile from, defaults to the location used by the Atlas CLI")
+	flag.StringVar(&searchNamespaces, "search-namespaces", "", "comma-separated `database.collection` namespaces to include the search indexes of")
 	flag.Parse()
 
+	if generateConfig != "" {
+		opts := &mongodbatlas.GenerateConfigOptions{OrgID: orgID, ProjectID: projectID}
+		if searchNamespaces != "" {
+			opts.SearchNamespaces = strings.Split(searchNamespaces, ",")
+		}
+		if err := runGenerateConfig(generateConfig, profile, configFile, opts); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if migrateCluster != "" {
+		if err := runMigrateCluster(migrateCluster); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	var serveOpts []tf6server.ServeOpt
 
 	if debugMode {
@@ -28,3 +68,53 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+func runMigrateCluster(path string) error {
+	in := os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	return mongodbatlas.MigrateClusterState(in, os.Stdout)
+}
+
+func runGenerateConfig(dir, profile, configFile string, opts *mongodbatlas.GenerateConfigOptions) error {
+	config := mongodbatlas.Config{
+		BaseURL:      mongodbatlas.MultiEnvDefaultFunc([]string{"MONGODB_ATLAS_BASE_URL", "MCLI_OPS_MANAGER_URL"}, "").(string),
+		PublicKey:    mongodbatlas.MultiEnvDefaultFunc([]string{"MONGODB_ATLAS_PUBLIC_KEY", "MCLI_PUBLIC_API_KEY"}, "").(string),
+		PrivateKey:   mongodbatlas.MultiEnvDefaultFunc([]string{"MONGODB_ATLAS_PRIVATE_KEY", "MCLI_PRIVATE_API_KEY"}, "").(string),
+		ClientID:     os.Getenv("MONGODB_ATLAS_CLIENT_ID"),
+		ClientSecret: os.Getenv("MONGODB_ATLAS_CLIENT_SECRET"),
+	}
+	if profile != "" || configFile != "" {
+		cliProfile, err := mongodbatlas.LoadAtlasCLIProfile(configFile, profile)
+		if err != nil {
+			return err
+		}
+		config.ApplyAtlasCLIProfile(cliProfile)
+		opts.ApplyAtlasCLIProfile(cliProfile)
+	}
+	ctx := context.Background()
+	client, err := config.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	files, err := mongodbatlas.GenerateConfig(ctx, client.(*mongodbatlas.MongoDBClient), opts)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
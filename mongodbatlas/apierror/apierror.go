@@ -0,0 +1,176 @@
+// Package apierror classifies the errors returned by the MongoDB Atlas clients used in the provider so resources
+// don't need to inspect error messages to decide whether to retry, remove a resource from the state or fail.
+package apierror
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/realm/realm"
+)
+
+// Atlas error codes used by the provider to take decisions, see https://www.mongodb.com/docs/atlas/reference/api-errors/
+const (
+	CodeUnexpectedError           = "UNEXPECTED_ERROR"
+	CodeResourceNotFound          = "RESOURCE_NOT_FOUND"
+	CodeGroupNotFound             = "GROUP_NOT_FOUND"
+	CodeClusterNotFound           = "CLUSTER_NOT_FOUND"
+	CodeBackupConfigNotFound      = "BACKUP_CONFIG_NOT_FOUND"
+	CodeCustomRoleNotFound        = "ATLAS_CUSTOM_ROLE_NOT_FOUND"
+	CodeSearchDeploymentNotFound  = "ATLAS_SEARCH_DEPLOYMENT_DOES_NOT_EXIST"
+	CodeCannotUpdatePausedCluster = "CANNOT_UPDATE_PAUSED_CLUSTER"
+	CodeCannotAssumeRole          = "CANNOT_ASSUME_ROLE"
+	CodeDuplicateManagedNamespace = "DUPLICATE_MANAGED_NAMESPACE"
+	CodeUserUnauthorized          = "USER_UNAUTHORIZED"
+)
+
+// Error is the normalized representation of an Atlas API error regardless of the client that returned it.
+type Error struct {
+	ErrorCode  string
+	Detail     string
+	StatusCode int
+}
+
+// From extracts the HTTP status and the Atlas error code from errors returned by the legacy client (matlas.ErrorResponse),
+// the versioned SDK (admin.GenericOpenAPIError) and the App Services client (realm.ErrorResponse).
+// It returns false if err was not returned by the Atlas API, e.g. network or validation errors.
+func From(err error) (*Error, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var legacyErr *matlas.ErrorResponse
+	if errors.As(err, &legacyErr) {
+		statusCode := legacyErr.HTTPCode
+		if legacyErr.Response != nil {
+			statusCode = legacyErr.Response.StatusCode
+		}
+		return &Error{StatusCode: statusCode, ErrorCode: legacyErr.ErrorCode, Detail: legacyErr.Detail}, true
+	}
+
+	var sdkErr *admin.GenericOpenAPIError
+	if errors.As(err, &sdkErr) {
+		model := sdkErr.Model()
+		statusCode := model.GetError()
+		if statusCode == 0 {
+			// the error body could not be decoded, the error message is then the HTTP status, e.g. "404 Not Found"
+			statusCode = parseStatus(sdkErr.Error())
+		}
+		if statusCode == 0 && model.GetErrorCode() == "" {
+			return nil, false
+		}
+		return &Error{StatusCode: statusCode, ErrorCode: model.GetErrorCode(), Detail: model.GetDetail()}, true
+	}
+
+	var realmErr *realm.ErrorResponse
+	if errors.As(err, &realmErr) {
+		statusCode := 0
+		if realmErr.Response != nil {
+			statusCode = realmErr.Response.StatusCode
+		}
+		return &Error{StatusCode: statusCode, ErrorCode: realmErr.ErrorCode, Detail: realmErr.Detail}, true
+	}
+
+	return nil, false
+}
+
+// StatusCode returns the HTTP status code of an Atlas API error or 0 if it's not available.
+func StatusCode(err error) int {
+	if apiErr, ok := From(err); ok {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// ErrorCode returns the Atlas error code of an Atlas API error or an empty string if it's not available.
+func ErrorCode(err error) string {
+	if apiErr, ok := From(err); ok {
+		return apiErr.ErrorCode
+	}
+	return ""
+}
+
+// HasStatus returns true if err is an Atlas API error with any of the given HTTP status codes.
+func HasStatus(err error, statusCodes ...int) bool {
+	statusCode := StatusCode(err)
+	for _, code := range statusCodes {
+		if statusCode != 0 && statusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrorCode returns true if err is an Atlas API error with any of the given Atlas error codes.
+func HasErrorCode(err error, errorCodes ...string) bool {
+	errorCode := ErrorCode(err)
+	for _, code := range errorCodes {
+		if errorCode != "" && errorCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound returns true if the resource doesn't exist in Atlas, typically because it was deleted outside Terraform.
+func IsNotFound(err error) bool {
+	return HasStatus(err, http.StatusNotFound) ||
+		HasErrorCode(err, CodeResourceNotFound, CodeGroupNotFound, CodeClusterNotFound, CodeBackupConfigNotFound, CodeCustomRoleNotFound,
+			CodeSearchDeploymentNotFound)
+}
+
+// IsConflict returns true if the request conflicts with the current state of the resource, e.g. a concurrent modification.
+func IsConflict(err error) bool {
+	return HasStatus(err, http.StatusConflict)
+}
+
+// IsClusterPaused returns true if the request was rejected because the cluster is paused.
+func IsClusterPaused(err error) bool {
+	return HasErrorCode(err, CodeCannotUpdatePausedCluster)
+}
+
+// IsRetryable returns true if the request failed because of a transient condition and can be attempted again:
+// rate limiting, Atlas internal and availability errors or a dropped connection.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if apiErr, ok := From(err); ok {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+			http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return apiErr.ErrorCode == CodeUnexpectedError
+	}
+
+	return IsTransientNetworkError(err)
+}
+
+// IsTransientNetworkError returns true for connection errors that are expected to succeed if the request is repeated.
+func IsTransientNetworkError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func parseStatus(message string) int {
+	code, _, _ := strings.Cut(message, " ")
+	status, err := strconv.Atoi(code)
+	if err != nil || status < 100 || status > 599 {
+		return 0
+	}
+	return status
+}
@@ -0,0 +1,148 @@
+package apierror_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+	"go.mongodb.org/realm/realm"
+)
+
+func legacyError(statusCode int, errorCode string) error {
+	return &matlas.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: statusCode,
+			Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{}},
+		},
+		HTTPCode:  statusCode,
+		ErrorCode: errorCode,
+	}
+}
+
+func sdkError(statusCode int, errorCode string) error {
+	err := &admin.GenericOpenAPIError{}
+	err.SetError(fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)))
+	err.SetModel(admin.ApiError{Error: &statusCode, ErrorCode: &errorCode})
+	return err
+}
+
+func realmError(statusCode int, errorCode string) error {
+	return &realm.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: statusCode,
+			Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{}},
+		},
+		ErrorCode: errorCode,
+	}
+}
+
+func TestFrom(t *testing.T) {
+	undecodedSdkErr := &admin.GenericOpenAPIError{}
+	undecodedSdkErr.SetError("503 Service Unavailable")
+
+	tests := []struct {
+		err      error
+		expected *apierror.Error
+		name     string
+	}{
+		{
+			name:     "legacy client",
+			err:      legacyError(http.StatusNotFound, "GROUP_NOT_FOUND"),
+			expected: &apierror.Error{StatusCode: http.StatusNotFound, ErrorCode: "GROUP_NOT_FOUND"},
+		},
+		{
+			name:     "versioned SDK",
+			err:      sdkError(http.StatusConflict, "CANNOT_UPDATE_PAUSED_CLUSTER"),
+			expected: &apierror.Error{StatusCode: http.StatusConflict, ErrorCode: "CANNOT_UPDATE_PAUSED_CLUSTER"},
+		},
+		{
+			name:     "versioned SDK without decoded body",
+			err:      undecodedSdkErr,
+			expected: &apierror.Error{StatusCode: http.StatusServiceUnavailable},
+		},
+		{
+			name:     "realm client",
+			err:      realmError(http.StatusNotFound, "AppNotFound"),
+			expected: &apierror.Error{StatusCode: http.StatusNotFound, ErrorCode: "AppNotFound"},
+		},
+		{
+			name:     "wrapped error",
+			err:      fmt.Errorf("error getting cluster: %w", legacyError(http.StatusInternalServerError, "UNEXPECTED_ERROR")),
+			expected: &apierror.Error{StatusCode: http.StatusInternalServerError, ErrorCode: "UNEXPECTED_ERROR"},
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("404"),
+		},
+		{
+			name: "nil error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr, ok := apierror.From(tt.err)
+			if ok != (tt.expected != nil) {
+				t.Fatalf("From() ok = %v; want %v", ok, tt.expected != nil)
+			}
+			if tt.expected != nil && (apiErr.StatusCode != tt.expected.StatusCode || apiErr.ErrorCode != tt.expected.ErrorCode) {
+				t.Errorf("From() = %+v; want %+v", apiErr, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	connReset := &url.Error{Op: "Get", URL: "https://cloud.mongodb.com", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}
+
+	tests := []struct {
+		err         error
+		name        string
+		notFound    bool
+		retryable   bool
+		conflict    bool
+		paused      bool
+		networkOnly bool
+	}{
+		{name: "404 legacy", err: legacyError(http.StatusNotFound, ""), notFound: true},
+		{name: "404 sdk", err: sdkError(http.StatusNotFound, "CLUSTER_NOT_FOUND"), notFound: true},
+		{name: "backup config not found", err: legacyError(http.StatusBadRequest, "BACKUP_CONFIG_NOT_FOUND"), notFound: true},
+		{name: "custom role not found", err: legacyError(http.StatusBadRequest, "ATLAS_CUSTOM_ROLE_NOT_FOUND"), notFound: true},
+		{name: "search deployment not found", err: legacyError(http.StatusBadRequest, "ATLAS_SEARCH_DEPLOYMENT_DOES_NOT_EXIST"), notFound: true},
+		{name: "rate limited", err: sdkError(http.StatusTooManyRequests, "RATE_LIMITED"), retryable: true},
+		{name: "unexpected error", err: legacyError(http.StatusInternalServerError, "UNEXPECTED_ERROR"), retryable: true},
+		{name: "unavailable", err: realmError(http.StatusServiceUnavailable, ""), retryable: true},
+		{name: "conflict", err: sdkError(http.StatusConflict, "ATLAS_GENERAL_ERROR"), conflict: true},
+		{name: "paused cluster", err: legacyError(http.StatusBadRequest, "CANNOT_UPDATE_PAUSED_CLUSTER"), paused: true},
+		{name: "connection reset", err: connReset, retryable: true, networkOnly: true},
+		{name: "bad request", err: legacyError(http.StatusBadRequest, "INVALID_ATTRIBUTE")},
+		{name: "plain error mentioning 404", err: errors.New("something 404 reset by peer")},
+		{name: "nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := apierror.IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound() = %v; want %v", got, tt.notFound)
+			}
+			if got := apierror.IsRetryable(tt.err); got != tt.retryable {
+				t.Errorf("IsRetryable() = %v; want %v", got, tt.retryable)
+			}
+			if got := apierror.IsConflict(tt.err); got != tt.conflict {
+				t.Errorf("IsConflict() = %v; want %v", got, tt.conflict)
+			}
+			if got := apierror.IsClusterPaused(tt.err); got != tt.paused {
+				t.Errorf("IsClusterPaused() = %v; want %v", got, tt.paused)
+			}
+			if got := apierror.IsTransientNetworkError(tt.err); got != tt.networkOnly {
+				t.Errorf("IsTransientNetworkError() = %v; want %v", got, tt.networkOnly)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/realm/realm"
+)
+
+// the realm client only implements applications listing and event triggers, the rest of the App Services Admin API
+// used by the app_services resources is called through the client's NewRequest and Do, so requests keep the same
+// authentication, retries and logging, see https://www.mongodb.com/docs/atlas/app-services/admin/api/v3/
+const (
+	appServicesAppsPath      = "groups/%s/apps"
+	appServicesAppPath       = "groups/%s/apps/%s"
+	appServicesFunctionsPath = appServicesAppPath + "/functions"
+	appServicesValuesPath    = appServicesAppPath + "/values"
+	appServicesSecretsPath   = appServicesAppPath + "/secrets"
+	appServicesServicesPath  = appServicesAppPath + "/services"
+
+	appServicesClusterServiceType = "mongodb-atlas"
+)
+
+type appServicesApp struct {
+	ID              string `json:"_id,omitempty"`
+	ClientAppID     string `json:"client_app_id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Location        string `json:"location,omitempty"`
+	DeploymentModel string `json:"deployment_model,omitempty"`
+	Environment     string `json:"environment,omitempty"`
+	DomainID        string `json:"domain_id,omitempty"`
+	GroupID         string `json:"group_id,omitempty"`
+}
+
+type appServicesFunction struct {
+	ID             string          `json:"_id,omitempty"`
+	Name           string          `json:"name"`
+	Source         string          `json:"source,omitempty"`
+	CanEvaluate    json.RawMessage `json:"can_evaluate,omitempty"`
+	RunAsUserID    string          `json:"run_as_user_id,omitempty"`
+	Private        bool            `json:"private"`
+	RunAsSystem    bool            `json:"run_as_system"`
+	DisableArgLogs bool            `json:"disable_arg_logs"`
+}
+
+type appServicesValue struct {
+	ID         string          `json:"_id,omitempty"`
+	Name       string          `json:"name"`
+	Value      json.RawMessage `json:"value"`
+	Private    bool            `json:"private"`
+	FromSecret bool            `json:"from_secret"`
+}
+
+type appServicesSecret struct {
+	ID    string `json:"_id,omitempty"`
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// appServicesService is a linked data source of an app. Only Atlas clusters, services of type mongodb-atlas, are
+// managed by the provider.
+type appServicesService struct {
+	Config *appServicesClusterConfig `json:"config,omitempty"`
+	ID     string                    `json:"_id,omitempty"`
+	Name   string                    `json:"name"`
+	Type   string                    `json:"type"`
+}
+
+type appServicesClusterConfig struct {
+	ClusterName         string `json:"clusterName"`
+	ReadPreference      string `json:"readPreference,omitempty"`
+	WireProtocolEnabled bool   `json:"wireProtocolEnabled"`
+}
+
+func doAppServicesRequest(ctx context.Context, conn *realm.Client, method, path string, body, v any) error {
+	req, err := conn.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(ctx, req, v)
+	return err
+}
+
+func createAppServicesApp(ctx context.Context, conn *realm.Client, projectID string, app *appServicesApp) (*appServicesApp, error) {
+	created := new(appServicesApp)
+	if err := doAppServicesRequest(ctx, conn, http.MethodPost, fmt.Sprintf(appServicesAppsPath, projectID), app, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func getAppServicesApp(ctx context.Context, conn *realm.Client, projectID, appID string) (*appServicesApp, error) {
+	app := new(appServicesApp)
+	if err := doAppServicesRequest(ctx, conn, http.MethodGet, fmt.Sprintf(appServicesAppPath, projectID, appID), nil, app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+func setAppServicesAppEnvironment(ctx context.Context, conn *realm.Client, projectID, appID, environment string) error {
+	path := fmt.Sprintf(appServicesAppPath+"/environment", projectID, appID)
+	return doAppServicesRequest(ctx, conn, http.MethodPut, path, map[string]string{"environment": environment}, nil)
+}
+
+func deleteAppServicesApp(ctx context.Context, conn *realm.Client, projectID, appID string) error {
+	return doAppServicesRequest(ctx, conn, http.MethodDelete, fmt.Sprintf(appServicesAppPath, projectID, appID), nil, nil)
+}
+
+func createAppServicesFunction(ctx context.Context, conn *realm.Client, projectID, appID string, function *appServicesFunction) (*appServicesFunction, error) {
+	created := new(appServicesFunction)
+	path := fmt.Sprintf(appServicesFunctionsPath, projectID, appID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodPost, path, function, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func getAppServicesFunction(ctx context.Context, conn *realm.Client, projectID, appID, functionID string) (*appServicesFunction, error) {
+	function := new(appServicesFunction)
+	path := fmt.Sprintf(appServicesFunctionsPath+"/%s", projectID, appID, functionID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodGet, path, nil, function); err != nil {
+		return nil, err
+	}
+	return function, nil
+}
+
+func updateAppServicesFunction(ctx context.Context, conn *realm.Client, projectID, appID, functionID string, function *appServicesFunction) error {
+	path := fmt.Sprintf(appServicesFunctionsPath+"/%s", projectID, appID, functionID)
+	return doAppServicesRequest(ctx, conn, http.MethodPut, path, function, nil)
+}
+
+func deleteAppServicesFunction(ctx context.Context, conn *realm.Client, projectID, appID, functionID string) error {
+	path := fmt.Sprintf(appServicesFunctionsPath+"/%s", projectID, appID, functionID)
+	return doAppServicesRequest(ctx, conn, http.MethodDelete, path, nil, nil)
+}
+
+func createAppServicesValue(ctx context.Context, conn *realm.Client, projectID, appID string, value *appServicesValue) (*appServicesValue, error) {
+	created := new(appServicesValue)
+	path := fmt.Sprintf(appServicesValuesPath, projectID, appID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodPost, path, value, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func getAppServicesValue(ctx context.Context, conn *realm.Client, projectID, appID, valueID string) (*appServicesValue, error) {
+	value := new(appServicesValue)
+	path := fmt.Sprintf(appServicesValuesPath+"/%s", projectID, appID, valueID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodGet, path, nil, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func updateAppServicesValue(ctx context.Context, conn *realm.Client, projectID, appID, valueID string, value *appServicesValue) error {
+	path := fmt.Sprintf(appServicesValuesPath+"/%s", projectID, appID, valueID)
+	return doAppServicesRequest(ctx, conn, http.MethodPut, path, value, nil)
+}
+
+func deleteAppServicesValue(ctx context.Context, conn *realm.Client, projectID, appID, valueID string) error {
+	path := fmt.Sprintf(appServicesValuesPath+"/%s", projectID, appID, valueID)
+	return doAppServicesRequest(ctx, conn, http.MethodDelete, path, nil, nil)
+}
+
+func createAppServicesSecret(ctx context.Context, conn *realm.Client, projectID, appID string, secret *appServicesSecret) (*appServicesSecret, error) {
+	created := new(appServicesSecret)
+	path := fmt.Sprintf(appServicesSecretsPath, projectID, appID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodPost, path, secret, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// getAppServicesSecret returns the name of a secret, the API has no endpoint to read a single secret and never returns
+// their values. It returns a not found error if the secret doesn't exist.
+func getAppServicesSecret(ctx context.Context, conn *realm.Client, projectID, appID, secretID string) (*appServicesSecret, error) {
+	var secrets []appServicesSecret
+	path := fmt.Sprintf(appServicesSecretsPath, projectID, appID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodGet, path, nil, &secrets); err != nil {
+		return nil, err
+	}
+	for i := range secrets {
+		if secrets[i].ID == secretID {
+			return &secrets[i], nil
+		}
+	}
+	return nil, &realm.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusNotFound, Request: &http.Request{Method: http.MethodGet, URL: conn.BaseURL.JoinPath(path)}},
+		Detail:   fmt.Sprintf("secret %s not found", secretID),
+	}
+}
+
+func updateAppServicesSecret(ctx context.Context, conn *realm.Client, projectID, appID, secretID string, secret *appServicesSecret) error {
+	path := fmt.Sprintf(appServicesSecretsPath+"/%s", projectID, appID, secretID)
+	return doAppServicesRequest(ctx, conn, http.MethodPut, path, secret, nil)
+}
+
+func deleteAppServicesSecret(ctx context.Context, conn *realm.Client, projectID, appID, secretID string) error {
+	path := fmt.Sprintf(appServicesSecretsPath+"/%s", projectID, appID, secretID)
+	return doAppServicesRequest(ctx, conn, http.MethodDelete, path, nil, nil)
+}
+
+func createAppServicesService(ctx context.Context, conn *realm.Client, projectID, appID string, service *appServicesService) (*appServicesService, error) {
+	created := new(appServicesService)
+	path := fmt.Sprintf(appServicesServicesPath, projectID, appID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodPost, path, service, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// getAppServicesService returns a linked data source including its configuration, which is served by its own endpoint.
+func getAppServicesService(ctx context.Context, conn *realm.Client, projectID, appID, serviceID string) (*appServicesService, error) {
+	service := new(appServicesService)
+	path := fmt.Sprintf(appServicesServicesPath+"/%s", projectID, appID, serviceID)
+	if err := doAppServicesRequest(ctx, conn, http.MethodGet, path, nil, service); err != nil {
+		return nil, err
+	}
+	service.Config = new(appServicesClusterConfig)
+	if err := doAppServicesRequest(ctx, conn, http.MethodGet, path+"/config", nil, service.Config); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+func updateAppServicesServiceConfig(ctx context.Context, conn *realm.Client, projectID, appID, serviceID string, config *appServicesClusterConfig) error {
+	path := fmt.Sprintf(appServicesServicesPath+"/%s/config", projectID, appID, serviceID)
+	return doAppServicesRequest(ctx, conn, http.MethodPatch, path, config, nil)
+}
+
+func deleteAppServicesService(ctx context.Context, conn *realm.Client, projectID, appID, serviceID string) error {
+	path := fmt.Sprintf(appServicesServicesPath+"/%s", projectID, appID, serviceID)
+	return doAppServicesRequest(ctx, conn, http.MethodDelete, path, nil, nil)
+}
@@ -0,0 +1,125 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	"go.mongodb.org/realm/realm"
+)
+
+func TestAppServicesRequests(t *testing.T) {
+	const app = "/groups/project/apps/app"
+	server := atlastest.NewServer()
+	defer server.Close()
+	server.AddRoute(http.MethodPost, app+"/functions", "", func(r *http.Request) (int, any) {
+		var function map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&function); err != nil {
+			t.Errorf("unexpected error decoding the request: %s", err)
+		}
+		if function["can_evaluate"] != nil || function["private"] != false {
+			t.Errorf("unexpected function request %v", function)
+		}
+		return http.StatusOK, `{"_id":"function-id","name":"onInsert"}`
+	})
+	server.AddRoute(http.MethodGet, app+"/functions/missing", "", func(r *http.Request) (int, any) {
+		return http.StatusNotFound, `{"error":"function not found","error_code":"FunctionNotFound"}`
+	})
+	server.AddRoute(http.MethodDelete, app+"/functions/function-id", "", func(r *http.Request) (int, any) {
+		return http.StatusNoContent, nil
+	})
+	server.AddRoute(http.MethodPost, app+"/values", "", func(r *http.Request) (int, any) {
+		var value appServicesValue
+		if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+			t.Errorf("unexpected error decoding the request: %s", err)
+		}
+		if string(value.Value) != `{"threshold":10}` {
+			t.Errorf("expected the value to be sent as JSON, got %s", value.Value)
+		}
+		value.ID = "value-id"
+		return http.StatusOK, value
+	})
+	secrets := `[{"_id":"other-id","name":"other"},{"_id":"secret-id","name":"apiKey"}]`
+	server.AddRoute(http.MethodGet, app+"/secrets", "", atlastest.Responses(secrets, secrets))
+	server.AddRoute(http.MethodGet, app+"/services/service-id", "",
+		atlastest.Responses(`{"_id":"service-id","name":"mongodb-atlas","type":"mongodb-atlas"}`))
+	server.AddRoute(http.MethodGet, app+"/services/service-id/config", "",
+		atlastest.Responses(`{"clusterName":"Cluster0","readPreference":"primary","wireProtocolEnabled":true}`))
+
+	conn, err := realm.New(nil, realm.SetBaseURL(server.URL()))
+	if err != nil {
+		t.Fatalf("unexpected error creating the client: %s", err)
+	}
+	ctx := context.Background()
+
+	function, err := createAppServicesFunction(ctx, conn, "project", "app", &appServicesFunction{Name: "onInsert", Source: "exports = function(){}"})
+	if err != nil || function.ID != "function-id" {
+		t.Fatalf("unexpected result creating the function: %+v, %v", function, err)
+	}
+	if _, err := getAppServicesFunction(ctx, conn, "project", "app", "missing"); !apierror.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+	if err := deleteAppServicesFunction(ctx, conn, "project", "app", function.ID); err != nil {
+		t.Errorf("unexpected error deleting the function: %s", err)
+	}
+
+	value, err := createAppServicesValue(ctx, conn, "project", "app", &appServicesValue{Name: "settings", Value: json.RawMessage(`{"threshold":10}`)})
+	if err != nil || value.ID != "value-id" {
+		t.Fatalf("unexpected result creating the value: %+v, %v", value, err)
+	}
+
+	secret, err := getAppServicesSecret(ctx, conn, "project", "app", "secret-id")
+	if err != nil || secret.Name != "apiKey" {
+		t.Errorf("unexpected result reading the secret: %+v, %v", secret, err)
+	}
+	if _, err := getAppServicesSecret(ctx, conn, "project", "app", "deleted-id"); !apierror.IsNotFound(err) {
+		t.Errorf("expected a not found error for a deleted secret, got %v", err)
+	}
+
+	service, err := getAppServicesService(ctx, conn, "project", "app", "service-id")
+	if err != nil {
+		t.Fatalf("unexpected error reading the cluster link: %s", err)
+	}
+	if service.Type != appServicesClusterServiceType || service.Config.ClusterName != "Cluster0" || !service.Config.WireProtocolEnabled {
+		t.Errorf("unexpected cluster link %+v", service)
+	}
+
+	expected := []string{
+		"POST " + app + "/functions",
+		"GET " + app + "/functions/missing",
+		"DELETE " + app + "/functions/function-id",
+		"POST " + app + "/values",
+		"GET " + app + "/secrets",
+		"GET " + app + "/secrets",
+		"GET " + app + "/services/service-id",
+		"GET " + app + "/services/service-id/config",
+	}
+	if requests := server.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestAppServicesJSONDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{name: "same document", old: `{"a":1,"b":[1,2]}`, new: "{\n  \"b\": [1, 2],\n  \"a\": 1\n}", expected: true},
+		{name: "different document", old: `{"a":1}`, new: `{"a":2}`, expected: false},
+		{name: "string", old: `"secretName"`, new: `"secretName"`, expected: true},
+		{name: "unset", old: "", new: `{}`, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := appServicesJSONDiff("value", tt.old, tt.new, nil); got != tt.expected {
+				t.Errorf("appServicesJSONDiff() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Package catalog lists the cloud regions and instance sizes Atlas offers per cloud provider, so typos in a
+// configuration can be reported at plan time instead of after a failed request to Atlas.
+package catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	ProviderTenant = "TENANT"
+
+	// maxSuggestions is the maximum number of names suggested for a value that is not in the catalog.
+	maxSuggestions = 3
+)
+
+//go:embed catalog.json
+var catalogJSON []byte
+
+var defaultCatalog = mustParse(catalogJSON)
+
+// Catalog is a versioned list of cloud providers. The version is the date the catalog was last reviewed against the
+// Atlas documentation.
+type Catalog struct {
+	Version   string     `json:"version"`
+	Providers []Provider `json:"providers"`
+}
+
+// Provider lists the regions and instance sizes of a cloud provider. Shared tier clusters (TENANT) are deployed in the
+// regions of their backing providers.
+type Provider struct {
+	Name             string         `json:"name"`
+	BackingProviders []string       `json:"backing_providers,omitempty"`
+	Regions          []Region       `json:"regions,omitempty"`
+	InstanceSizes    []InstanceSize `json:"instance_sizes"`
+}
+
+// Region is a cloud region, identified by its Atlas name (US_EAST_1) and by the name the cloud provider uses for it
+// (us-east-1).
+type Region struct {
+	Name      string `json:"name"`
+	CloudName string `json:"cloud_name"`
+}
+
+// InstanceSize is a cluster tier. Class is GENERAL, LOW_CPU, NVME or SHARED and AutoScaling reports whether the size
+// can be used as a compute auto-scaling bound.
+type InstanceSize struct {
+	Name        string `json:"name"`
+	Class       string `json:"class"`
+	AutoScaling bool   `json:"auto_scaling"`
+}
+
+func mustParse(data []byte) *Catalog {
+	c := new(Catalog)
+	if err := json.Unmarshal(data, c); err != nil {
+		panic(fmt.Sprintf("invalid embedded catalog: %s", err))
+	}
+	for i := range c.Providers {
+		p := &c.Providers[i]
+		for _, backingProvider := range p.BackingProviders {
+			if backing, ok := c.Provider(backingProvider); ok {
+				p.Regions = append(p.Regions, backing.Regions...)
+			}
+		}
+	}
+	return c
+}
+
+// Default returns the catalog embedded in the provider.
+func Default() *Catalog {
+	return defaultCatalog
+}
+
+// Provider returns the provider with the given name.
+func (c *Catalog) Provider(name string) (*Provider, bool) {
+	for i := range c.Providers {
+		if c.Providers[i].Name == name {
+			return &c.Providers[i], true
+		}
+	}
+	return nil, false
+}
+
+// Region returns the region with the given Atlas or cloud provider name. Names are compared ignoring case and the
+// difference between dashes and underscores, the same way regions are normalized before being sent to Atlas.
+func (p *Provider) Region(name string) (*Region, bool) {
+	normalized := normalizeRegion(name)
+	for i := range p.Regions {
+		if p.Regions[i].Name == normalized || normalizeRegion(p.Regions[i].CloudName) == normalized {
+			return &p.Regions[i], true
+		}
+	}
+	return nil, false
+}
+
+// InstanceSize returns the instance size with the given name.
+func (p *Provider) InstanceSize(name string) (*InstanceSize, bool) {
+	for i := range p.InstanceSizes {
+		if p.InstanceSizes[i].Name == name {
+			return &p.InstanceSizes[i], true
+		}
+	}
+	return nil, false
+}
+
+// ValidateRegion returns an error suggesting the closest regions if region is not offered by the provider. Providers
+// that aren't in the catalog aren't validated.
+func (c *Catalog) ValidateRegion(providerName, region string) error {
+	p, ok := c.Provider(providerName)
+	if !ok {
+		return nil
+	}
+	if _, ok := p.Region(region); ok {
+		return nil
+	}
+	names := make([]string, 0, len(p.Regions))
+	for _, r := range p.Regions {
+		names = append(names, r.Name)
+	}
+	return notFoundError("region", region, providerName, Suggest(normalizeRegion(region), names))
+}
+
+// ValidateInstanceSize returns an error suggesting the closest sizes if instanceSize is not offered by the provider.
+// Providers that aren't in the catalog aren't validated.
+func (c *Catalog) ValidateInstanceSize(providerName, instanceSize string) error {
+	p, ok := c.Provider(providerName)
+	if !ok {
+		return nil
+	}
+	if _, ok := p.InstanceSize(instanceSize); ok {
+		return nil
+	}
+	return notFoundError("instance size", instanceSize, providerName, Suggest(instanceSize, p.instanceSizeNames(false)))
+}
+
+// ValidateAutoScalingInstanceSize is like ValidateInstanceSize but also rejects the sizes that can't be used as compute
+// auto-scaling bounds.
+func (c *Catalog) ValidateAutoScalingInstanceSize(providerName, instanceSize string) error {
+	if err := c.ValidateInstanceSize(providerName, instanceSize); err != nil {
+		return err
+	}
+	p, ok := c.Provider(providerName)
+	if !ok {
+		return nil
+	}
+	if size, _ := p.InstanceSize(instanceSize); !size.AutoScaling {
+		return notFoundError("compute auto-scaling instance size", instanceSize, providerName,
+			Suggest(instanceSize, p.instanceSizeNames(true)))
+	}
+	return nil
+}
+
+func (p *Provider) instanceSizeNames(autoScalingOnly bool) []string {
+	names := make([]string, 0, len(p.InstanceSizes))
+	for _, size := range p.InstanceSizes {
+		if !autoScalingOnly || size.AutoScaling {
+			names = append(names, size.Name)
+		}
+	}
+	return names
+}
+
+func notFoundError(kind, value, providerName string, suggestions []string) error {
+	err := fmt.Sprintf("%q is not a valid %s for provider %s", value, kind, providerName)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("%s", err)
+	}
+	quoted := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return fmt.Errorf("%s, did you mean %s?", err, strings.Join(quoted, " or "))
+}
+
+// Suggest returns up to three candidates closest to value in edit distance, in the order they are listed.
+// Candidates that are too far from value to be a typo aren't returned.
+func Suggest(value string, candidates []string) []string {
+	var suggestions []string
+	best := len(value)/3 + 1
+	for _, candidate := range candidates {
+		d := distance(strings.ToUpper(value), strings.ToUpper(candidate))
+		switch {
+		case d < best:
+			best = d
+			suggestions = []string{candidate}
+		case d == best:
+			suggestions = append(suggestions, candidate)
+		}
+	}
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	return suggestions
+}
+
+// distance returns the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			// substitution, deletion or insertion, whichever is cheapest
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func normalizeRegion(region string) string {
+	return strings.ToUpper(strings.ReplaceAll(region, "-", "_"))
+}
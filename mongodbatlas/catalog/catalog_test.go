@@ -0,0 +1,124 @@
+package catalog_test
+
+import (
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/catalog"
+)
+
+func TestCatalogValidateRegion(t *testing.T) {
+	testCases := []struct {
+		name         string
+		providerName string
+		region       string
+		expectedErr  string
+	}{
+		{name: "atlas name", providerName: "AWS", region: "US_EAST_1"},
+		{name: "cloud provider name", providerName: "AWS", region: "us-east-1"},
+		{name: "azure cloud provider name", providerName: "AZURE", region: "eastus2"},
+		{name: "gcp atlas name", providerName: "GCP", region: "CENTRAL_US"},
+		{name: "tenant uses the backing provider regions", providerName: catalog.ProviderTenant, region: "EUROPE_NORTH"},
+		{name: "provider not in the catalog", providerName: "SERVERLESS", region: "ANYWHERE"},
+		{
+			name:         "typo",
+			providerName: "AWS",
+			region:       "US_EST_1",
+			expectedErr:  `"US_EST_1" is not a valid region for provider AWS, did you mean "US_EAST_1" or "US_WEST_1"?`,
+		},
+		{
+			name:         "region of another provider",
+			providerName: "GCP",
+			region:       "EU_CENTRAL_1",
+			expectedErr:  `"EU_CENTRAL_1" is not a valid region for provider GCP, did you mean "CENTRAL_US" or "EUROPE_CENTRAL_2"?`,
+		},
+		{
+			name:         "no close region",
+			providerName: "AWS",
+			region:       "MARS",
+			expectedErr:  `"MARS" is not a valid region for provider AWS`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assertError(t, catalog.Default().ValidateRegion(tc.providerName, tc.region), tc.expectedErr)
+		})
+	}
+}
+
+func TestCatalogValidateInstanceSize(t *testing.T) {
+	testCases := []struct {
+		name         string
+		providerName string
+		instanceSize string
+		autoScaling  bool
+		expectedErr  string
+	}{
+		{name: "general", providerName: "AWS", instanceSize: "M30"},
+		{name: "low cpu", providerName: "GCP", instanceSize: "R40"},
+		{name: "nvme", providerName: "AZURE", instanceSize: "M60_NVME"},
+		{name: "shared", providerName: catalog.ProviderTenant, instanceSize: "M5"},
+		{name: "auto-scaling bound", providerName: "AWS", instanceSize: "M20", autoScaling: true},
+		{
+			name:         "typo",
+			providerName: "AWS",
+			instanceSize: "M35",
+			expectedErr:  `"M35" is not a valid instance size for provider AWS, did you mean "M30"?`,
+		},
+		{
+			name:         "shared size on a dedicated provider",
+			providerName: "AWS",
+			instanceSize: "M0",
+			expectedErr:  `"M0" is not a valid instance size for provider AWS, did you mean "M10" or "M20" or "M30"?`,
+		},
+		{
+			name:         "nvme auto-scaling bound",
+			providerName: "AWS",
+			instanceSize: "M40_NVME",
+			autoScaling:  true,
+			expectedErr:  `"M40_NVME" is not a valid compute auto-scaling instance size for provider AWS`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validate := catalog.Default().ValidateInstanceSize
+			if tc.autoScaling {
+				validate = catalog.Default().ValidateAutoScalingInstanceSize
+			}
+			assertError(t, validate(tc.providerName, tc.instanceSize), tc.expectedErr)
+		})
+	}
+}
+
+func TestCatalogVersion(t *testing.T) {
+	c := catalog.Default()
+	if c.Version == "" {
+		t.Error("expected the catalog to be versioned")
+	}
+	for _, name := range []string{"AWS", "GCP", "AZURE", catalog.ProviderTenant} {
+		p, ok := c.Provider(name)
+		if !ok {
+			t.Fatalf("expected provider %s in the catalog", name)
+		}
+		if len(p.Regions) == 0 || len(p.InstanceSizes) == 0 {
+			t.Errorf("expected regions and instance sizes for provider %s", name)
+		}
+	}
+}
+
+func assertError(t *testing.T, err error, expected string) {
+	t.Helper()
+	if expected == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return
+	}
+	if err == nil {
+		t.Fatalf("expected error %q, got none", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+}
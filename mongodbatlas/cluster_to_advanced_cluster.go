@@ -0,0 +1,317 @@
+package mongodbatlas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/spf13/cast"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	clusterResourceType         = "mongodbatlas_cluster"
+	advancedClusterResourceType = "mongodbatlas_advanced_cluster"
+)
+
+// clusterToAdvancedClusterAttributes are the attributes with the same name and meaning in both resources.
+var clusterToAdvancedClusterAttributes = []string{
+	"project_id",
+	"name",
+	"cluster_type",
+	"disk_size_gb",
+	"encryption_at_rest_provider",
+	"mongo_db_major_version",
+	"paused",
+	"pit_enabled",
+	"retain_backups_enabled",
+	"termination_protection_enabled",
+	"version_release_system",
+}
+
+// tfShowOutput is the part of the output of `terraform show -json` used to migrate the clusters.
+type tfShowOutput struct {
+	Values struct {
+		RootModule tfShowModule `json:"root_module"`
+	} `json:"values"`
+}
+
+type tfShowModule struct {
+	Address      string           `json:"address"`
+	Resources    []tfShowResource `json:"resources"`
+	ChildModules []tfShowModule   `json:"child_modules"`
+}
+
+type tfShowResource struct {
+	Values  map[string]any `json:"values"`
+	Index   any            `json:"index"`
+	Address string         `json:"address"`
+	Mode    string         `json:"mode"`
+	Type    string         `json:"type"`
+	Name    string         `json:"name"`
+}
+
+// MigrateClusterState reads the output of `terraform show -json` and writes, for each mongodbatlas_cluster, the
+// configuration of the equivalent mongodbatlas_advanced_cluster along with an import block for it and a removed block
+// that forgets the mongodbatlas_cluster without destroying it. Applying it migrates the clusters without changes in
+// Atlas.
+func MigrateClusterState(r io.Reader, w io.Writer) error {
+	var show tfShowOutput
+	if err := json.NewDecoder(r).Decode(&show); err != nil {
+		return fmt.Errorf("error reading the output of `terraform show -json`: %s", err)
+	}
+
+	clusters := findClusterResources(&show.Values.RootModule)
+	if len(clusters) == 0 {
+		return errors.New("no mongodbatlas_cluster resources found in the state")
+	}
+
+	f := hclwrite.NewEmptyFile()
+	for i, cluster := range clusters {
+		if cluster.Index != nil {
+			return fmt.Errorf("%s: resources with count or for_each must be migrated by hand", cluster.Address)
+		}
+		advancedCluster, err := newAdvancedClusterFromCluster(cluster.Values)
+		if err != nil {
+			return fmt.Errorf("%s: %s", cluster.Address, err)
+		}
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+		if err := appendAdvancedClusterMigration(f.Body(), cluster, advancedCluster); err != nil {
+			return fmt.Errorf("%s: %s", cluster.Address, err)
+		}
+	}
+
+	_, err := w.Write(f.Bytes())
+	return err
+}
+
+func findClusterResources(module *tfShowModule) []tfShowResource {
+	var clusters []tfShowResource
+	for _, r := range module.Resources {
+		if r.Mode == "managed" && r.Type == clusterResourceType {
+			clusters = append(clusters, r)
+		}
+	}
+	for i := range module.ChildModules {
+		clusters = append(clusters, findClusterResources(&module.ChildModules[i])...)
+	}
+	return clusters
+}
+
+// appendAdvancedClusterMigration writes the advanced cluster resource, which must be placed in the same module as the
+// cluster, and the import and removed blocks, which must be placed in the root module.
+func appendAdvancedClusterMigration(body *hclwrite.Body, cluster tfShowResource, advancedCluster map[string]any) error {
+	modulePrefix := strings.TrimSuffix(cluster.Address, cluster.Type+"."+cluster.Name)
+	if modulePrefix != "" {
+		body.AppendUnstructuredTokens(hclwrite.Tokens{{
+			Type:  hclsyntax.TokenComment,
+			Bytes: []byte(fmt.Sprintf("# place the resource in %s\n", strings.TrimSuffix(modulePrefix, "."))),
+		}})
+	}
+	resource := body.AppendNewBlock("resource", []string{advancedClusterResourceType, cluster.Name}).Body()
+	appendSchemaValues(resource, newSDKConfigSchema(resourceMongoDBAtlasAdvancedCluster().Schema), advancedCluster)
+
+	to, diags := hclsyntax.ParseTraversalAbs([]byte(modulePrefix+advancedClusterResourceType+"."+cluster.Name), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+	from, diags := hclsyntax.ParseTraversalAbs([]byte(cluster.Address), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	body.AppendNewline()
+	importBlock := body.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", to)
+	importBlock.SetAttributeValue("id", cty.StringVal(fmt.Sprintf("%s-%s", advancedCluster["project_id"], advancedCluster["name"])))
+
+	body.AppendNewline()
+	removedBlock := body.AppendNewBlock("removed", nil).Body()
+	removedBlock.SetAttributeTraversal("from", from)
+	removedBlock.AppendNewBlock("lifecycle", nil).Body().SetAttributeValue("destroy", cty.False)
+	return nil
+}
+
+// newAdvancedClusterFromCluster returns the configuration of the advanced cluster equivalent to the state of a
+// cluster. The state must come from `terraform show -json`, so numbers are float64 and sets are lists.
+func newAdvancedClusterFromCluster(cluster map[string]any) (map[string]any, error) {
+	if cast.ToBool(cluster["backup_enabled"]) && !cast.ToBool(cluster["cloud_backup"]) {
+		return nil, errors.New("legacy backups (`backup_enabled`) are not supported by mongodbatlas_advanced_cluster, use `cloud_backup` first")
+	}
+
+	advancedCluster := map[string]any{
+		"backup_enabled": cast.ToBool(cluster["cloud_backup"]),
+	}
+	for _, key := range clusterToAdvancedClusterAttributes {
+		if v, ok := cluster[key]; ok && v != nil && v != "" {
+			advancedCluster[key] = v
+		}
+	}
+	// the disk grows on its own when it auto-scales, setting it would revert it
+	if cast.ToBool(cluster["auto_scaling_disk_gb_enabled"]) {
+		delete(advancedCluster, "disk_size_gb")
+	}
+	for _, key := range []string{"labels", "tags", "bi_connector_config"} {
+		if v := cast.ToSlice(cluster[key]); len(v) > 0 {
+			advancedCluster[key] = v
+		}
+	}
+	if v := cast.ToSlice(cluster["advanced_configuration"]); len(v) > 0 {
+		advancedCluster["advanced_configuration"] = []any{newAdvancedClusterAdvancedConfiguration(cast.ToStringMap(v[0]))}
+	}
+
+	replicationSpecs, err := newAdvancedClusterReplicationSpecs(cluster)
+	if err != nil {
+		return nil, err
+	}
+	advancedCluster["replication_specs"] = replicationSpecs
+	return advancedCluster, nil
+}
+
+// newAdvancedClusterAdvancedConfiguration leaves out the process arguments that aren't set, Atlas reports them as zero
+// values in the state of the cluster.
+func newAdvancedClusterAdvancedConfiguration(processArgs map[string]any) map[string]any {
+	config := make(map[string]any, len(processArgs))
+	for key, value := range processArgs {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			if v != "" {
+				config[key] = v
+			}
+		case float64:
+			if v != 0 {
+				config[key] = v
+			}
+		default:
+			config[key] = v
+		}
+	}
+	return config
+}
+
+func newAdvancedClusterReplicationSpecs(cluster map[string]any) ([]any, error) {
+	providerName := cast.ToString(cluster["provider_name"])
+	specs := cast.ToSlice(cluster["replication_specs"])
+
+	// shared tier and single region clusters may not have replication specs in their state
+	if providerName == "TENANT" || len(specs) == 0 {
+		region := cast.ToString(cluster["provider_region_name"])
+		if region == "" {
+			return nil, errors.New("the cluster has neither `replication_specs` nor `provider_region_name`")
+		}
+		electableNodes := cast.ToInt(cluster["replication_factor"])
+		if electableNodes == 0 {
+			electableNodes = 3
+		}
+		numShards := cast.ToInt(cluster["num_shards"])
+		if numShards == 0 {
+			numShards = 1
+		}
+		specs = []any{map[string]any{
+			"num_shards": numShards,
+			"regions_config": []any{map[string]any{
+				"region_name":     region,
+				"electable_nodes": electableNodes,
+				"priority":        advancedClusterHighestPriority,
+			}},
+		}}
+	}
+
+	advancedSpecs := make([]any, 0, len(specs))
+	for _, s := range specs {
+		spec := cast.ToStringMap(s)
+		regionsConfig := cast.ToSlice(spec["regions_config"])
+		if len(regionsConfig) == 0 {
+			return nil, errors.New("a replication spec of the cluster has no `regions_config`")
+		}
+		// Atlas lists the regions by descending priority
+		sort.SliceStable(regionsConfig, func(i, j int) bool {
+			return cast.ToInt(cast.ToStringMap(regionsConfig[i])["priority"]) > cast.ToInt(cast.ToStringMap(regionsConfig[j])["priority"])
+		})
+
+		regionConfigs := make([]any, 0, len(regionsConfig))
+		for _, rc := range regionsConfig {
+			regionConfigs = append(regionConfigs, newAdvancedClusterRegionConfig(cluster, cast.ToStringMap(rc)))
+		}
+
+		advancedSpec := map[string]any{
+			"num_shards":     cast.ToInt(spec["num_shards"]),
+			"region_configs": regionConfigs,
+		}
+		if zoneName := cast.ToString(spec["zone_name"]); zoneName != "" {
+			advancedSpec["zone_name"] = zoneName
+		}
+		advancedSpecs = append(advancedSpecs, advancedSpec)
+	}
+	return advancedSpecs, nil
+}
+
+// newAdvancedClusterRegionConfig maps a region of the cluster. The instance size, disk and auto-scaling settings of the
+// cluster apply to all its regions.
+func newAdvancedClusterRegionConfig(cluster, regionConfig map[string]any) map[string]any {
+	providerName := cast.ToString(cluster["provider_name"])
+	instanceSize := cast.ToString(cluster["provider_instance_size_name"])
+	advancedRegionConfig := map[string]any{
+		"provider_name": providerName,
+		"region_name":   cast.ToString(regionConfig["region_name"]),
+		"priority":      cast.ToInt(regionConfig["priority"]),
+	}
+
+	if providerName == "TENANT" {
+		advancedRegionConfig["backing_provider_name"] = cast.ToString(cluster["backing_provider_name"])
+		advancedRegionConfig["electable_specs"] = []any{map[string]any{"instance_size": instanceSize}}
+		return advancedRegionConfig
+	}
+
+	specs := func(nodeCount int) []any {
+		spec := map[string]any{
+			"instance_size": instanceSize,
+			"node_count":    nodeCount,
+		}
+		if providerName == "AWS" {
+			if diskIOPS := cast.ToInt(cluster["provider_disk_iops"]); diskIOPS > 0 {
+				spec["disk_iops"] = diskIOPS
+			}
+			if volumeType := cast.ToString(cluster["provider_volume_type"]); volumeType != "" {
+				spec["ebs_volume_type"] = volumeType
+			}
+		}
+		return []any{spec}
+	}
+	for key, nodes := range map[string]string{
+		"electable_specs": "electable_nodes",
+		"read_only_specs": "read_only_nodes",
+		"analytics_specs": "analytics_nodes",
+	} {
+		if nodeCount := cast.ToInt(regionConfig[nodes]); nodeCount > 0 {
+			advancedRegionConfig[key] = specs(nodeCount)
+		}
+	}
+
+	autoScaling := map[string]any{
+		"disk_gb_enabled":            cast.ToBool(cluster["auto_scaling_disk_gb_enabled"]),
+		"compute_enabled":            cast.ToBool(cluster["auto_scaling_compute_enabled"]),
+		"compute_scale_down_enabled": cast.ToBool(cluster["auto_scaling_compute_scale_down_enabled"]),
+	}
+	if cast.ToBool(cluster["auto_scaling_compute_enabled"]) {
+		for key, legacyKey := range map[string]string{
+			"compute_min_instance_size": "provider_auto_scaling_compute_min_instance_size",
+			"compute_max_instance_size": "provider_auto_scaling_compute_max_instance_size",
+		} {
+			if v := cast.ToString(cluster[legacyKey]); v != "" {
+				autoScaling[key] = v
+			}
+		}
+	}
+	advancedRegionConfig["auto_scaling"] = []any{autoScaling}
+	return advancedRegionConfig
+}
@@ -0,0 +1,448 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+const clusterMigrationProjectID = "5d0f1f74cf09a29120e123cd"
+
+var clusterMigrationStates = map[string]string{
+	"single region": `{
+		"project_id": "5d0f1f74cf09a29120e123cd",
+		"name": "single-region",
+		"cluster_type": "REPLICASET",
+		"provider_name": "AWS",
+		"provider_instance_size_name": "M10",
+		"provider_region_name": "US_EAST_1",
+		"provider_disk_iops": 3000,
+		"provider_volume_type": "STANDARD",
+		"disk_size_gb": 10,
+		"mongo_db_major_version": "6.0",
+		"cloud_backup": true,
+		"backup_enabled": false,
+		"pit_enabled": true,
+		"paused": false,
+		"termination_protection_enabled": false,
+		"version_release_system": "LTS",
+		"encryption_at_rest_provider": "NONE",
+		"auto_scaling_disk_gb_enabled": true,
+		"auto_scaling_compute_enabled": true,
+		"auto_scaling_compute_scale_down_enabled": true,
+		"provider_auto_scaling_compute_min_instance_size": "M10",
+		"provider_auto_scaling_compute_max_instance_size": "M30",
+		"replication_factor": 3,
+		"num_shards": 1,
+		"bi_connector_config": [{"enabled": true, "read_preference": "secondary"}],
+		"advanced_configuration": [{
+			"javascript_enabled": false,
+			"minimum_enabled_tls_protocol": "TLS1_2",
+			"oplog_size_mb": 1000,
+			"default_read_concern": "",
+			"sample_size_bi_connector": 0,
+			"transaction_lifetime_limit_seconds": 0
+		}],
+		"tags": [{"key": "environment", "value": "dev"}],
+		"replication_specs": [{
+			"id": "65005f2b7e4d7f1b6e6a5c1a",
+			"num_shards": 1,
+			"zone_name": "ZoneName managed by Terraform",
+			"regions_config": [{"region_name": "US_EAST_1", "electable_nodes": 3, "priority": 7, "read_only_nodes": 0, "analytics_nodes": 0}]
+		}]
+	}`,
+	"multi region": `{
+		"project_id": "5d0f1f74cf09a29120e123cd",
+		"name": "multi-region",
+		"cluster_type": "REPLICASET",
+		"provider_name": "GCP",
+		"provider_instance_size_name": "M30",
+		"disk_size_gb": 40,
+		"cloud_backup": true,
+		"auto_scaling_disk_gb_enabled": false,
+		"auto_scaling_compute_enabled": false,
+		"auto_scaling_compute_scale_down_enabled": false,
+		"provider_auto_scaling_compute_min_instance_size": "",
+		"provider_auto_scaling_compute_max_instance_size": "",
+		"replication_specs": [{
+			"num_shards": 1,
+			"zone_name": "ZoneName managed by Terraform",
+			"regions_config": [
+				{"region_name": "WESTERN_US", "electable_nodes": 2, "priority": 6, "read_only_nodes": 1, "analytics_nodes": 0},
+				{"region_name": "CENTRAL_US", "electable_nodes": 3, "priority": 7, "read_only_nodes": 0, "analytics_nodes": 1}
+			]
+		}]
+	}`,
+	"tenant": `{
+		"project_id": "5d0f1f74cf09a29120e123cd",
+		"name": "tenant",
+		"cluster_type": "REPLICASET",
+		"provider_name": "TENANT",
+		"backing_provider_name": "AWS",
+		"provider_instance_size_name": "M2",
+		"provider_region_name": "US_EAST_1",
+		"cloud_backup": false,
+		"auto_scaling_disk_gb_enabled": false
+	}`,
+}
+
+func TestNewAdvancedClusterFromCluster(t *testing.T) {
+	testCases := []struct {
+		expected    map[string]any
+		name        string
+		state       string
+		expectedErr string
+	}{
+		{
+			name:  "single region",
+			state: clusterMigrationStates["single region"],
+			expected: map[string]any{
+				"project_id":                     clusterMigrationProjectID,
+				"name":                           "single-region",
+				"cluster_type":                   "REPLICASET",
+				"backup_enabled":                 true,
+				"encryption_at_rest_provider":    "NONE",
+				"mongo_db_major_version":         "6.0",
+				"paused":                         false,
+				"pit_enabled":                    true,
+				"termination_protection_enabled": false,
+				"version_release_system":         "LTS",
+				"bi_connector_config":            []any{map[string]any{"enabled": true, "read_preference": "secondary"}},
+				"tags":                           []any{map[string]any{"key": "environment", "value": "dev"}},
+				"advanced_configuration": []any{map[string]any{
+					"javascript_enabled":           false,
+					"minimum_enabled_tls_protocol": "TLS1_2",
+					"oplog_size_mb":                float64(1000),
+				}},
+				"replication_specs": []any{map[string]any{
+					"num_shards": 1,
+					"zone_name":  "ZoneName managed by Terraform",
+					"region_configs": []any{map[string]any{
+						"provider_name": "AWS",
+						"region_name":   "US_EAST_1",
+						"priority":      7,
+						"electable_specs": []any{map[string]any{
+							"instance_size":   "M10",
+							"node_count":      3,
+							"disk_iops":       3000,
+							"ebs_volume_type": "STANDARD",
+						}},
+						"auto_scaling": []any{map[string]any{
+							"disk_gb_enabled":            true,
+							"compute_enabled":            true,
+							"compute_scale_down_enabled": true,
+							"compute_min_instance_size":  "M10",
+							"compute_max_instance_size":  "M30",
+						}},
+					}},
+				}},
+			},
+		},
+		{
+			name:  "multi region",
+			state: clusterMigrationStates["multi region"],
+			expected: map[string]any{
+				"project_id":     clusterMigrationProjectID,
+				"name":           "multi-region",
+				"cluster_type":   "REPLICASET",
+				"backup_enabled": true,
+				"disk_size_gb":   float64(40),
+				"replication_specs": []any{map[string]any{
+					"num_shards": 1,
+					"zone_name":  "ZoneName managed by Terraform",
+					"region_configs": []any{
+						map[string]any{
+							"provider_name":   "GCP",
+							"region_name":     "CENTRAL_US",
+							"priority":        7,
+							"electable_specs": []any{map[string]any{"instance_size": "M30", "node_count": 3}},
+							"analytics_specs": []any{map[string]any{"instance_size": "M30", "node_count": 1}},
+							"auto_scaling": []any{map[string]any{
+								"disk_gb_enabled":            false,
+								"compute_enabled":            false,
+								"compute_scale_down_enabled": false,
+							}},
+						},
+						map[string]any{
+							"provider_name":   "GCP",
+							"region_name":     "WESTERN_US",
+							"priority":        6,
+							"electable_specs": []any{map[string]any{"instance_size": "M30", "node_count": 2}},
+							"read_only_specs": []any{map[string]any{"instance_size": "M30", "node_count": 1}},
+							"auto_scaling": []any{map[string]any{
+								"disk_gb_enabled":            false,
+								"compute_enabled":            false,
+								"compute_scale_down_enabled": false,
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{
+			name:  "tenant",
+			state: clusterMigrationStates["tenant"],
+			expected: map[string]any{
+				"project_id":     clusterMigrationProjectID,
+				"name":           "tenant",
+				"cluster_type":   "REPLICASET",
+				"backup_enabled": false,
+				"replication_specs": []any{map[string]any{
+					"num_shards": 1,
+					"region_configs": []any{map[string]any{
+						"provider_name":         "TENANT",
+						"backing_provider_name": "AWS",
+						"region_name":           "US_EAST_1",
+						"priority":              7,
+						"electable_specs":       []any{map[string]any{"instance_size": "M2"}},
+					}},
+				}},
+			},
+		},
+		{
+			name:        "legacy backups",
+			state:       `{"name": "legacy", "backup_enabled": true, "cloud_backup": false}`,
+			expectedErr: "legacy backups (`backup_enabled`) are not supported by mongodbatlas_advanced_cluster, use `cloud_backup` first",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var state map[string]any
+			if err := json.Unmarshal([]byte(tc.state), &state); err != nil {
+				t.Fatalf("invalid state: %s", err)
+			}
+
+			advancedCluster, err := newAdvancedClusterFromCluster(state)
+			if tc.expectedErr != "" {
+				if err == nil || err.Error() != tc.expectedErr {
+					t.Fatalf("expected error %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(advancedCluster, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, advancedCluster)
+			}
+		})
+	}
+}
+
+// TestClusterToAdvancedClusterRoundTrip renders the advanced cluster of each state as HCL, parses it back and checks it
+// is the same configuration and that it is valid for mongodbatlas_advanced_cluster.
+func TestClusterToAdvancedClusterRoundTrip(t *testing.T) {
+	for name, state := range clusterMigrationStates {
+		t.Run(name, func(t *testing.T) {
+			var values map[string]any
+			if err := json.Unmarshal([]byte(state), &values); err != nil {
+				t.Fatalf("invalid state: %s", err)
+			}
+			advancedCluster, err := newAdvancedClusterFromCluster(values)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			show := fmt.Sprintf(`{"values": {"root_module": {"resources": [
+				{"address": "mongodbatlas_cluster.test", "mode": "managed", "type": "mongodbatlas_cluster", "name": "test", "values": %s}
+			]}}}`, state)
+			var out bytes.Buffer
+			if err := MigrateClusterState(strings.NewReader(show), &out); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			file, diags := hclsyntax.ParseConfig(out.Bytes(), "main.tf", hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("invalid HCL: %s\n%s", diags, out.String())
+			}
+			blocks := file.Body.(*hclsyntax.Body).Blocks
+			if len(blocks) != 3 || blocks[0].Type != "resource" || blocks[1].Type != "import" || blocks[2].Type != "removed" {
+				t.Fatalf("expected resource, import and removed blocks, got:\n%s", out.String())
+			}
+			if labels := blocks[0].Labels; labels[0] != "mongodbatlas_advanced_cluster" || labels[1] != "test" {
+				t.Errorf("unexpected resource labels %v", labels)
+			}
+			expectedImportID := fmt.Sprintf("%s-%s", clusterMigrationProjectID, advancedCluster["name"])
+			if importID := testHCLBodyValues(t, blocks[1].Body)["id"]; importID != expectedImportID {
+				t.Errorf("expected import ID %s, got %v", expectedImportID, importID)
+			}
+
+			parsed := testHCLBodyValues(t, blocks[0].Body)
+			if expected := testNormalizeJSON(t, advancedCluster); !reflect.DeepEqual(parsed, expected) {
+				t.Errorf("HCL doesn't match the advanced cluster, expected %#v, got %#v", expected, parsed)
+			}
+
+			r := resourceMongoDBAtlasAdvancedCluster()
+			config := terraform.NewResourceConfigRaw(parsed)
+			if diags := r.Validate(config); diags.HasError() {
+				t.Fatalf("invalid advanced cluster config: %v", diags)
+			}
+			rawState := &terraform.InstanceState{RawConfig: testRawConfig(t, r, parsed)}
+			if _, err := r.Diff(context.Background(), rawState, config, nil); err != nil {
+				t.Errorf("unexpected plan error: %s", err)
+			}
+		})
+	}
+}
+
+func TestSplitSClusterAdvancedImportIDFromCluster(t *testing.T) {
+	clusterID := encodeStateID(map[string]string{
+		"cluster_id":    "65005f2b7e4d7f1b6e6a5c1a",
+		"project_id":    clusterMigrationProjectID,
+		"cluster_name":  "legacy-cluster",
+		"provider_name": "AWS",
+	})
+
+	projectID, clusterName, err := splitSClusterAdvancedImportID(clusterID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *projectID != clusterMigrationProjectID || *clusterName != "legacy-cluster" {
+		t.Errorf("unexpected project ID %s and cluster name %s", *projectID, *clusterName)
+	}
+
+	if _, _, err := splitSClusterAdvancedImportID("legacy-cluster"); err == nil {
+		t.Error("expected an error for an invalid ID")
+	}
+}
+
+// TestAccClusterAdvancedCluster_migrationFromCluster moves a mongodbatlas_cluster to the configuration generated for it,
+// it requires Terraform 1.7 or later for the removed block.
+func TestAccClusterAdvancedCluster_migrationFromCluster(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_advanced_cluster.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc")
+		rName        = acctest.RandomWithPrefix("test-acc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasClusterConfigAWS(orgID, projectName, rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mongodbatlas_cluster.test", "name", rName),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAdvancedClusterConfigMigrationFromCluster(orgID, projectName, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "backup_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.0.auto_scaling.0.disk_gb_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasAdvancedClusterConfigMigrationFromCluster(orgID, projectName, name string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "cluster_project" {
+			name   = %[2]q
+			org_id = %[1]q
+		}
+		resource "mongodbatlas_advanced_cluster" "test" {
+			project_id     = mongodbatlas_project.cluster_project.id
+			name           = %[3]q
+			backup_enabled = true
+			cluster_type   = "REPLICASET"
+			disk_size_gb   = 100
+			pit_enabled    = true
+			replication_specs {
+				num_shards = 1
+				region_configs {
+					priority      = 7
+					provider_name = "AWS"
+					region_name   = "EU_CENTRAL_1"
+					auto_scaling {
+						compute_enabled            = false
+						compute_scale_down_enabled = false
+						disk_gb_enabled            = true
+					}
+					electable_specs {
+						instance_size = "M30"
+						node_count    = 3
+					}
+				}
+			}
+		}
+		import {
+			to = mongodbatlas_advanced_cluster.test
+			id = "${mongodbatlas_project.cluster_project.id}-%[3]s"
+		}
+		removed {
+			from = mongodbatlas_cluster.test
+			lifecycle {
+				destroy = false
+			}
+		}
+	`, orgID, projectName, name)
+}
+
+// testHCLBodyValues returns the attributes and blocks of body the way they are decoded from JSON.
+func testHCLBodyValues(t *testing.T, body *hclsyntax.Body) map[string]any {
+	t.Helper()
+	values := make(map[string]any)
+	for name, attr := range body.Attributes {
+		v, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			// references such as the import target can't be evaluated without a context
+			traversal, traversalDiags := hcl.AbsTraversalForExpr(attr.Expr)
+			if traversalDiags.HasErrors() {
+				t.Fatalf("invalid attribute %s: %s", name, diags)
+			}
+			values[name] = traversal.RootName()
+			continue
+		}
+		valueJSON, err := ctyjson.Marshal(v, v.Type())
+		if err != nil {
+			t.Fatalf("invalid attribute %s: %s", name, err)
+		}
+		var value any
+		if err := json.Unmarshal(valueJSON, &value); err != nil {
+			t.Fatalf("invalid attribute %s: %s", name, err)
+		}
+		values[name] = value
+	}
+	for _, block := range body.Blocks {
+		blocks, _ := values[block.Type].([]any)
+		values[block.Type] = append(blocks, testHCLBodyValues(t, block.Body))
+	}
+	return values
+}
+
+func testNormalizeJSON(t *testing.T, v map[string]any) map[string]any {
+	t.Helper()
+	valueJSON, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("invalid value: %s", err)
+	}
+	var normalized map[string]any
+	if err := json.Unmarshal(valueJSON, &normalized); err != nil {
+		t.Fatalf("invalid value: %s", err)
+	}
+	return normalized
+}
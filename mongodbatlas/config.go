@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/mongodb-forks/digest"
@@ -15,19 +17,37 @@ import (
 	matlasClient "go.mongodb.org/atlas/mongodbatlas"
 	realmAuth "go.mongodb.org/realm/auth"
 	"go.mongodb.org/realm/realm"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
-const ToolName = "terraform-provider-mongodbatlas"
+const (
+	ToolName = "terraform-provider-mongodbatlas"
+
+	defaultBaseURL = "https://cloud.mongodb.com/"
+	tokenPath      = "api/oauth/token"
+	// tokenEarlyExpiry is how long before its expiration a service account token is refreshed
+	tokenEarlyExpiry = 1 * time.Minute
+)
 
 var userAgent = fmt.Sprintf("%s/%s", ToolName, version.ProviderVersion)
 
 // Config contains the configurations needed to use SDKs
 type Config struct {
-	AssumeRole   *AssumeRole
-	PublicKey    string
-	PrivateKey   string
-	BaseURL      string
-	RealmBaseURL string
+	AssumeRole       *AssumeRole
+	DefaultLabels    map[string]string
+	PublicKey        string
+	PrivateKey       string
+	ClientID         string
+	ClientSecret     string
+	BaseURL          string
+	RealmBaseURL     string
+	DefaultProjectID string
+	MaxRetries       int
+	// MaxConcurrentRequests and MaxConcurrentRequestsPerProject limit the requests in flight, not positive means no limit
+	MaxConcurrentRequests           int
+	MaxConcurrentRequestsPerProject int
+	ReadOnly                        bool
 }
 
 // MongoDBClient contains the mongodbatlas clients and configurations
@@ -35,20 +55,45 @@ type MongoDBClient struct {
 	Atlas   *matlasClient.Client
 	AtlasV2 *atlasSDK.APIClient
 	Config  *Config
+	// tokenSource is only set when authenticating with a service account, it caches the access token
+	// and refreshes it before expiration for all the clients.
+	tokenSource oauth2.TokenSource
 }
 
 // NewClient func...
 func (c *Config) NewClient(ctx context.Context) (any, error) {
-	// setup a transport to handle digest
-	transport := digest.NewTransport(cast.ToString(c.PublicKey), cast.ToString(c.PrivateKey))
+	var (
+		transport   http.RoundTripper
+		tokenSource oauth2.TokenSource
+	)
+
+	// when replaying a cassette the transport handling authentication is never reached, so there is no token to fetch
+	if c.usesServiceAccount() && !isReplayingCassette() {
+		tokenSource = c.newTokenSource()
+		// fetch the first token eagerly so invalid credentials are reported while configuring the provider
+		if _, err := tokenSource.Token(); err != nil {
+			return nil, fmt.Errorf("failed to obtain service account access token: %w", err)
+		}
+		transport = &oauth2.Transport{Source: tokenSource, Base: http.DefaultTransport}
+	} else {
+		// setup a transport to handle digest
+		transport = digest.NewTransport(cast.ToString(c.PublicKey), cast.ToString(c.PrivateKey))
+	}
 
-	// initialize the client
-	client, err := transport.Client()
+	transport, err := newCassetteTransport(transport)
 	if err != nil {
 		return nil, err
 	}
 
-	client.Transport = logging.NewTransport("MongoDB Atlas", transport)
+	// requests are rejected in read-only mode before being coalesced, retried or limited, and each retry waits for
+	// its own slot so a request waiting to be retried doesn't block the others
+	transport = newConcurrencyLimitTransport(logging.NewTransport("MongoDB Atlas", transport), c.MaxConcurrentRequests, c.MaxConcurrentRequestsPerProject)
+	transport = newCoalescingTransport(newRetryTransport(transport, c.MaxRetries))
+
+	// initialize the client
+	client := &http.Client{
+		Transport: newReadOnlyTransport(transport, c.ReadOnly),
+	}
 
 	optsAtlas := []matlasClient.ClientOpt{matlasClient.SetUserAgent(userAgent)}
 	if c.BaseURL != "" {
@@ -67,14 +112,38 @@ func (c *Config) NewClient(ctx context.Context) (any, error) {
 	}
 
 	clients := &MongoDBClient{
-		Atlas:   atlasClient,
-		AtlasV2: sdkV2Client,
-		Config:  c,
+		Atlas:       atlasClient,
+		AtlasV2:     sdkV2Client,
+		Config:      c,
+		tokenSource: tokenSource,
 	}
 
 	return clients, nil
 }
 
+func (c *Config) usesServiceAccount() bool {
+	return c.ClientID != "" && c.ClientSecret != ""
+}
+
+// newTokenSource returns a cached token source exchanging the service account credentials for an access token
+// using the OAuth 2.0 client credentials flow. The token endpoint is resolved relative to the base URL.
+func (c *Config) newTokenSource() oauth2.TokenSource {
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	conf := &clientcredentials.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		TokenURL:     strings.TrimSuffix(baseURL, "/") + "/" + tokenPath,
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+
+	// the token source outlives the provider configure call, so it can't be bound to its context
+	return oauth2.ReuseTokenSourceWithExpiry(nil, conf.TokenSource(context.Background()), tokenEarlyExpiry)
+}
+
 func (c *Config) newSDKV2Client(client *http.Client) (*atlasSDK.APIClient, error) {
 	opts := []atlasSDK.ClientModifier{
 		atlasSDK.UseHTTPClient(client),
@@ -93,8 +162,8 @@ func (c *Config) newSDKV2Client(client *http.Client) (*atlasSDK.APIClient, error
 
 func (c *MongoDBClient) GetRealmClient(ctx context.Context) (*realm.Client, error) {
 	// Realm
-	if c.Config.PublicKey == "" && c.Config.PrivateKey == "" {
-		return nil, errors.New("please set `public_key` and `private_key` in order to use the realm client")
+	if c.tokenSource == nil && c.Config.PublicKey == "" && c.Config.PrivateKey == "" {
+		return nil, errors.New("please set `public_key` and `private_key` or `client_id` and `client_secret` in order to use the realm client")
 	}
 
 	optsRealm := []realm.ClientOpt{realm.SetUserAgent(userAgent)}
@@ -104,13 +173,27 @@ func (c *MongoDBClient) GetRealmClient(ctx context.Context) (*realm.Client, erro
 		authConfig.AuthURL, _ = url.Parse(c.Config.RealmBaseURL + "api/admin/v3.0/auth/providers/mongodb-cloud/login")
 	}
 
-	token, err := authConfig.NewTokenFromCredentials(ctx, c.Config.PublicKey, c.Config.PrivateKey)
+	var tokenSource realmAuth.TokenSource
+	switch {
+	case isReplayingCassette():
+		// responses are replayed without reaching the network so there is no need to log in
+		tokenSource = realmAuth.BasicTokenSource(&realmAuth.Token{AccessToken: redactedValue})
+	case c.tokenSource != nil:
+		tokenSource = realmTokenSource{source: c.tokenSource}
+	default:
+		token, err := authConfig.NewTokenFromCredentials(ctx, c.Config.PublicKey, c.Config.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource = realmAuth.BasicTokenSource(token)
+	}
+
+	clientRealm := realmAuth.NewClient(tokenSource)
+	transport, err := newCassetteTransport(clientRealm.Transport)
 	if err != nil {
 		return nil, err
 	}
-
-	clientRealm := realmAuth.NewClient(realmAuth.BasicTokenSource(token))
-	clientRealm.Transport = logging.NewTransport("MongoDB Realm", clientRealm.Transport)
+	clientRealm.Transport = newReadOnlyTransport(newRetryTransport(logging.NewTransport("MongoDB Realm", transport), c.Config.MaxRetries), c.Config.ReadOnly)
 
 	// Initialize the MongoDB Realm API Client.
 	realmClient, err := realm.New(clientRealm, optsRealm...)
@@ -120,3 +203,16 @@ func (c *MongoDBClient) GetRealmClient(ctx context.Context) (*realm.Client, erro
 
 	return realmClient, nil
 }
+
+// realmTokenSource adapts the service account token source to the token source used by the realm client.
+type realmTokenSource struct {
+	source oauth2.TokenSource
+}
+
+func (s realmTokenSource) Token() (*realmAuth.Token, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	return &realmAuth.Token{AccessToken: token.AccessToken}, nil
+}
@@ -0,0 +1,124 @@
+package mongodbatlas
+
+import (
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spf13/cast"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// configSchema is the part of the schema of a resource needed to write its configuration, the names of its arguments
+// and nested blocks, for both SDKv2 and framework resources.
+type configSchema struct {
+	arguments map[string]bool
+	blocks    map[string]*configSchema
+}
+
+func newSDKConfigSchema(s map[string]*schema.Schema) *configSchema {
+	cs := &configSchema{arguments: map[string]bool{}, blocks: map[string]*configSchema{}}
+	for key, attr := range s {
+		if elem, ok := attr.Elem.(*schema.Resource); ok {
+			cs.blocks[key] = newSDKConfigSchema(elem.Schema)
+			continue
+		}
+		cs.arguments[key] = true
+	}
+	return cs
+}
+
+func newFrameworkConfigSchema(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) *configSchema {
+	cs := &configSchema{arguments: map[string]bool{}, blocks: map[string]*configSchema{}}
+	for key := range attributes {
+		cs.arguments[key] = true
+	}
+	for key, block := range blocks {
+		switch block := block.(type) {
+		case fwschema.ListNestedBlock:
+			cs.blocks[key] = newFrameworkConfigSchema(block.NestedObject.Attributes, block.NestedObject.Blocks)
+		case fwschema.SetNestedBlock:
+			cs.blocks[key] = newFrameworkConfigSchema(block.NestedObject.Attributes, block.NestedObject.Blocks)
+		case fwschema.SingleNestedBlock:
+			cs.blocks[key] = newFrameworkConfigSchema(block.Attributes, block.Blocks)
+		}
+	}
+	return cs
+}
+
+// appendSchemaValues writes values as the arguments and blocks of s: the arguments first, sorted by name, then the
+// nested blocks. Values that are traversals, e.g. references to other resources or variables, are written as such.
+func appendSchemaValues(body *hclwrite.Body, s *configSchema, values map[string]any) {
+	var arguments, blocks []string
+	for key := range values {
+		switch {
+		case s.arguments[key]:
+			arguments = append(arguments, key)
+		case s.blocks[key] != nil:
+			blocks = append(blocks, key)
+		}
+	}
+	sort.Slice(arguments, func(i, j int) bool { return schemaKeyOrder(arguments[i]) < schemaKeyOrder(arguments[j]) })
+	sort.Slice(blocks, func(i, j int) bool { return schemaKeyOrder(blocks[i]) < schemaKeyOrder(blocks[j]) })
+
+	for _, key := range arguments {
+		if traversal, ok := values[key].(hcl.Traversal); ok {
+			body.SetAttributeTraversal(key, traversal)
+			continue
+		}
+		body.SetAttributeValue(key, ctyValue(values[key]))
+	}
+	for _, key := range blocks {
+		for _, v := range cast.ToSlice(values[key]) {
+			appendSchemaValues(body.AppendNewBlock(key, nil).Body(), s.blocks[key], cast.ToStringMap(v))
+		}
+	}
+}
+
+// schemaKeyOrder puts the identifying arguments first.
+func schemaKeyOrder(key string) string {
+	switch key {
+	case "project_id":
+		return "0"
+	case "name":
+		return "1"
+	}
+	return "2" + key
+}
+
+func ctyValue(v any) cty.Value {
+	switch v := v.(type) {
+	case string:
+		return cty.StringVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case []string:
+		elements := make([]any, len(v))
+		for i := range v {
+			elements[i] = v[i]
+		}
+		return ctyValue(elements)
+	case []any:
+		if len(v) == 0 {
+			return cty.ListValEmpty(cty.String)
+		}
+		elements := make([]cty.Value, len(v))
+		for i := range v {
+			elements[i] = ctyValue(v[i])
+		}
+		return cty.TupleVal(elements)
+	case map[string]any:
+		attributes := make(map[string]cty.Value, len(v))
+		for key := range v {
+			attributes[key] = ctyValue(v[key])
+		}
+		return cty.ObjectVal(attributes)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
@@ -0,0 +1,81 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+const defaultAtlasCLIProfile = "default"
+
+// AtlasCLIProfile contains the settings of a profile of the Atlas CLI used by the provider.
+type AtlasCLIProfile struct {
+	PublicAPIKey  string `toml:"public_api_key"`
+	PrivateAPIKey string `toml:"private_api_key"`
+	OrgID         string `toml:"org_id"`
+	ProjectID     string `toml:"project_id"`
+	OpsManagerURL string `toml:"ops_manager_url"`
+}
+
+// defaultAtlasCLIConfigFile returns the location of the configuration file of the Atlas CLI.
+func defaultAtlasCLIConfigFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "atlascli", "config.toml"), nil
+}
+
+// LoadAtlasCLIProfile reads profile from the Atlas CLI configuration in path, or from the default location of the
+// configuration if path is empty. The default profile is read if profile is empty.
+func LoadAtlasCLIProfile(path, profile string) (*AtlasCLIProfile, error) {
+	if path == "" {
+		var err error
+		if path, err = defaultAtlasCLIConfigFile(); err != nil {
+			return nil, fmt.Errorf("unable to find the Atlas CLI configuration file: %w", err)
+		}
+	}
+	if profile == "" {
+		profile = defaultAtlasCLIProfile
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the Atlas CLI configuration file: %w", err)
+	}
+
+	// every profile is a table, the other keys are global settings of the Atlas CLI
+	var profiles map[string]toml.Primitive
+	metadata, err := toml.Decode(string(content), &profiles)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the Atlas CLI configuration file %s: %w", path, err)
+	}
+	settings, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in the Atlas CLI configuration file %s", profile, path)
+	}
+	cliProfile := new(AtlasCLIProfile)
+	if err := metadata.PrimitiveDecode(settings, cliProfile); err != nil {
+		return nil, fmt.Errorf("unable to parse profile %q of the Atlas CLI configuration file %s: %w", profile, path, err)
+	}
+	return cliProfile, nil
+}
+
+// ApplyAtlasCLIProfile sets the settings of the provider that were not configured in the provider or in environment
+// variables from an Atlas CLI profile. The profile API keys are only used if neither key nor a service account are
+// configured, so keys from different sources are never mixed.
+func (c *Config) ApplyAtlasCLIProfile(profile *AtlasCLIProfile) {
+	if c.PublicKey == "" && c.PrivateKey == "" && !c.usesServiceAccount() {
+		c.PublicKey = profile.PublicAPIKey
+		c.PrivateKey = profile.PrivateAPIKey
+	}
+	if c.BaseURL == "" && profile.OpsManagerURL != "" {
+		c.BaseURL = strings.TrimSuffix(profile.OpsManagerURL, "/") + "/"
+	}
+	if c.DefaultProjectID == "" {
+		c.DefaultProjectID = profile.ProjectID
+	}
+}
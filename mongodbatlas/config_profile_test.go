@@ -0,0 +1,170 @@
+package mongodbatlas
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const atlasCLIConfigFixture = "testdata/atlascli/config.toml"
+
+func TestLoadAtlasCLIProfile(t *testing.T) {
+	tests := []struct {
+		expected *AtlasCLIProfile
+		name     string
+		path     string
+		profile  string
+		wantErr  string
+	}{
+		{
+			name: "default profile",
+			path: atlasCLIConfigFixture,
+			expected: &AtlasCLIProfile{
+				PublicAPIKey:  "default-public-key",
+				PrivateAPIKey: "default-private-key",
+				OrgID:         "5e2211c17a3e5a48f5497de3",
+				ProjectID:     "5e2211c17a3e5a48f5497de4",
+			},
+		},
+		{
+			name:    "named profile",
+			path:    atlasCLIConfigFixture,
+			profile: "dev",
+			expected: &AtlasCLIProfile{
+				PublicAPIKey:  "dev-public-key",
+				PrivateAPIKey: "dev-private-key",
+				OrgID:         "6b8cd3c1a4c1aa2b8e4a0c21",
+				ProjectID:     "6b8cd3c1a4c1aa2b8e4a0c22",
+				OpsManagerURL: "https://cloud-dev.mongodb.com",
+			},
+		},
+		{
+			name:     "quoted profile name",
+			path:     atlasCLIConfigFixture,
+			profile:  `team "a"`,
+			expected: &AtlasCLIProfile{PublicAPIKey: "team-public-key", PrivateAPIKey: "team-private-key"},
+		},
+		{
+			name:    "missing profile",
+			path:    atlasCLIConfigFixture,
+			profile: "prod",
+			wantErr: `profile "prod" not found`,
+		},
+		{
+			name:    "missing file",
+			path:    filepath.Join(t.TempDir(), "config.toml"),
+			wantErr: "unable to read the Atlas CLI configuration file",
+		},
+		{
+			name:    "invalid file",
+			path:    "testdata/atlascli/invalid.toml",
+			wantErr: "line 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := LoadAtlasCLIProfile(tt.path, tt.profile)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(profile, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, profile)
+			}
+		})
+	}
+}
+
+func TestApplyAtlasCLIProfile(t *testing.T) {
+	profile := &AtlasCLIProfile{
+		PublicAPIKey:  "profile-public-key",
+		PrivateAPIKey: "profile-private-key",
+		ProjectID:     "profile-project",
+		OpsManagerURL: "https://cloud-dev.mongodb.com",
+	}
+	tests := []struct {
+		name     string
+		config   Config
+		expected Config
+	}{
+		{
+			name: "unset settings",
+			expected: Config{
+				PublicKey:        "profile-public-key",
+				PrivateKey:       "profile-private-key",
+				BaseURL:          "https://cloud-dev.mongodb.com/",
+				DefaultProjectID: "profile-project",
+			},
+		},
+		{
+			name:     "configured settings take precedence",
+			config:   Config{PublicKey: "public-key", BaseURL: "https://cloud.mongodb.com/", DefaultProjectID: "project"},
+			expected: Config{PublicKey: "public-key", BaseURL: "https://cloud.mongodb.com/", DefaultProjectID: "project"},
+		},
+		{
+			name:   "service account",
+			config: Config{ClientID: "client-id", ClientSecret: "client-secret"},
+			expected: Config{
+				ClientID:         "client-id",
+				ClientSecret:     "client-secret",
+				BaseURL:          "https://cloud-dev.mongodb.com/",
+				DefaultProjectID: "profile-project",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.ApplyAtlasCLIProfile(profile)
+			if !reflect.DeepEqual(tt.config, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, tt.config)
+			}
+		})
+	}
+}
+
+func TestProviderAtlasCLIProfilePrecedence(t *testing.T) {
+	for _, env := range []string{"MONGODB_ATLAS_PUBLIC_KEY", "MONGODB_ATLAS_PRIVATE_KEY", "MCLI_PUBLIC_API_KEY", "MCLI_PRIVATE_API_KEY",
+		"MONGODB_ATLAS_BASE_URL", "MCLI_OPS_MANAGER_URL", "MONGODB_ATLAS_CLIENT_ID", "MONGODB_ATLAS_CLIENT_SECRET"} {
+		t.Setenv(env, "")
+	}
+	configure := func(t *testing.T, raw map[string]any) *Config {
+		t.Helper()
+		provider := NewSdkV2Provider()
+		if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+			t.Fatalf("unexpected error configuring the provider: %v", diags)
+		}
+		return provider.Meta().(*MongoDBClient).Config
+	}
+
+	config := configure(t, map[string]any{"profile": "dev", "config_file": atlasCLIConfigFixture})
+	if config.PublicKey != "dev-public-key" || config.BaseURL != "https://cloud-dev.mongodb.com/" || config.DefaultProjectID != "6b8cd3c1a4c1aa2b8e4a0c22" {
+		t.Errorf("expected the settings of the profile, got %+v", config)
+	}
+
+	t.Setenv("MONGODB_ATLAS_PUBLIC_KEY", "env-public-key")
+	t.Setenv("MONGODB_ATLAS_PRIVATE_KEY", "env-private-key")
+	config = configure(t, map[string]any{"profile": "dev", "config_file": atlasCLIConfigFixture})
+	if config.PublicKey != "env-public-key" || config.PrivateKey != "env-private-key" {
+		t.Errorf("expected environment variables to take precedence over the profile, got %+v", config)
+	}
+
+	config = configure(t, map[string]any{
+		"profile":            "dev",
+		"config_file":        atlasCLIConfigFixture,
+		"public_key":         "public-key",
+		"private_key":        "private-key",
+		"default_project_id": "project",
+	})
+	if config.PublicKey != "public-key" || config.PrivateKey != "private-key" || config.DefaultProjectID != "project" {
+		t.Errorf("expected provider settings to take precedence over the environment and the profile, got %+v", config)
+	}
+}
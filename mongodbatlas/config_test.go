@@ -0,0 +1,172 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	testClientID     = "mdb_sa_id_test"
+	testClientSecret = "mdb_sa_sk_test"
+)
+
+// newServiceAccountTestServer returns a server issuing tokens on the OAuth token endpoint and recording
+// the Authorization header of any other request. Each issued token has a different value.
+func newServiceAccountTestServer(t *testing.T, expiresIn int, tokenCalls *int32, authHeaders *[]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/"+tokenPath {
+			clientID, clientSecret, ok := r.BasicAuth()
+			if !ok || clientID != testClientID || clientSecret != testClientSecret {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = fmt.Fprint(w, `{"error":"invalid_client"}`)
+				return
+			}
+			if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "client_credentials" {
+				t.Errorf("unexpected token request form: %v", r.Form)
+			}
+			call := atomic.AddInt32(tokenCalls, 1)
+			_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, call, expiresIn)
+			return
+		}
+		*authHeaders = append(*authHeaders, r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "5e2211c17a3e5a48f5497de3", "name": "test"})
+	}))
+}
+
+func TestNewClientServiceAccount(t *testing.T) {
+	tests := []struct {
+		name               string
+		expectedHeaders    []string
+		expiresIn          int
+		expectedTokenCalls int32
+	}{
+		{
+			name:               "token is cached",
+			expiresIn:          3600,
+			expectedTokenCalls: 1,
+			expectedHeaders:    []string{"Bearer token-1", "Bearer token-1", "Bearer token-1"},
+		},
+		{
+			name:               "token is refreshed before expiration",
+			expiresIn:          int(tokenEarlyExpiry.Seconds()) / 2,
+			expectedTokenCalls: 4,
+			expectedHeaders:    []string{"Bearer token-2", "Bearer token-3", "Bearer token-4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				tokenCalls  int32
+				authHeaders []string
+			)
+			server := newServiceAccountTestServer(t, tt.expiresIn, &tokenCalls, &authHeaders)
+			defer server.Close()
+
+			config := Config{
+				ClientID:     testClientID,
+				ClientSecret: testClientSecret,
+				BaseURL:      server.URL + "/",
+				RealmBaseURL: server.URL + "/",
+			}
+			client, err := config.NewClient(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			conn := client.(*MongoDBClient)
+
+			if _, _, err := conn.Atlas.Projects.GetOneProject(context.Background(), "5e2211c17a3e5a48f5497de3"); err != nil {
+				t.Fatalf("unexpected error in Atlas client: %s", err)
+			}
+			if _, _, err := conn.AtlasV2.ProjectsApi.GetProject(context.Background(), "5e2211c17a3e5a48f5497de3").Execute(); err != nil {
+				t.Fatalf("unexpected error in AtlasV2 client: %s", err)
+			}
+			realmClient, err := conn.GetRealmClient(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error creating Realm client: %s", err)
+			}
+			if _, _, err := realmClient.EventTriggers.Get(context.Background(), "5e2211c17a3e5a48f5497de3", "appID", "triggerID"); err != nil {
+				t.Fatalf("unexpected error in Realm client: %s", err)
+			}
+
+			if tokenCalls != tt.expectedTokenCalls {
+				t.Errorf("expected %d token requests, got %d", tt.expectedTokenCalls, tokenCalls)
+			}
+			if len(authHeaders) != len(tt.expectedHeaders) {
+				t.Fatalf("expected %d API requests, got %d", len(tt.expectedHeaders), len(authHeaders))
+			}
+			for i, header := range authHeaders {
+				if header != tt.expectedHeaders[i] {
+					t.Errorf("request %d: expected Authorization %q, got %q", i, tt.expectedHeaders[i], header)
+				}
+			}
+		})
+	}
+}
+
+func TestNewClientServiceAccountInvalidCredentials(t *testing.T) {
+	var (
+		tokenCalls  int32
+		authHeaders []string
+	)
+	server := newServiceAccountTestServer(t, 3600, &tokenCalls, &authHeaders)
+	defer server.Close()
+
+	config := Config{
+		ClientID:     testClientID,
+		ClientSecret: "wrong",
+		BaseURL:      server.URL + "/",
+	}
+	if _, err := config.NewClient(context.Background()); err == nil {
+		t.Fatal("expected error with invalid service account credentials")
+	}
+}
+
+func TestProviderServiceAccountPartialCredentials(t *testing.T) {
+	for _, env := range []string{"MONGODB_ATLAS_CLIENT_ID", "MONGODB_ATLAS_CLIENT_SECRET"} {
+		t.Setenv(env, "")
+	}
+	tests := []struct {
+		name         string
+		clientID     string
+		clientSecret string
+	}{
+		{
+			name:     "only client_id",
+			clientID: testClientID,
+		},
+		{
+			name:         "only client_secret",
+			clientSecret: testClientSecret,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]any{"client_id": tt.clientID, "client_secret": tt.clientSecret}
+			diags := NewSdkV2Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+			if !diags.HasError() || diags[len(diags)-1].Summary != MissingServiceAccountAttrError {
+				t.Errorf("expected %q from the SDKv2 provider, got %v", MissingServiceAccountAttrError, diags)
+			}
+
+			data := tfMongodbAtlasProviderModel{
+				ClientID:     types.StringValue(tt.clientID),
+				ClientSecret: types.StringValue(tt.clientSecret),
+			}
+			resp := &provider.ConfigureResponse{}
+			setDefaultValuesWithValidations(&data, false, resp)
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Detail() != MissingServiceAccountAttrError {
+				t.Errorf("expected %q from the framework provider, got %v", MissingServiceAccountAttrError, resp.Diagnostics)
+			}
+		})
+	}
+}
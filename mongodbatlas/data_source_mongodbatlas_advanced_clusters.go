@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -244,9 +244,9 @@ func dataSourceMongoDBAtlasAdvancedClustersRead(ctx context.Context, d *schema.R
 	projectID := d.Get("project_id").(string)
 	d.SetId(id.UniqueId())
 
-	clusters, resp, err := conn.AdvancedClusters.List(ctx, projectID, nil)
+	clusters, _, err := conn.AdvancedClusters.List(ctx, projectID, nil)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			return nil
 		}
 
@@ -3,10 +3,10 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -202,13 +202,16 @@ func dataSourceMongoDBAtlasBackupCompliancePolicyRead(ctx context.Context, d *sc
 
 	projectID := d.Get("project_id").(string)
 
-	backupPolicy, resp, err := conn.BackupCompliancePolicy.Get(ctx, projectID)
-	if resp != nil && resp.StatusCode == http.StatusNotFound || backupPolicy.ProjectID == "" {
+	backupPolicy, _, err := conn.BackupCompliancePolicy.Get(ctx, projectID)
+	if apierror.IsNotFound(err) {
 		return nil
 	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorSnapshotBackupPolicyRead, projectID, err))
 	}
+	if backupPolicy.ProjectID == "" {
+		return nil
+	}
 
 	if err := d.Set("authorized_email", backupPolicy.AuthorizedEmail); err != nil {
 		return diag.FromErr(fmt.Errorf(errorSnapshotBackupPolicySetting, "authorized_email", projectID, err))
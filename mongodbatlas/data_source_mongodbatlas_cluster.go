@@ -3,10 +3,10 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -324,9 +324,9 @@ func dataSourceMongoDBAtlasClusterRead(ctx context.Context, d *schema.ResourceDa
 	projectID := d.Get("project_id").(string)
 	clusterName := d.Get("name").(string)
 
-	cluster, resp, err := conn.Clusters.Get(ctx, projectID, clusterName)
+	cluster, _, err := conn.Clusters.Get(ctx, projectID, clusterName)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			return nil
 		}
 
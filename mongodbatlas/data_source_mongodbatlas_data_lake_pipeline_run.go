@@ -3,10 +3,10 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -91,9 +91,9 @@ func dataSourceMongoDBAtlasDataLakeRunRead(ctx context.Context, d *schema.Resour
 	name := d.Get("pipeline_name").(string)
 	pipelineRunID := d.Get("pipeline_run_id").(string)
 
-	dataLakeRun, resp, err := conn.DataLakePipeline.GetRun(ctx, projectID, name, pipelineRunID)
+	dataLakeRun, _, err := conn.DataLakePipeline.GetRun(ctx, projectID, name, pipelineRunID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -3,10 +3,10 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 )
 
 func dataSourceMongoDBAtlasGlobalCluster() *schema.Resource {
@@ -66,9 +66,9 @@ func dataSourceMongoDBAtlasGlobalClusterRead(ctx context.Context, d *schema.Reso
 	projectID := d.Get("project_id").(string)
 	clusterName := d.Get("cluster_name").(string)
 
-	globalCluster, resp, err := conn.GlobalClusters.Get(ctx, projectID, clusterName)
+	globalCluster, _, err := conn.GlobalClusters.Get(ctx, projectID, clusterName)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			return nil
 		}
 
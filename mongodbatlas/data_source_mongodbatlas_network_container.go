@@ -3,10 +3,10 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 )
 
 func dataSourceMongoDBAtlasNetworkContainer() *schema.Resource {
@@ -78,9 +78,9 @@ func dataSourceMongoDBAtlasNetworkContainerRead(ctx context.Context, d *schema.R
 	projectID := d.Get("project_id").(string)
 	containerID := getEncodedID(d.Get("container_id").(string), "container_id")
 
-	container, resp, err := conn.Containers.Get(ctx, projectID, containerID)
+	container, _, err := conn.Containers.Get(ctx, projectID, containerID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			return nil
 		}
 
@@ -3,10 +3,10 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 )
 
 func dataSourceMongoDBAtlasNetworkPeering() *schema.Resource {
@@ -113,9 +113,9 @@ func dataSourceMongoDBAtlasNetworkPeeringRead(ctx context.Context, d *schema.Res
 	projectID := d.Get("project_id").(string)
 	peerID := getEncodedID(d.Get("peering_id").(string), "peer_id")
 
-	peer, resp, err := conn.Peers.Get(ctx, projectID, peerID)
+	peer, _, err := conn.Peers.Get(ctx, projectID, peerID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			return nil
 		}
 
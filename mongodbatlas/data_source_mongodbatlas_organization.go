@@ -3,9 +3,12 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 )
 
 func dataSourceMongoDBAtlasOrganization() *schema.Resource {
@@ -24,6 +27,18 @@ func dataSourceMongoDBAtlasOrganization() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"api_access_list_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"multi_factor_auth_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"restrict_employee_access": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"links": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -66,6 +81,25 @@ func dataSourceMongoDBAtlasOrganizationRead(ctx context.Context, d *schema.Resou
 		return diag.FromErr(fmt.Errorf("error setting `is_deleted`: %s", err))
 	}
 
+	// only organization owners can read the settings, the rest of the attributes are still available to other users
+	settings, _, err := meta.(*MongoDBClient).AtlasV2.OrganizationsApi.GetOrganizationSettings(ctx, orgID).Execute()
+	switch {
+	case apierror.HasStatus(err, http.StatusUnauthorized, http.StatusForbidden):
+		log.Printf("[WARN] settings of organization (%s) can't be read: %s", orgID, err)
+	case err != nil:
+		return diag.FromErr(fmt.Errorf(errorOrganizationSettingsRead, orgID, err))
+	default:
+		if err := d.Set("api_access_list_required", settings.GetApiAccessListRequired()); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `api_access_list_required`: %s", err))
+		}
+		if err := d.Set("multi_factor_auth_required", settings.GetMultiFactorAuthRequired()); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `multi_factor_auth_required`: %s", err))
+		}
+		if err := d.Set("restrict_employee_access", settings.GetRestrictEmployeeAccess()); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `restrict_employee_access`: %s", err))
+		}
+	}
+
 	d.SetId(organization.ID)
 
 	return nil
@@ -20,6 +20,9 @@ func TestAccConfigDSOrganization_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.mongodbatlas_organization.test", "name"),
 					resource.TestCheckResourceAttrSet("data.mongodbatlas_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("data.mongodbatlas_organization.test", "multi_factor_auth_required"),
+					resource.TestCheckResourceAttrSet("data.mongodbatlas_organization.test", "api_access_list_required"),
+					resource.TestCheckResourceAttrSet("data.mongodbatlas_organization.test", "restrict_employee_access"),
 				),
 			},
 		},
@@ -2,10 +2,10 @@ package mongodbatlas
 
 import (
 	"context"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 )
 
 func dataSourceMongoDBAtlasPrivateEndpointRegionalMode() *schema.Resource {
@@ -33,7 +33,7 @@ func dataSourceMongoDBAtlasPrivateEndpointRegionalModeRead(ctx context.Context,
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if strings.Contains(err.Error(), "404") {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
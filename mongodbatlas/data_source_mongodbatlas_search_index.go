@@ -87,6 +87,14 @@ func returnSearchIndexDSSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"fields": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -99,8 +107,8 @@ func dataSourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resour
 		return diag.Errorf("project_id, cluster_name and index_id must be configured")
 	}
 
-	connV2 := meta.(*MongoDBClient).AtlasV2
-	searchIndex, _, err := connV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID.(string), clusterName.(string), indexID.(string)).Execute()
+	conn := meta.(*MongoDBClient).Atlas
+	searchIndex, err := getVersionedSearchIndex(ctx, conn, projectID.(string), clusterName.(string), indexID.(string))
 	if err != nil {
 		return diag.Errorf("error getting search index information: %s", err)
 	}
@@ -140,7 +148,7 @@ func dataSourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resour
 		return diag.Errorf("error setting `searchAnalyzer` for search index (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("mappings_dynamic", searchIndex.Mappings.Dynamic); err != nil {
+	if err := d.Set("mappings_dynamic", searchIndex.GetMappings().Dynamic); err != nil {
 		return diag.Errorf("error setting `mappings_dynamic` for search index (%s): %s", d.Id(), err)
 	}
 
@@ -148,7 +156,7 @@ func dataSourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resour
 		return diag.Errorf("error setting `synonyms` for search index (%s): %s", d.Id(), err)
 	}
 
-	if len(searchIndex.Mappings.Fields) > 0 {
+	if len(searchIndex.GetMappings().Fields) > 0 {
 		searchIndexMappingFields, err := marshalSearchIndex(searchIndex.Mappings.Fields)
 		if err != nil {
 			return diag.FromErr(err)
@@ -158,6 +166,20 @@ func dataSourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if err := d.Set("type", searchIndex.Type); err != nil {
+		return diag.Errorf("error setting `type` for search index (%s): %s", d.Id(), err)
+	}
+
+	if len(searchIndex.Fields) > 0 {
+		searchIndexFields, err := marshalSearchIndex(searchIndex.Fields)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("fields", searchIndexFields); err != nil {
+			return diag.Errorf("error setting `fields` for search index (%s): %s", d.Id(), err)
+		}
+	}
+
 	d.SetId(encodeStateID(map[string]string{
 		"project_id":   projectID.(string),
 		"cluster_name": clusterName.(string),
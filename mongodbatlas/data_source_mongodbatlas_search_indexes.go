@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 )
 
 func dataSourceMongoDBAtlasSearchIndexes() *schema.Resource {
@@ -65,8 +64,8 @@ func dataSourceMongoDBAtlasSearchIndexesRead(ctx context.Context, d *schema.Reso
 		return diag.Errorf("project_id, cluster_name, database and collection_name must be configured")
 	}
 
-	connV2 := meta.(*MongoDBClient).AtlasV2
-	searchIndexes, _, err := connV2.AtlasSearchApi.ListAtlasSearchIndexes(ctx, projectID.(string), clusterName.(string), collectionName.(string), databaseName.(string)).Execute()
+	conn := meta.(*MongoDBClient).Atlas
+	searchIndexes, err := listVersionedSearchIndexes(ctx, conn, projectID.(string), clusterName.(string), databaseName.(string), collectionName.(string))
 
 	if err != nil {
 		return diag.Errorf("error getting search indexes information: %s", err)
@@ -90,7 +89,7 @@ func dataSourceMongoDBAtlasSearchIndexesRead(ctx context.Context, d *schema.Reso
 	return nil
 }
 
-func flattenSearchIndexes(searchIndexes []admin.ClusterSearchIndex, projectID, clusterName string) ([]map[string]any, error) {
+func flattenSearchIndexes(searchIndexes []versionedSearchIndex, projectID, clusterName string) ([]map[string]any, error) {
 	var searchIndexesMap []map[string]any
 
 	if len(searchIndexes) == 0 {
@@ -106,14 +105,15 @@ func flattenSearchIndexes(searchIndexes []admin.ClusterSearchIndex, projectID, c
 			"collection_name":  searchIndexes[i].CollectionName,
 			"database":         searchIndexes[i].Database,
 			"index_id":         searchIndexes[i].IndexID,
-			"mappings_dynamic": searchIndexes[i].Mappings.Dynamic,
+			"mappings_dynamic": searchIndexes[i].GetMappings().Dynamic,
 			"name":             searchIndexes[i].Name,
 			"search_analyzer":  searchIndexes[i].SearchAnalyzer,
 			"status":           searchIndexes[i].Status,
 			"synonyms":         flattenSearchIndexSynonyms(searchIndexes[i].Synonyms),
+			"type":             searchIndexes[i].Type,
 		}
 
-		if len(searchIndexes[i].Mappings.Fields) > 0 {
+		if len(searchIndexes[i].GetMappings().Fields) > 0 {
 			searchIndexMappingFields, err := marshalSearchIndex(searchIndexes[i].Mappings.Fields)
 			if err != nil {
 				return nil, err
@@ -128,6 +128,14 @@ func flattenSearchIndexes(searchIndexes []admin.ClusterSearchIndex, projectID, c
 			}
 			searchIndexesMap[i]["analyzers"] = searchIndexAnalyzers
 		}
+
+		if len(searchIndexes[i].Fields) > 0 {
+			searchIndexFields, err := marshalSearchIndex(searchIndexes[i].Fields)
+			if err != nil {
+				return nil, err
+			}
+			searchIndexesMap[i]["fields"] = searchIndexFields
+		}
 	}
 
 	return searchIndexesMap, nil
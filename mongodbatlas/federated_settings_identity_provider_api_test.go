@@ -0,0 +1,106 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+)
+
+func TestFederatedIdentityProviderRequests(t *testing.T) {
+	const (
+		identityProviders = "/api/atlas/v2/federationSettings/fed/identityProviders"
+		idpID             = "65a1b2c3d4e5f6a7b8c9d0e1"
+	)
+	server := atlastest.NewServer()
+	defer server.Close()
+	server.AddRoute(http.MethodPost, identityProviders, federatedIdentityProviderMediaType, func(r *http.Request) (int, any) {
+		var idp federatedIdentityProvider
+		if err := json.NewDecoder(r.Body).Decode(&idp); err != nil {
+			t.Errorf("unexpected error decoding the request: %s", err)
+		}
+		idp.ID = idpID
+		idp.OktaIdpID = "0oa1b2c3d4e5f6g7h8i9"
+		return http.StatusOK, idp
+	})
+	server.AddRoute(http.MethodGet, identityProviders+"/"+idpID, federatedIdentityProviderMediaType,
+		atlastest.Responses(`{"error":404,"errorCode":"RESOURCE_NOT_FOUND"}`))
+	server.AddRoute(http.MethodDelete, identityProviders+"/"+idpID, federatedIdentityProviderMediaType, func(r *http.Request) (int, any) {
+		return http.StatusNoContent, nil
+	})
+	conn := testOfflineClient(server).Atlas
+	ctx := context.Background()
+
+	created, err := createFederatedIdentityProvider(ctx, conn, "fed", &federatedIdentityProvider{
+		Protocol:    identityProviderProtocolOIDC,
+		IdpType:     identityProviderTypeWorkload,
+		DisplayName: "workload",
+		Audience:    "audience",
+		UserClaim:   "sub",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating the identity provider: %s", err)
+	}
+	if created.ID != idpID || created.IdpType != identityProviderTypeWorkload || created.Audience != "audience" {
+		t.Errorf("unexpected identity provider %+v", created)
+	}
+
+	if _, err := getFederatedIdentityProvider(ctx, conn, "fed", created.ID); !apierror.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if err := deleteFederatedIdentityProvider(ctx, conn, "fed", created.ID); err != nil {
+		t.Errorf("unexpected error deleting the identity provider: %s", err)
+	}
+
+	expected := []string{
+		"POST " + identityProviders,
+		"GET " + identityProviders + "/" + idpID,
+		"DELETE " + identityProviders + "/" + idpID,
+	}
+	if requests := server.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestFederatedIdentityProviderReadPemFileInfo(t *testing.T) {
+	const (
+		identityProvider = "/api/atlas/v2/federationSettings/fed/identityProviders/65a1b2c3d4e5f6a7b8c9d0e1"
+		certificate      = "MIIBszCCARygAwIBAgIJAKx2"
+	)
+	server := atlastest.NewServer()
+	defer server.Close()
+	server.AddRoute(http.MethodGet, identityProvider, federatedIdentityProviderMediaType, atlastest.Responses(`{
+		"id": "65a1b2c3d4e5f6a7b8c9d0e1",
+		"protocol": "SAML",
+		"displayName": "saml",
+		"pemFileInfo": {
+			"fileName": "idp.pem",
+			"certificates": [{"content": "`+certificate+`", "notAfter": "2030-01-01T00:00:00Z"}]
+		}
+	}`))
+
+	d := schema.TestResourceDataRaw(t, resourceMongoDBAtlasFederatedSettingsIdentityProvider().Schema, map[string]any{
+		"federation_settings_id": "fed",
+		"pem_file_info": []any{map[string]any{
+			"file_name":    "idp.pem",
+			"certificates": []any{"outdated"},
+		}},
+	})
+	diags := resourceMongoDBAtlasFederatedSettingsIdentityProviderReadByID(context.Background(), d, testOfflineClient(server).Atlas, "fed", "65a1b2c3d4e5f6a7b8c9d0e1")
+	if diags.HasError() {
+		t.Fatalf("unexpected error reading the identity provider: %v", diags)
+	}
+
+	if fileName := d.Get("pem_file_info.0.file_name"); fileName != "idp.pem" {
+		t.Errorf("expected file name idp.pem, got %v", fileName)
+	}
+	if certificates := d.Get("pem_file_info.0.certificates").([]any); len(certificates) != 1 || certificates[0] != certificate {
+		t.Errorf("expected the certificate returned by Atlas, got %v", certificates)
+	}
+}
@@ -0,0 +1,107 @@
+// Package jsontypes implements a string custom type for the plugin framework that holds a JSON document and ignores
+// differences of formatting between values, e.g. whitespace or the order of the keys of objects.
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NormalizedType{}
+	_ basetypes.StringValuableWithSemanticEquals = Normalized{}
+)
+
+// NormalizedType is the attribute type of JSON documents.
+type NormalizedType struct {
+	basetypes.StringType
+}
+
+func (t NormalizedType) String() string {
+	return "jsontypes.NormalizedType"
+}
+
+func (t NormalizedType) ValueType(ctx context.Context) attr.Value {
+	return Normalized{}
+}
+
+func (t NormalizedType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t NormalizedType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Normalized{StringValue: in}, nil
+}
+
+func (t NormalizedType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return Normalized{StringValue: stringValue}, nil
+}
+
+// Normalized is a JSON document, two documents are semantically equal if they decode to the same value.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedValue returns a known JSON document.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewNormalizedNull returns a null JSON document.
+func NewNormalizedNull() Normalized {
+	return Normalized{StringValue: basetypes.NewStringNull()}
+}
+
+func (v Normalized) Type(ctx context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Normalized) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(Normalized)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable))
+		return false, diags
+	}
+	return Equal(v.ValueString(), newValue.ValueString()), diags
+}
+
+// Equal returns true if a and b are valid JSON documents that decode to the same value.
+func Equal(a, b string) bool {
+	var decodedA, decodedB any
+	if err := json.Unmarshal([]byte(a), &decodedA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &decodedB); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
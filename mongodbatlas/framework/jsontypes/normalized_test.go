@@ -0,0 +1,59 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/jsontypes"
+)
+
+func TestNormalizedSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			current:  `[{"$source":{"connectionName":"sample"}}]`,
+			new:      `[{"$source":{"connectionName":"sample"}}]`,
+			expected: true,
+		},
+		{
+			name:     "whitespace and key order",
+			current:  `[{"$merge": {"into": {"db": "test", "coll": "out"}}}]`,
+			new:      "[\n  {\"$merge\":{\"into\":{\"coll\":\"out\",\"db\":\"test\"}}}\n]",
+			expected: true,
+		},
+		{
+			name:     "different values",
+			current:  `[{"$match":{"a":1}}]`,
+			new:      `[{"$match":{"a":2}}]`,
+			expected: false,
+		},
+		{
+			name:     "array order is significant",
+			current:  `[{"$match":{}},{"$project":{}}]`,
+			new:      `[{"$project":{}},{"$match":{}}]`,
+			expected: false,
+		},
+		{
+			name:     "invalid json",
+			current:  `[{"$match":{}}]`,
+			new:      `[{"$match":`,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := jsontypes.NewNormalizedValue(tt.current).StringSemanticEquals(context.Background(), jsontypes.NewNormalizedValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, equal)
+			}
+		})
+	}
+}
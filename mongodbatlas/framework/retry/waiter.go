@@ -0,0 +1,178 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	defaultPollInterval = 10 * time.Second
+	// pollJitter is the fraction of the poll interval randomly added or removed between refreshes, so resources
+	// created together don't keep polling Atlas at the same time.
+	pollJitter = 0.1
+)
+
+// sleep is replaced in tests so polling doesn't take real time.
+var sleep = sleepWithContext
+
+// Waiter polls an asynchronous Atlas operation, like a cluster creation, until it reaches one of the Target states.
+// It can be used from both SDKv2 and framework resources, any function with the signature of an SDKv2
+// retry.StateRefreshFunc can be used as Refresh for a Waiter[any].
+//
+// Refresh errors that are expected to go away, like rate limiting or dropped connections, are logged and the
+// operation is considered to be still pending. Any other error, or a state that is neither pending nor a target,
+// stops waiting.
+type Waiter[T any] struct {
+	// Refresh returns the current state of the operation along with the object it was read from.
+	Refresh func() (T, string, error)
+	// Operation describes what is being waited for in logs and errors, e.g. `creation of cluster "test"`.
+	Operation string
+	// Pending are the states in which the operation is still in progress. If empty any state other than a
+	// target is considered pending.
+	Pending []string
+	// Target are the states in which the operation is done.
+	Target []string
+	// Timeout is how long to wait for a target state, including Delay.
+	Timeout time.Duration
+	// Delay is how long to wait before the first refresh.
+	Delay time.Duration
+	// PollInterval is the approximate time between refreshes, defaults to 10 seconds.
+	PollInterval time.Duration
+}
+
+// Lifecycle describes the states of an object that Atlas creates, updates and deletes asynchronously.
+type Lifecycle struct {
+	// Ready is the state of the object once it's created or updated.
+	Ready string
+	// Deleted is the state reported once the object is deleted, which may be reported as an error or as an empty object.
+	Deleted string
+	// Pending are the states of the object while it's being created or updated.
+	Pending []string
+	// Delay is how long to wait before the first refresh.
+	Delay time.Duration
+	// PollInterval is the approximate time between refreshes.
+	PollInterval time.Duration
+}
+
+// NewWaiter returns a Waiter for an object of the lifecycle to be ready or, if deleted is true, to be deleted. While
+// the object is being deleted it can still be reported as ready.
+func NewWaiter[T any](operation string, lifecycle Lifecycle, refresh func() (T, string, error), deleted bool, timeout time.Duration) *Waiter[T] {
+	waiter := &Waiter[T]{
+		Operation:    operation,
+		Refresh:      refresh,
+		Pending:      lifecycle.Pending,
+		Target:       []string{lifecycle.Ready},
+		Timeout:      timeout,
+		Delay:        lifecycle.Delay,
+		PollInterval: lifecycle.PollInterval,
+	}
+	if deleted {
+		waiter.Pending = append(append([]string(nil), lifecycle.Pending...), lifecycle.Ready)
+		waiter.Target = []string{lifecycle.Deleted}
+	}
+	return waiter
+}
+
+// Wait refreshes the operation until it reaches a target state and returns the object read in that state. It stops
+// early if ctx is canceled.
+func (w *Waiter[T]) Wait(ctx context.Context) (T, error) {
+	var zero T
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	logFields := map[string]any{"operation": w.Operation, "target": w.Target}
+	tflog.Debug(ctx, "Waiting for Atlas operation to complete", logFields)
+
+	lastState := ""
+	if err := sleep(ctx, w.Delay); err != nil {
+		return zero, w.waitError(ctx, err, lastState)
+	}
+
+	for attempt := 1; ; attempt++ {
+		result, state, err := w.Refresh()
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return zero, w.waitError(ctx, ctx.Err(), lastState)
+		case err != nil && apierror.IsRetryable(err):
+			tflog.Warn(ctx, "Transient error while waiting for Atlas operation, will retry", withFields(logFields, "error", err.Error()))
+		case err != nil:
+			return zero, fmt.Errorf("error waiting for %s: %w", w.Operation, err)
+		case contains(w.Target, state):
+			tflog.Debug(ctx, "Atlas operation completed", withFields(logFields, "state", state))
+			return result, nil
+		case len(w.Pending) > 0 && !contains(w.Pending, state):
+			return zero, fmt.Errorf("unexpected state %q while waiting for %s, expected %s", state, w.Operation, strings.Join(w.Target, " or "))
+		default:
+			lastState = state
+			tflog.Trace(ctx, "Atlas operation still in progress", withFields(logFields, "state", state, "attempt", attempt))
+		}
+
+		if err := sleep(ctx, w.nextInterval()); err != nil {
+			return zero, w.waitError(ctx, err, lastState)
+		}
+	}
+}
+
+func (w *Waiter[T]) nextInterval() time.Duration {
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	jitter := time.Duration(float64(interval) * pollJitter)
+	return interval - jitter + time.Duration(rand.Int63n(int64(2*jitter)+1)) //nolint:gosec // jitter does not need a secure source
+}
+
+// waitError reports why waiting stopped, distinguishing the waiter's own timeout from the cancellation of the caller.
+func (w *Waiter[T]) waitError(ctx context.Context, err error, lastState string) error {
+	if lastState == "" {
+		lastState = "unknown"
+	}
+	if err == context.DeadlineExceeded && ctx.Err() == context.DeadlineExceeded && w.Timeout > 0 {
+		return fmt.Errorf("timeout after %s waiting for %s to reach %s (last state: %s)", w.Timeout, w.Operation, strings.Join(w.Target, " or "), lastState)
+	}
+	return fmt.Errorf("stopped waiting for %s (last state: %s): %w", w.Operation, lastState, err)
+}
+
+func withFields(fields map[string]any, keyValues ...any) map[string]any {
+	result := make(map[string]any, len(fields)+len(keyValues)/2)
+	for k, v := range fields {
+		result[k] = v
+	}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		result[keyValues[i].(string)] = keyValues[i+1]
+	}
+	return result
+}
+
+func contains(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
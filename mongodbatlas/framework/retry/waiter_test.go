@@ -0,0 +1,182 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+type refreshStep struct {
+	err   error
+	state string
+}
+
+func TestWaiter(t *testing.T) {
+	sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	defer func() { sleep = sleepWithContext }()
+
+	tests := []struct {
+		name          string
+		wantErr       string
+		steps         []refreshStep
+		pending       []string
+		wantRefreshes int
+	}{
+		{
+			name:          "reaches target",
+			steps:         []refreshStep{{state: "CREATING"}, {state: "CREATING"}, {state: "IDLE"}},
+			pending:       []string{"CREATING"},
+			wantRefreshes: 3,
+		},
+		{
+			name:          "transient errors are pending",
+			steps:         []refreshStep{{state: "CREATING"}, {err: io.ErrUnexpectedEOF}, {state: "IDLE"}},
+			pending:       []string{"CREATING"},
+			wantRefreshes: 3,
+		},
+		{
+			name:          "any state is pending without pending states",
+			steps:         []refreshStep{{state: "UNKNOWN"}, {state: "IDLE"}},
+			wantRefreshes: 2,
+		},
+		{
+			name:          "unexpected state",
+			steps:         []refreshStep{{state: "CREATING"}, {state: "FAILED"}},
+			pending:       []string{"CREATING"},
+			wantRefreshes: 2,
+			wantErr:       `unexpected state "FAILED" while waiting for creation of cluster "test", expected IDLE`,
+		},
+		{
+			name:          "refresh error",
+			steps:         []refreshStep{{err: errors.New("invalid request")}},
+			pending:       []string{"CREATING"},
+			wantRefreshes: 1,
+			wantErr:       `error waiting for creation of cluster "test": invalid request`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refreshes := 0
+			waiter := Waiter[int]{
+				Operation: `creation of cluster "test"`,
+				Pending:   tt.pending,
+				Target:    []string{"IDLE"},
+				Refresh: func() (int, string, error) {
+					step := tt.steps[refreshes]
+					refreshes++
+					return refreshes, step.state, step.err
+				},
+			}
+
+			result, err := waiter.Wait(context.Background())
+			if refreshes != tt.wantRefreshes {
+				t.Errorf("expected %d refreshes, got %d", tt.wantRefreshes, refreshes)
+			}
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil || result != tt.wantRefreshes {
+				t.Errorf("expected result of the last refresh, got %d (error %v)", result, err)
+			}
+		})
+	}
+}
+
+func TestNewWaiter(t *testing.T) {
+	sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	defer func() { sleep = sleepWithContext }()
+
+	lifecycle := Lifecycle{Pending: []string{"UPDATING"}, Ready: "IDLE", Deleted: "DELETED", PollInterval: time.Second}
+	tests := []struct {
+		name    string
+		wantErr string
+		states  []string
+		deleted bool
+	}{
+		{name: "ready", states: []string{"UPDATING", "UPDATING", "IDLE"}},
+		{name: "deleted", states: []string{"IDLE", "UPDATING", "DELETED"}, deleted: true},
+		{name: "deleted while waiting to be ready", states: []string{"UPDATING", "DELETED"},
+			wantErr: `unexpected state "DELETED" while waiting for update of cluster "test", expected IDLE`},
+		{name: "unknown state", states: []string{"UPDATING", "FAILED"}, deleted: true,
+			wantErr: `unexpected state "FAILED" while waiting for update of cluster "test", expected DELETED`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refreshes := 0
+			refresh := func() (string, string, error) {
+				state := tt.states[refreshes]
+				refreshes++
+				return state, state, nil
+			}
+
+			waiter := NewWaiter(`update of cluster "test"`, lifecycle, refresh, tt.deleted, time.Minute)
+			if waiter.PollInterval != lifecycle.PollInterval || waiter.Timeout != time.Minute {
+				t.Errorf("unexpected waiter %+v", waiter)
+			}
+			result, err := waiter.Wait(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil || result != tt.states[len(tt.states)-1] || refreshes != len(tt.states) {
+				t.Errorf("unexpected result %q after %d refreshes, error %v", result, refreshes, err)
+			}
+		})
+	}
+	if len(lifecycle.Pending) != 1 {
+		t.Errorf("expected the pending states of the lifecycle not to change, got %v", lifecycle.Pending)
+	}
+}
+
+func TestWaiterTimeout(t *testing.T) {
+	waiter := Waiter[any]{
+		Operation:    `deletion of cluster "test"`,
+		Target:       []string{"DELETED"},
+		Timeout:      50 * time.Millisecond,
+		PollInterval: time.Millisecond,
+		Refresh: func() (any, string, error) {
+			return "", "DELETING", nil
+		},
+	}
+
+	_, err := waiter.Wait(context.Background())
+	expected := `timeout after 50ms waiting for deletion of cluster "test" to reach DELETED (last state: DELETING)`
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
+
+func TestWaiterCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	waiter := Waiter[any]{
+		Operation: `deletion of cluster "test"`,
+		Target:    []string{"DELETED"},
+		Timeout:   time.Hour,
+		Refresh: func() (any, string, error) {
+			cancel()
+			return "", "DELETING", nil
+		},
+	}
+
+	_, err := waiter.Wait(ctx)
+	if !errors.Is(err, context.Canceled) || !strings.Contains(err.Error(), "last state: DELETING") {
+		t.Errorf("expected cancellation error, got %v", err)
+	}
+}
+
+func TestNextInterval(t *testing.T) {
+	waiter := Waiter[any]{PollInterval: time.Minute}
+	for i := 0; i < 100; i++ {
+		if interval := waiter.nextInterval(); interval < 54*time.Second || interval > 66*time.Second {
+			t.Fatalf("interval %s is out of the jitter range", interval)
+		}
+	}
+}
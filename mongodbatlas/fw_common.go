@@ -31,6 +31,11 @@ func (r *RSCommon) Configure(ctx context.Context, req resource.ConfigureRequest,
 	r.client = client
 }
 
+// contextWithResourceName returns a copy of ctx identifying the resource in the requests sent to Atlas.
+func (r *RSCommon) contextWithResourceName(ctx context.Context) context.Context {
+	return contextWithResourceName(ctx, fmt.Sprintf("%s_%s", providerTypeName, r.resourceName))
+}
+
 // DSCommon is used as an embedded struct for all framework data sources. Implements the following plugin-framework defined functions:
 // - Metadata
 // - Configure
@@ -0,0 +1,186 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+)
+
+const (
+	performanceAdvisorSchemaAdviceDataSourceName = "performance_advisor_schema_advice"
+	errorPerformanceAdvisorSchemaAdvice          = "error getting the schema advice of cluster %s: %s"
+)
+
+var _ datasource.DataSource = &PerformanceAdvisorSchemaAdviceDS{}
+var _ datasource.DataSourceWithConfigure = &PerformanceAdvisorSchemaAdviceDS{}
+
+func NewPerformanceAdvisorSchemaAdviceDS() datasource.DataSource {
+	return &PerformanceAdvisorSchemaAdviceDS{
+		DSCommon: DSCommon{
+			dataSourceName: performanceAdvisorSchemaAdviceDataSourceName,
+		},
+	}
+}
+
+// PerformanceAdvisorSchemaAdviceDS reads the schema recommendations of the Performance Advisor for a cluster, or
+// for the cluster of a process. Atlas computes them from the current workload, so they can't be limited to a time
+// window, and the namespaces are filtered by the provider.
+type PerformanceAdvisorSchemaAdviceDS struct {
+	DSCommon
+}
+
+type tfPerformanceAdvisorSchemaAdviceDSModel struct {
+	ID              types.String                                    `tfsdk:"id"`
+	ProjectID       types.String                                    `tfsdk:"project_id"`
+	ProcessID       types.String                                    `tfsdk:"process_id"`
+	ClusterName     types.String                                    `tfsdk:"cluster_name"`
+	Namespaces      types.List                                      `tfsdk:"namespaces"`
+	Recommendations []tfPerformanceAdvisorSchemaRecommendationModel `tfsdk:"recommendations"`
+}
+
+type tfPerformanceAdvisorSchemaRecommendationModel struct {
+	Recommendation     types.String                                 `tfsdk:"recommendation"`
+	Description        types.String                                 `tfsdk:"description"`
+	AffectedNamespaces []tfPerformanceAdvisorAffectedNamespaceModel `tfsdk:"affected_namespaces"`
+}
+
+type tfPerformanceAdvisorAffectedNamespaceModel struct {
+	Namespace types.String                       `tfsdk:"namespace"`
+	Triggers  []tfPerformanceAdvisorTriggerModel `tfsdk:"triggers"`
+}
+
+type tfPerformanceAdvisorTriggerModel struct {
+	TriggerType types.String `tfsdk:"trigger_type"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *PerformanceAdvisorSchemaAdviceDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := performanceAdvisorDSAttributes(false)
+	// computed when process_id is set
+	attributes["cluster_name"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("process_id")),
+		},
+	}
+	attributes["recommendations"] = schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"recommendation": schema.StringAttribute{
+					Computed: true,
+				},
+				"description": schema.StringAttribute{
+					Computed: true,
+				},
+				"affected_namespaces": schema.ListNestedAttribute{
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"namespace": schema.StringAttribute{
+								Computed: true,
+							},
+							"triggers": schema.ListNestedAttribute{
+								Computed: true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"trigger_type": schema.StringAttribute{
+											Computed: true,
+										},
+										"description": schema.StringAttribute{
+											Computed: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (d *PerformanceAdvisorSchemaAdviceDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var schemaAdviceConfig tfPerformanceAdvisorSchemaAdviceDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &schemaAdviceConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if schemaAdviceConfig.ProcessID.IsNull() && schemaAdviceConfig.ClusterName.IsNull() {
+		resp.Diagnostics.AddError(errorMissingAttributesSummary, errorPerformanceAdvisorTarget)
+		return
+	}
+
+	projectID := schemaAdviceConfig.ProjectID.ValueString()
+	processID := schemaAdviceConfig.ProcessID.ValueString()
+	clusterName, err := getPerformanceAdvisorClusterName(ctx, d.client.AtlasV2, projectID, processID, schemaAdviceConfig.ClusterName.ValueString())
+	if err != nil {
+		target := performanceAdvisorTarget(processID, "")
+		resp.Diagnostics.AddError("error fetching processes", fmt.Sprintf(errorPerformanceAdvisorProcesses, target, projectID, err))
+		return
+	}
+
+	advice, err := getSchemaAdvice(ctx, d.client.Atlas, projectID, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching results", fmt.Sprintf(errorPerformanceAdvisorSchemaAdvice, clusterName, err))
+		return
+	}
+
+	namespaces := conversion.TypesListToString(ctx, schemaAdviceConfig.Namespaces)
+	schemaAdviceConfig.ID = types.StringValue(performanceAdvisorStateID(projectID, processID, schemaAdviceConfig.ClusterName.ValueString()))
+	schemaAdviceConfig.ClusterName = types.StringValue(clusterName)
+	schemaAdviceConfig.Recommendations = newTFPerformanceAdvisorSchemaRecommendations(advice.Content.Recommendations, namespaces)
+	resp.Diagnostics.Append(resp.State.Set(ctx, schemaAdviceConfig)...)
+}
+
+// newTFPerformanceAdvisorSchemaRecommendations returns the recommendations affecting any of namespaces, or all of
+// them if namespaces is empty, with only the affected namespaces that are part of namespaces.
+func newTFPerformanceAdvisorSchemaRecommendations(recommendations []schemaAdviceRecommendation, namespaces []string) []tfPerformanceAdvisorSchemaRecommendationModel {
+	selected := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		selected[namespace] = true
+	}
+
+	models := []tfPerformanceAdvisorSchemaRecommendationModel{}
+	for i := range recommendations {
+		recommendation := &recommendations[i]
+		model := tfPerformanceAdvisorSchemaRecommendationModel{
+			Recommendation:     types.StringValue(recommendation.Recommendation),
+			Description:        types.StringValue(recommendation.Description),
+			AffectedNamespaces: []tfPerformanceAdvisorAffectedNamespaceModel{},
+		}
+		for _, affected := range recommendation.AffectedNamespaces {
+			if len(selected) > 0 && !selected[affected.Namespace] {
+				continue
+			}
+			namespace := tfPerformanceAdvisorAffectedNamespaceModel{
+				Namespace: types.StringValue(affected.Namespace),
+				Triggers:  make([]tfPerformanceAdvisorTriggerModel, len(affected.Triggers)),
+			}
+			for j, trigger := range affected.Triggers {
+				namespace.Triggers[j] = tfPerformanceAdvisorTriggerModel{
+					TriggerType: types.StringValue(trigger.TriggerType),
+					Description: types.StringValue(trigger.Description),
+				}
+			}
+			model.AffectedNamespaces = append(model.AffectedNamespaces, namespace)
+		}
+		if len(selected) > 0 && len(model.AffectedNamespaces) == 0 {
+			continue
+		}
+		models = append(models, model)
+	}
+	return models
+}
@@ -0,0 +1,85 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPerformanceAdvisorSchemaAdviceDS_offline(t *testing.T) {
+	server, projectID := testPerformanceAdvisorServer(t)
+	dataSourceName := "data.mongodbatlas_performance_advisor_schema_advice.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + fmt.Sprintf(`
+					data "mongodbatlas_performance_advisor_suggested_indexes" "test" {
+						project_id   = %[1]q
+						cluster_name = %[2]q
+					}
+
+					data "mongodbatlas_performance_advisor_schema_advice" "test" {
+						project_id = %[1]q
+						process_id = data.mongodbatlas_performance_advisor_suggested_indexes.test.suggested_indexes[0].process_id
+						namespaces = ["store.products"]
+					}
+				`, projectID, performanceAdvisorClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_name", performanceAdvisorClusterName),
+					resource.TestCheckResourceAttr(dataSourceName, "recommendations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "recommendations.0.recommendation", "REDUCE_LOOKUP_OPS"),
+					resource.TestCheckResourceAttr(dataSourceName, "recommendations.0.affected_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "recommendations.0.affected_namespaces.0.namespace", "store.products"),
+					resource.TestCheckResourceAttr(dataSourceName, "recommendations.0.affected_namespaces.0.triggers.0.trigger_type", "NUMBER_OF_QUERIES_USE_LOOKUP"),
+				),
+			},
+		},
+	})
+}
+
+func TestNewTFPerformanceAdvisorSchemaRecommendations(t *testing.T) {
+	recommendations := []schemaAdviceRecommendation{
+		{
+			Recommendation: "REDUCE_LOOKUP_OPS",
+			AffectedNamespaces: []schemaAdviceNamespace{
+				{Namespace: "store.orders", Triggers: []schemaAdviceTrigger{{TriggerType: "PERCENT_QUERIES_USE_LOOKUP"}}},
+				{Namespace: "store.products"},
+			},
+		},
+		{
+			Recommendation:     "AVOID_UNBOUNDED_ARRAY",
+			AffectedNamespaces: []schemaAdviceNamespace{{Namespace: "store.carts"}},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		namespaces         []string
+		expected           []string
+		expectedNamespaces int
+	}{
+		{name: "all namespaces", expected: []string{"REDUCE_LOOKUP_OPS", "AVOID_UNBOUNDED_ARRAY"}, expectedNamespaces: 2},
+		{name: "one namespace", namespaces: []string{"store.orders"}, expected: []string{"REDUCE_LOOKUP_OPS"}, expectedNamespaces: 1},
+		{name: "unaffected namespace", namespaces: []string{"store.users"}, expected: []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			models := newTFPerformanceAdvisorSchemaRecommendations(recommendations, tc.namespaces)
+			if models == nil || len(models) != len(tc.expected) {
+				t.Fatalf("expected recommendations %v, got %+v", tc.expected, models)
+			}
+			for i, model := range models {
+				if model.Recommendation.ValueString() != tc.expected[i] {
+					t.Errorf("expected recommendation %s, got %s", tc.expected[i], model.Recommendation.ValueString())
+				}
+			}
+			if len(models) > 0 && len(models[0].AffectedNamespaces) != tc.expectedNamespaces {
+				t.Errorf("expected %d affected namespaces, got %+v", tc.expectedNamespaces, models[0].AffectedNamespaces)
+			}
+		})
+	}
+}
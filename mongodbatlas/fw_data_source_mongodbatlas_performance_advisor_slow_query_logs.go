@@ -0,0 +1,126 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	performanceAdvisorSlowQueryLogsDataSourceName = "performance_advisor_slow_query_logs"
+	errorPerformanceAdvisorSlowQueryLogs          = "error getting the slow query logs of process %s: %s"
+)
+
+var _ datasource.DataSource = &PerformanceAdvisorSlowQueryLogsDS{}
+var _ datasource.DataSourceWithConfigure = &PerformanceAdvisorSlowQueryLogsDS{}
+
+func NewPerformanceAdvisorSlowQueryLogsDS() datasource.DataSource {
+	return &PerformanceAdvisorSlowQueryLogsDS{
+		DSCommon: DSCommon{
+			dataSourceName: performanceAdvisorSlowQueryLogsDataSourceName,
+		},
+	}
+}
+
+// PerformanceAdvisorSlowQueryLogsDS reads the log lines of the slow queries of a process, or of the primaries of a
+// cluster, as analyzed by the Performance Advisor.
+type PerformanceAdvisorSlowQueryLogsDS struct {
+	DSCommon
+}
+
+type tfPerformanceAdvisorSlowQueryLogsDSModel struct {
+	ID          types.String                         `tfsdk:"id"`
+	ProjectID   types.String                         `tfsdk:"project_id"`
+	ProcessID   types.String                         `tfsdk:"process_id"`
+	ClusterName types.String                         `tfsdk:"cluster_name"`
+	Namespaces  types.List                           `tfsdk:"namespaces"`
+	SlowQueries []tfPerformanceAdvisorSlowQueryModel `tfsdk:"slow_queries"`
+	Since       types.Int64                          `tfsdk:"since"`
+	Duration    types.Int64                          `tfsdk:"duration"`
+}
+
+type tfPerformanceAdvisorSlowQueryModel struct {
+	ProcessID types.String `tfsdk:"process_id"`
+	Namespace types.String `tfsdk:"namespace"`
+	Line      types.String `tfsdk:"line"`
+}
+
+func (d *PerformanceAdvisorSlowQueryLogsDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := performanceAdvisorDSAttributes(true)
+	attributes["slow_queries"] = schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"process_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"namespace": schema.StringAttribute{
+					Computed: true,
+				},
+				"line": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (d *PerformanceAdvisorSlowQueryLogsDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	connV2 := d.client.AtlasV2
+
+	var slowQueryLogsConfig tfPerformanceAdvisorSlowQueryLogsDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &slowQueryLogsConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if slowQueryLogsConfig.ProcessID.IsNull() && slowQueryLogsConfig.ClusterName.IsNull() {
+		resp.Diagnostics.AddError(errorMissingAttributesSummary, errorPerformanceAdvisorTarget)
+		return
+	}
+
+	projectID := slowQueryLogsConfig.ProjectID.ValueString()
+	processID := slowQueryLogsConfig.ProcessID.ValueString()
+	clusterName := slowQueryLogsConfig.ClusterName.ValueString()
+	processIDs, err := listPerformanceAdvisorProcessIDs(ctx, connV2, projectID, processID, clusterName)
+	if err != nil {
+		target := performanceAdvisorTarget(processID, clusterName)
+		resp.Diagnostics.AddError("error fetching processes", fmt.Sprintf(errorPerformanceAdvisorProcesses, target, projectID, err))
+		return
+	}
+
+	namespaces := conversion.TypesListToString(ctx, slowQueryLogsConfig.Namespaces)
+	slowQueryLogsConfig.SlowQueries = []tfPerformanceAdvisorSlowQueryModel{}
+	for _, id := range processIDs {
+		request := connV2.PerformanceAdvisorApi.ListSlowQueries(ctx, projectID, id)
+		request = withPerformanceAdvisorFilters(request, namespaces, slowQueryLogsConfig.Since, slowQueryLogsConfig.Duration)
+		response, _, err := request.Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("error fetching results", fmt.Sprintf(errorPerformanceAdvisorSlowQueryLogs, id, err))
+			return
+		}
+		slowQueryLogsConfig.SlowQueries = append(slowQueryLogsConfig.SlowQueries, newTFPerformanceAdvisorSlowQueries(id, response)...)
+	}
+
+	slowQueryLogsConfig.ID = types.StringValue(performanceAdvisorStateID(projectID, processID, clusterName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, slowQueryLogsConfig)...)
+}
+
+func newTFPerformanceAdvisorSlowQueries(processID string, response *admin.PerformanceAdvisorSlowQueryList) []tfPerformanceAdvisorSlowQueryModel {
+	slowQueries := make([]tfPerformanceAdvisorSlowQueryModel, len(response.SlowQueries))
+	for i := range response.SlowQueries {
+		slowQueries[i] = tfPerformanceAdvisorSlowQueryModel{
+			ProcessID: types.StringValue(processID),
+			Namespace: types.StringPointerValue(response.SlowQueries[i].Namespace),
+			Line:      types.StringPointerValue(response.SlowQueries[i].Line),
+		}
+	}
+	return slowQueries
+}
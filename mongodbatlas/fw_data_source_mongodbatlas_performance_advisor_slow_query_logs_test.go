@@ -0,0 +1,53 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPerformanceAdvisorSlowQueryLogsDS_offline(t *testing.T) {
+	server, projectID := testPerformanceAdvisorServer(t)
+	processIDs, err := listPerformanceAdvisorProcessIDs(context.Background(), testOfflineClient(server).AtlasV2, projectID, "", performanceAdvisorClusterName)
+	if err != nil {
+		t.Fatalf("unexpected error getting the primary: %s", err)
+	}
+	dataSourceName := "data.mongodbatlas_performance_advisor_slow_query_logs.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + fmt.Sprintf(`
+					data "mongodbatlas_performance_advisor_slow_query_logs" "test" {
+						project_id = %[1]q
+						process_id = %[2]q
+						since      = 1697500000000
+					}
+				`, projectID, processIDs[0]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "slow_queries.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "slow_queries.0.process_id", processIDs[0]),
+					resource.TestCheckResourceAttr(dataSourceName, "slow_queries.0.namespace", "store.orders"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "slow_queries.0.line"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + fmt.Sprintf(`
+					data "mongodbatlas_performance_advisor_slow_query_logs" "test" {
+						project_id   = %[1]q
+						cluster_name = %[2]q
+						namespaces   = ["store.products"]
+					}
+				`, projectID, performanceAdvisorClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "slow_queries.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "slow_queries.0.namespace", "store.products"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,383 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	performanceAdvisorSuggestedIndexesDataSourceName = "performance_advisor_suggested_indexes"
+	errorPerformanceAdvisorTarget                    = "either process_id or cluster_name must be configured"
+	errorPerformanceAdvisorProcesses                 = "error getting the processes of %s in project %s: %s"
+	errorPerformanceAdvisorSuggestedIndexes          = "error getting the suggested indexes of process %s: %s"
+)
+
+var _ datasource.DataSource = &PerformanceAdvisorSuggestedIndexesDS{}
+var _ datasource.DataSourceWithConfigure = &PerformanceAdvisorSuggestedIndexesDS{}
+
+func NewPerformanceAdvisorSuggestedIndexesDS() datasource.DataSource {
+	return &PerformanceAdvisorSuggestedIndexesDS{
+		DSCommon: DSCommon{
+			dataSourceName: performanceAdvisorSuggestedIndexesDataSourceName,
+		},
+	}
+}
+
+// PerformanceAdvisorSuggestedIndexesDS reads the indexes the Performance Advisor suggests for a process, or for the
+// primaries of a cluster, and the query shapes that would benefit from them.
+type PerformanceAdvisorSuggestedIndexesDS struct {
+	DSCommon
+}
+
+type tfPerformanceAdvisorSuggestedIndexesDSModel struct {
+	ID               types.String                          `tfsdk:"id"`
+	ProjectID        types.String                          `tfsdk:"project_id"`
+	ProcessID        types.String                          `tfsdk:"process_id"`
+	ClusterName      types.String                          `tfsdk:"cluster_name"`
+	Namespaces       types.List                            `tfsdk:"namespaces"`
+	SuggestedIndexes []tfPerformanceAdvisorIndexModel      `tfsdk:"suggested_indexes"`
+	Shapes           []tfPerformanceAdvisorQueryShapeModel `tfsdk:"shapes"`
+	Since            types.Int64                           `tfsdk:"since"`
+	Duration         types.Int64                           `tfsdk:"duration"`
+}
+
+type tfPerformanceAdvisorIndexModel struct {
+	ID         types.String                          `tfsdk:"id"`
+	ProcessID  types.String                          `tfsdk:"process_id"`
+	Namespace  types.String                          `tfsdk:"namespace"`
+	Index      []tfPerformanceAdvisorIndexFieldModel `tfsdk:"index"`
+	Impact     []types.String                        `tfsdk:"impact"`
+	Weight     types.Float64                         `tfsdk:"weight"`
+	AvgObjSize types.Float64                         `tfsdk:"avg_obj_size"`
+}
+
+type tfPerformanceAdvisorIndexFieldModel struct {
+	Field     types.String `tfsdk:"field"`
+	Direction types.Int64  `tfsdk:"direction"`
+}
+
+type tfPerformanceAdvisorQueryShapeModel struct {
+	ID                types.String                         `tfsdk:"id"`
+	ProcessID         types.String                         `tfsdk:"process_id"`
+	Namespace         types.String                         `tfsdk:"namespace"`
+	Operations        []tfPerformanceAdvisorOperationModel `tfsdk:"operations"`
+	AvgMs             types.Int64                          `tfsdk:"avg_ms"`
+	Count             types.Int64                          `tfsdk:"count"`
+	InefficiencyScore types.Int64                          `tfsdk:"inefficiency_score"`
+}
+
+type tfPerformanceAdvisorOperationModel struct {
+	Predicates []types.String `tfsdk:"predicates"`
+	Ms         types.Int64    `tfsdk:"ms"`
+	NReturned  types.Int64    `tfsdk:"n_returned"`
+	NScanned   types.Int64    `tfsdk:"n_scanned"`
+	Ts         types.Int64    `tfsdk:"ts"`
+}
+
+func (d *PerformanceAdvisorSuggestedIndexesDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := performanceAdvisorDSAttributes(true)
+	attributes["suggested_indexes"] = schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+				},
+				"process_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"namespace": schema.StringAttribute{
+					Computed: true,
+				},
+				"index": schema.ListNestedAttribute{
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"field": schema.StringAttribute{
+								Computed: true,
+							},
+							"direction": schema.Int64Attribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+				"impact": schema.ListAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+				"weight": schema.Float64Attribute{
+					Computed: true,
+				},
+				"avg_obj_size": schema.Float64Attribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+	attributes["shapes"] = schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+				},
+				"process_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"namespace": schema.StringAttribute{
+					Computed: true,
+				},
+				"avg_ms": schema.Int64Attribute{
+					Computed: true,
+				},
+				"count": schema.Int64Attribute{
+					Computed: true,
+				},
+				"inefficiency_score": schema.Int64Attribute{
+					Computed: true,
+				},
+				"operations": schema.ListNestedAttribute{
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"predicates": schema.ListAttribute{
+								Computed:    true,
+								ElementType: types.StringType,
+							},
+							"ms": schema.Int64Attribute{
+								Computed: true,
+							},
+							"n_returned": schema.Int64Attribute{
+								Computed: true,
+							},
+							"n_scanned": schema.Int64Attribute{
+								Computed: true,
+							},
+							"ts": schema.Int64Attribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (d *PerformanceAdvisorSuggestedIndexesDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	connV2 := d.client.AtlasV2
+
+	var suggestedIndexesConfig tfPerformanceAdvisorSuggestedIndexesDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &suggestedIndexesConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if suggestedIndexesConfig.ProcessID.IsNull() && suggestedIndexesConfig.ClusterName.IsNull() {
+		resp.Diagnostics.AddError(errorMissingAttributesSummary, errorPerformanceAdvisorTarget)
+		return
+	}
+
+	projectID := suggestedIndexesConfig.ProjectID.ValueString()
+	processID := suggestedIndexesConfig.ProcessID.ValueString()
+	clusterName := suggestedIndexesConfig.ClusterName.ValueString()
+	processIDs, err := listPerformanceAdvisorProcessIDs(ctx, connV2, projectID, processID, clusterName)
+	if err != nil {
+		target := performanceAdvisorTarget(processID, clusterName)
+		resp.Diagnostics.AddError("error fetching processes", fmt.Sprintf(errorPerformanceAdvisorProcesses, target, projectID, err))
+		return
+	}
+
+	namespaces := conversion.TypesListToString(ctx, suggestedIndexesConfig.Namespaces)
+	suggestedIndexesConfig.SuggestedIndexes = []tfPerformanceAdvisorIndexModel{}
+	suggestedIndexesConfig.Shapes = []tfPerformanceAdvisorQueryShapeModel{}
+	for _, id := range processIDs {
+		request := connV2.PerformanceAdvisorApi.ListSuggestedIndexes(ctx, projectID, id)
+		request = withPerformanceAdvisorFilters(request, namespaces, suggestedIndexesConfig.Since, suggestedIndexesConfig.Duration)
+		response, _, err := request.Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("error fetching results", fmt.Sprintf(errorPerformanceAdvisorSuggestedIndexes, id, err))
+			return
+		}
+		indexes, shapes, err := newTFPerformanceAdvisorSuggestedIndexes(id, response)
+		if err != nil {
+			resp.Diagnostics.AddError("error fetching results", fmt.Sprintf(errorPerformanceAdvisorSuggestedIndexes, id, err))
+			return
+		}
+		suggestedIndexesConfig.SuggestedIndexes = append(suggestedIndexesConfig.SuggestedIndexes, indexes...)
+		suggestedIndexesConfig.Shapes = append(suggestedIndexesConfig.Shapes, shapes...)
+	}
+
+	suggestedIndexesConfig.ID = types.StringValue(performanceAdvisorStateID(projectID, processID, clusterName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, suggestedIndexesConfig)...)
+}
+
+func newTFPerformanceAdvisorSuggestedIndexes(processID string, response *admin.PerformanceAdvisorResponse) ([]tfPerformanceAdvisorIndexModel, []tfPerformanceAdvisorQueryShapeModel, error) {
+	indexes := make([]tfPerformanceAdvisorIndexModel, len(response.SuggestedIndexes))
+	for i := range response.SuggestedIndexes {
+		index := &response.SuggestedIndexes[i]
+		model := tfPerformanceAdvisorIndexModel{
+			ID:         types.StringPointerValue(index.Id),
+			ProcessID:  types.StringValue(processID),
+			Namespace:  types.StringPointerValue(index.Namespace),
+			Index:      make([]tfPerformanceAdvisorIndexFieldModel, 0, len(index.Index)),
+			Impact:     make([]types.String, len(index.Impact)),
+			Weight:     types.Float64PointerValue(index.Weight),
+			AvgObjSize: types.Float64PointerValue(index.AvgObjSize),
+		}
+		// every element of the index holds a single field, the map keys are sorted only to be deterministic
+		for _, key := range index.Index {
+			fields := make([]string, 0, len(key))
+			for field := range key {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			for _, field := range fields {
+				model.Index = append(model.Index, tfPerformanceAdvisorIndexFieldModel{
+					Field:     types.StringValue(field),
+					Direction: types.Int64Value(int64(key[field])),
+				})
+			}
+		}
+		for j, impact := range index.Impact {
+			model.Impact[j] = types.StringValue(impact)
+		}
+		indexes[i] = model
+	}
+
+	shapes := make([]tfPerformanceAdvisorQueryShapeModel, len(response.Shapes))
+	for i := range response.Shapes {
+		shape := &response.Shapes[i]
+		model := tfPerformanceAdvisorQueryShapeModel{
+			ID:                types.StringPointerValue(shape.Id),
+			ProcessID:         types.StringValue(processID),
+			Namespace:         types.StringPointerValue(shape.Namespace),
+			AvgMs:             types.Int64PointerValue(shape.AvgMs),
+			Count:             types.Int64PointerValue(shape.Count),
+			InefficiencyScore: types.Int64PointerValue(shape.InefficiencyScore),
+			Operations:        make([]tfPerformanceAdvisorOperationModel, len(shape.Operations)),
+		}
+		for j := range shape.Operations {
+			operation := &shape.Operations[j]
+			opModel := tfPerformanceAdvisorOperationModel{
+				Predicates: make([]types.String, len(operation.Predicates)),
+				Ms:         types.Int64Null(),
+				NReturned:  types.Int64Null(),
+				NScanned:   types.Int64Null(),
+				Ts:         types.Int64Null(),
+			}
+			for k, predicate := range operation.Predicates {
+				predicateJSON, err := json.Marshal(predicate)
+				if err != nil {
+					return nil, nil, err
+				}
+				opModel.Predicates[k] = types.StringValue(string(predicateJSON))
+			}
+			if stats := operation.Stats; stats != nil {
+				opModel.Ms = types.Int64PointerValue(stats.Ms)
+				opModel.NReturned = types.Int64PointerValue(stats.NReturned)
+				opModel.NScanned = types.Int64PointerValue(stats.NScanned)
+				opModel.Ts = types.Int64PointerValue(stats.Ts)
+			}
+			model.Operations[j] = opModel
+		}
+		shapes[i] = model
+	}
+	return indexes, shapes, nil
+}
+
+// performanceAdvisorRequest is implemented by the requests of the Performance Advisor that can be filtered by
+// namespace and time window.
+type performanceAdvisorRequest[T any] interface {
+	Namespaces(namespaces []string) T
+	Since(since int64) T
+	Duration(duration int64) T
+}
+
+func withPerformanceAdvisorFilters[T performanceAdvisorRequest[T]](request T, namespaces []string, since, duration types.Int64) T {
+	if len(namespaces) > 0 {
+		request = request.Namespaces(namespaces)
+	}
+	if !since.IsNull() {
+		request = request.Since(since.ValueInt64())
+	}
+	if !duration.IsNull() {
+		request = request.Duration(duration.ValueInt64())
+	}
+	return request
+}
+
+// performanceAdvisorDSAttributes returns the attributes selecting the process or cluster the Performance Advisor
+// data sources read the advice of. With timeWindow the advice can also be limited to a time window.
+func performanceAdvisorDSAttributes(timeWindow bool) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"project_id": schema.StringAttribute{
+			Required: true,
+		},
+		"process_id": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("cluster_name")),
+			},
+		},
+		"cluster_name": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("process_id")),
+			},
+		},
+		"namespaces": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+	if timeWindow {
+		attributes["since"] = schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		}
+		attributes["duration"] = schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		}
+	}
+	return attributes
+}
+
+// performanceAdvisorTarget describes the process or cluster a Performance Advisor data source reads, for error
+// messages.
+func performanceAdvisorTarget(processID, clusterName string) string {
+	if processID != "" {
+		return fmt.Sprintf("process %q", processID)
+	}
+	return fmt.Sprintf("cluster %q", clusterName)
+}
+
+func performanceAdvisorStateID(projectID, processID, clusterName string) string {
+	if processID != "" {
+		return encodeStateID(map[string]string{"project_id": projectID, "process_id": processID})
+	}
+	return encodeStateID(map[string]string{"project_id": projectID, "cluster_name": clusterName})
+}
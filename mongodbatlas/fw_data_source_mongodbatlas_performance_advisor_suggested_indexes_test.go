@@ -0,0 +1,149 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const performanceAdvisorClusterName = "offline-cluster"
+
+func TestPerformanceAdvisorSuggestedIndexesDS_offline(t *testing.T) {
+	server, projectID := testPerformanceAdvisorServer(t)
+	dataSourceName := "data.mongodbatlas_performance_advisor_suggested_indexes.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + fmt.Sprintf(`
+					data "mongodbatlas_performance_advisor_suggested_indexes" "test" {
+						project_id   = %[1]q
+						cluster_name = %[2]q
+						namespaces   = ["store.orders"]
+						duration     = 86400000
+					}
+				`, projectID, performanceAdvisorClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "suggested_indexes.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "suggested_indexes.0.process_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "suggested_indexes.0.namespace", "store.orders"),
+					resource.TestCheckResourceAttr(dataSourceName, "suggested_indexes.0.index.0.field", "customerId"),
+					resource.TestCheckResourceAttr(dataSourceName, "suggested_indexes.0.index.1.direction", "-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "shapes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "shapes.0.operations.0.predicates.0", `{"find":{"customerId":"?"}}`),
+					resource.TestCheckResourceAttr(dataSourceName, "shapes.0.operations.0.n_scanned", "5000"),
+				),
+			},
+		},
+	})
+}
+
+func TestNewTFPerformanceAdvisorSuggestedIndexes(t *testing.T) {
+	response := &admin.PerformanceAdvisorResponse{
+		SuggestedIndexes: []admin.PerformanceAdvisorIndex{{
+			Id:        admin.PtrString("65a1b2c3d4e5f6a7b8c9d0e1"),
+			Namespace: admin.PtrString("store.orders"),
+			Index:     []map[string]int{{"customerId": 1}, {"createdAt": -1}},
+			Impact:    []string{"1234"},
+			Weight:    admin.PtrFloat64(0.5),
+		}},
+		Shapes: []admin.PerformanceAdvisorShape{{
+			Id:         admin.PtrString("65a1b2c3d4e5f6a7b8c9d0e2"),
+			Namespace:  admin.PtrString("store.orders"),
+			Operations: []admin.PerformanceAdvisorOperation{{Predicates: []map[string]any{{"find": map[string]any{"customerId": "?"}}}}},
+		}},
+	}
+
+	indexes, shapes, err := newTFPerformanceAdvisorSuggestedIndexes("host:27017", response)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(indexes) != 1 || len(shapes) != 1 {
+		t.Fatalf("expected an index and a shape, got %+v and %+v", indexes, shapes)
+	}
+	index := indexes[0]
+	expectedFields := []tfPerformanceAdvisorIndexFieldModel{
+		{Field: types.StringValue("customerId"), Direction: types.Int64Value(1)},
+		{Field: types.StringValue("createdAt"), Direction: types.Int64Value(-1)},
+	}
+	if index.ProcessID.ValueString() != "host:27017" || len(index.Index) != 2 || index.Index[0] != expectedFields[0] || index.Index[1] != expectedFields[1] {
+		t.Errorf("unexpected index %+v", index)
+	}
+	if !index.AvgObjSize.IsNull() || index.Weight.ValueFloat64() != 0.5 {
+		t.Errorf("expected the weight and a null average object size, got %+v", index)
+	}
+	operation := shapes[0].Operations[0]
+	if len(operation.Predicates) != 1 || operation.Predicates[0].ValueString() != `{"find":{"customerId":"?"}}` || !operation.Ms.IsNull() {
+		t.Errorf("unexpected operation %+v", operation)
+	}
+}
+
+// testPerformanceAdvisorServer returns an atlastest server with a project and a cluster reporting suggested indexes,
+// slow queries and schema advice for two namespaces.
+func testPerformanceAdvisorServer(t *testing.T) (server *atlastest.Server, projectID string) {
+	t.Helper()
+	server = atlastest.NewServer()
+	t.Cleanup(server.Close)
+
+	projectID = server.CreateProject(offlineOrgID, "offline-project")
+	if err := server.CreateCluster(projectID, &matlas.AdvancedCluster{Name: performanceAdvisorClusterName}); err != nil {
+		t.Fatalf("unexpected error seeding cluster: %s", err)
+	}
+	err := server.SetPerformanceAdvice(projectID, performanceAdvisorClusterName, &atlastest.PerformanceAdvice{
+		SuggestedIndexes: []admin.PerformanceAdvisorIndex{
+			{
+				Id:        admin.PtrString("65a1b2c3d4e5f6a7b8c9d0e1"),
+				Namespace: admin.PtrString("store.orders"),
+				Index:     []map[string]int{{"customerId": 1}, {"createdAt": -1}},
+				Impact:    []string{"65a1b2c3d4e5f6a7b8c9d0e3"},
+				Weight:    admin.PtrFloat64(1250.5),
+			},
+			{
+				Id:        admin.PtrString("65a1b2c3d4e5f6a7b8c9d0e2"),
+				Namespace: admin.PtrString("store.products"),
+				Index:     []map[string]int{{"sku": 1}},
+			},
+		},
+		Shapes: []admin.PerformanceAdvisorShape{{
+			Id:                admin.PtrString("65a1b2c3d4e5f6a7b8c9d0e3"),
+			Namespace:         admin.PtrString("store.orders"),
+			AvgMs:             admin.PtrInt64(420),
+			Count:             admin.PtrInt64(12),
+			InefficiencyScore: admin.PtrInt64(5000),
+			Operations: []admin.PerformanceAdvisorOperation{{
+				Predicates: []map[string]any{{"find": map[string]any{"customerId": "?"}}},
+				Stats:      &admin.PerformanceAdvisorOpStats{Ms: admin.PtrInt64(420), NReturned: admin.PtrInt64(1), NScanned: admin.PtrInt64(5000), Ts: admin.PtrInt64(1697500000000)},
+			}},
+		}},
+		SlowQueries: []admin.PerformanceAdvisorSlowQuery{
+			{Namespace: admin.PtrString("store.orders"), Line: admin.PtrString(`{"t":{"$date":"2023-10-17T00:00:00.000Z"},"msg":"Slow query","attr":{"ns":"store.orders","durationMillis":420}}`)},
+			{Namespace: admin.PtrString("store.products"), Line: admin.PtrString(`{"t":{"$date":"2023-10-17T00:00:01.000Z"},"msg":"Slow query","attr":{"ns":"store.products","durationMillis":150}}`)},
+		},
+		SchemaAdvice: []atlastest.SchemaAdviceRecommendation{{
+			Recommendation: "REDUCE_LOOKUP_OPS",
+			Description:    "Reduce the use of $lookup operations",
+			AffectedNamespaces: []atlastest.SchemaAdviceNamespace{
+				{
+					Namespace: "store.orders",
+					Triggers:  []atlastest.SchemaAdviceTrigger{{TriggerType: "PERCENT_QUERIES_USE_LOOKUP", Description: "50% of queries use $lookup"}},
+				},
+				{
+					Namespace: "store.products",
+					Triggers:  []atlastest.SchemaAdviceTrigger{{TriggerType: "NUMBER_OF_QUERIES_USE_LOOKUP", Description: "100 queries use $lookup"}},
+				},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error seeding performance advice: %s", err)
+	}
+	return server, projectID
+}
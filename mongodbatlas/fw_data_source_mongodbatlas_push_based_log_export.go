@@ -0,0 +1,90 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PushBasedLogExportDS{}
+var _ datasource.DataSourceWithConfigure = &PushBasedLogExportDS{}
+
+func NewPushBasedLogExportDS() datasource.DataSource {
+	return &PushBasedLogExportDS{
+		DSCommon: DSCommon{
+			dataSourceName: pushBasedLogExportName,
+		},
+	}
+}
+
+type PushBasedLogExportDS struct {
+	DSCommon
+}
+
+type tfPushBasedLogExportDSModel struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectID  types.String `tfsdk:"project_id"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	IamRoleID  types.String `tfsdk:"iam_role_id"`
+	PrefixPath types.String `tfsdk:"prefix_path"`
+	State      types.String `tfsdk:"state"`
+	CreateDate types.String `tfsdk:"create_date"`
+}
+
+func (d *PushBasedLogExportDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+			},
+			"bucket_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"iam_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"prefix_path": schema.StringAttribute{
+				Computed: true,
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"create_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *PushBasedLogExportDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var logExportConfig tfPushBasedLogExportDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &logExportConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := logExportConfig.ProjectID.ValueString()
+	logExport, _, err := d.client.AtlasV2.PushBasedLogExportApi.GetPushBasedLogConfiguration(ctx, projectID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorPushBasedLogExportRead, projectID, err))
+		return
+	}
+
+	model := newTFPushBasedLogExportModel(projectID, logExport, timeouts.Value{})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfPushBasedLogExportDSModel{
+		ID:         model.ID,
+		ProjectID:  model.ProjectID,
+		BucketName: model.BucketName,
+		IamRoleID:  model.IamRoleID,
+		PrefixPath: model.PrefixPath,
+		State:      model.State,
+		CreateDate: model.CreateDate,
+	})...)
+}
@@ -0,0 +1,182 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/catalog"
+)
+
+const regionsDataSourceName = "regions"
+
+var _ datasource.DataSource = &RegionsDS{}
+var _ datasource.DataSourceWithConfigure = &RegionsDS{}
+
+func NewRegionsDS() datasource.DataSource {
+	return &RegionsDS{
+		DSCommon: DSCommon{
+			dataSourceName: regionsDataSourceName,
+		},
+	}
+}
+
+// RegionsDS exposes the catalog of regions and instance sizes embedded in the provider, the one used to validate
+// clusters and network resources at plan time. It doesn't call Atlas.
+type RegionsDS struct {
+	DSCommon
+}
+
+type tfRegionsDSModel struct {
+	ID             types.String               `tfsdk:"id"`
+	ProviderName   types.String               `tfsdk:"provider_name"`
+	CatalogVersion types.String               `tfsdk:"catalog_version"`
+	Results        []tfRegionsProviderDSModel `tfsdk:"results"`
+}
+
+type tfRegionsProviderDSModel struct {
+	ProviderName     types.String                   `tfsdk:"provider_name"`
+	BackingProviders []types.String                 `tfsdk:"backing_providers"`
+	Regions          []tfRegionsRegionDSModel       `tfsdk:"regions"`
+	InstanceSizes    []tfRegionsInstanceSizeDSModel `tfsdk:"instance_sizes"`
+}
+
+type tfRegionsRegionDSModel struct {
+	Name      types.String `tfsdk:"name"`
+	CloudName types.String `tfsdk:"cloud_name"`
+}
+
+type tfRegionsInstanceSizeDSModel struct {
+	Name        types.String `tfsdk:"name"`
+	Class       types.String `tfsdk:"class"`
+	AutoScaling types.Bool   `tfsdk:"auto_scaling"`
+}
+
+func (d *RegionsDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"provider_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"catalog_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"provider_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"backing_providers": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"regions": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+									"cloud_name": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"instance_sizes": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+									"class": schema.StringAttribute{
+										Computed: true,
+									},
+									"auto_scaling": schema.BoolAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegionsDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var regionsConfig tfRegionsDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &regionsConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := newTFRegionsDSModel(catalog.Default(), regionsConfig.ProviderName)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("provider_name"), "invalid provider name", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func newTFRegionsDSModel(c *catalog.Catalog, providerName types.String) (*tfRegionsDSModel, error) {
+	providers := c.Providers
+	id := c.Version
+	if name := providerName.ValueString(); name != "" {
+		p, ok := c.Provider(name)
+		if !ok {
+			names := make([]string, 0, len(c.Providers))
+			for i := range c.Providers {
+				names = append(names, c.Providers[i].Name)
+			}
+			return nil, fmt.Errorf("%q is not in the catalog, the available providers are %v", name, names)
+		}
+		providers = []catalog.Provider{*p}
+		id = c.Version + "-" + name
+	}
+
+	results := make([]tfRegionsProviderDSModel, len(providers))
+	for i := range providers {
+		p := &providers[i]
+		result := tfRegionsProviderDSModel{
+			ProviderName:     types.StringValue(p.Name),
+			BackingProviders: make([]types.String, len(p.BackingProviders)),
+			Regions:          make([]tfRegionsRegionDSModel, len(p.Regions)),
+			InstanceSizes:    make([]tfRegionsInstanceSizeDSModel, len(p.InstanceSizes)),
+		}
+		for j, backingProvider := range p.BackingProviders {
+			result.BackingProviders[j] = types.StringValue(backingProvider)
+		}
+		for j, region := range p.Regions {
+			result.Regions[j] = tfRegionsRegionDSModel{
+				Name:      types.StringValue(region.Name),
+				CloudName: types.StringValue(region.CloudName),
+			}
+		}
+		for j, size := range p.InstanceSizes {
+			result.InstanceSizes[j] = tfRegionsInstanceSizeDSModel{
+				Name:        types.StringValue(size.Name),
+				Class:       types.StringValue(size.Class),
+				AutoScaling: types.BoolValue(size.AutoScaling),
+			}
+		}
+		results[i] = result
+	}
+
+	return &tfRegionsDSModel{
+		ID:             types.StringValue(id),
+		ProviderName:   providerName,
+		CatalogVersion: types.StringValue(c.Version),
+		Results:        results,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package mongodbatlas
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/catalog"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+)
+
+func TestRegionsDS_offline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	dataSourceName := "data.mongodbatlas_regions.aws"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + `
+					data "mongodbatlas_regions" "aws" {
+						provider_name = "AWS"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "catalog_version", catalog.Default().Version),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.provider_name", "AWS"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.0.regions.*", map[string]string{
+						"name":       "US_EAST_1",
+						"cloud_name": "us-east-1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "results.0.instance_sizes.*", map[string]string{
+						"name":         "M40_NVME",
+						"class":        "NVME",
+						"auto_scaling": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestNewTFRegionsDSModel(t *testing.T) {
+	c := catalog.Default()
+
+	all, err := newTFRegionsDSModel(c, types.StringNull())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(all.Results) != len(c.Providers) {
+		t.Errorf("expected %d providers, got %d", len(c.Providers), len(all.Results))
+	}
+
+	tenant, err := newTFRegionsDSModel(c, types.StringValue(catalog.ProviderTenant))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := tenant.ID.ValueString(); got != c.Version+"-TENANT" {
+		t.Errorf("expected id %s-TENANT, got %s", c.Version, got)
+	}
+	if len(tenant.Results) != 1 || len(tenant.Results[0].BackingProviders) != 3 || len(tenant.Results[0].Regions) == 0 {
+		t.Errorf("expected the TENANT provider with the regions of its backing providers, got %+v", tenant.Results)
+	}
+
+	if _, err := newTFRegionsDSModel(c, types.StringValue("AWZ")); err == nil {
+		t.Error("expected an error for a provider that is not in the catalog")
+	}
+}
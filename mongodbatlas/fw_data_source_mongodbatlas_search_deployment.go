@@ -0,0 +1,91 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SearchDeploymentDS{}
+var _ datasource.DataSourceWithConfigure = &SearchDeploymentDS{}
+
+func NewSearchDeploymentDS() datasource.DataSource {
+	return &SearchDeploymentDS{
+		DSCommon: DSCommon{
+			dataSourceName: searchDeploymentName,
+		},
+	}
+}
+
+type SearchDeploymentDS struct {
+	DSCommon
+}
+
+type tfSearchDeploymentDSModel struct {
+	ID          types.String                  `tfsdk:"id"`
+	ProjectID   types.String                  `tfsdk:"project_id"`
+	ClusterName types.String                  `tfsdk:"cluster_name"`
+	StateName   types.String                  `tfsdk:"state_name"`
+	Specs       []tfSearchDeploymentSpecModel `tfsdk:"specs"`
+}
+
+func (d *SearchDeploymentDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_id": schema.StringAttribute{
+				Required: true,
+			},
+			"cluster_name": schema.StringAttribute{
+				Required: true,
+			},
+			"state_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"specs": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_size": schema.StringAttribute{
+							Computed: true,
+						},
+						"node_count": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SearchDeploymentDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var searchDeploymentConfig tfSearchDeploymentDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &searchDeploymentConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := searchDeploymentConfig.ProjectID.ValueString()
+	clusterName := searchDeploymentConfig.ClusterName.ValueString()
+	deployment, err := getSearchDeployment(ctx, d.client.Atlas, projectID, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorSearchDeploymentRead, clusterName, err))
+		return
+	}
+
+	model := newTFSearchDeploymentModel(projectID, clusterName, deployment, timeouts.Value{})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfSearchDeploymentDSModel{
+		ID:          model.ID,
+		ProjectID:   model.ProjectID,
+		ClusterName: model.ClusterName,
+		StateName:   model.StateName,
+		Specs:       model.Specs,
+	})...)
+}
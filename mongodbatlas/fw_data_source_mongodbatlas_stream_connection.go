@@ -0,0 +1,124 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StreamConnectionDS{}
+var _ datasource.DataSourceWithConfigure = &StreamConnectionDS{}
+
+func NewStreamConnectionDS() datasource.DataSource {
+	return &StreamConnectionDS{
+		DSCommon: DSCommon{
+			dataSourceName: streamConnectionName,
+		},
+	}
+}
+
+type StreamConnectionDS struct {
+	DSCommon
+}
+
+func (d *StreamConnectionDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := streamConnectionDSAttributes()
+	for _, name := range []string{"project_id", "instance_name", "connection_name"} {
+		attributes[name] = schema.StringAttribute{
+			Required: true,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// streamConnectionDSAttributes returns the attributes of a stream connection in data sources, all of them computed.
+// The password of the Kafka authentication is never returned by Atlas.
+func streamConnectionDSAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"project_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"instance_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"connection_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"type": schema.StringAttribute{
+			Computed: true,
+		},
+		"cluster_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"bootstrap_servers": schema.StringAttribute{
+			Computed: true,
+		},
+		"config": schema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"authentication": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"mechanism": schema.StringAttribute{
+						Computed: true,
+					},
+					"username": schema.StringAttribute{
+						Computed: true,
+					},
+					"password": schema.StringAttribute{
+						Computed:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		"security": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"protocol": schema.StringAttribute{
+						Computed: true,
+					},
+					"broker_public_certificate": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StreamConnectionDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var streamConnectionConfig tfStreamConnectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamConnectionConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := d.client.AtlasV2
+	projectID := streamConnectionConfig.ProjectID.ValueString()
+	instanceName := streamConnectionConfig.InstanceName.ValueString()
+	connectionName := streamConnectionConfig.ConnectionName.ValueString()
+	connection, _, err := connV2.StreamsApi.GetStreamConnection(ctx, projectID, instanceName, connectionName).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorStreamConnectionRead, connectionName, err))
+		return
+	}
+
+	newStreamConnectionModel, diags := newTFStreamConnectionModel(ctx, projectID, instanceName, connection, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
+}
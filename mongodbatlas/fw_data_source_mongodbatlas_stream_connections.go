@@ -0,0 +1,109 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/util"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	streamConnectionsName      = "stream_connections"
+	errorStreamConnectionsList = "error listing Stream Connections of instance (%s): %s"
+)
+
+var _ datasource.DataSource = &StreamConnectionsDS{}
+var _ datasource.DataSourceWithConfigure = &StreamConnectionsDS{}
+
+func NewStreamConnectionsDS() datasource.DataSource {
+	return &StreamConnectionsDS{
+		DSCommon: DSCommon{
+			dataSourceName: streamConnectionsName,
+		},
+	}
+}
+
+type StreamConnectionsDS struct {
+	DSCommon
+}
+
+type tfStreamConnectionsModel struct {
+	ProjectID    types.String              `tfsdk:"project_id"`
+	InstanceName types.String              `tfsdk:"instance_name"`
+	Results      []tfStreamConnectionModel `tfsdk:"results"`
+	PageNum      types.Int64               `tfsdk:"page_num"`
+	ItemsPerPage types.Int64               `tfsdk:"items_per_page"`
+	TotalCount   types.Int64               `tfsdk:"total_count"`
+}
+
+func (d *StreamConnectionsDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required: true,
+			},
+			"instance_name": schema.StringAttribute{
+				Required: true,
+			},
+			"page_num": schema.Int64Attribute{
+				Optional: true,
+			},
+			"items_per_page": schema.Int64Attribute{
+				Optional: true,
+			},
+			"total_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: streamConnectionDSAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *StreamConnectionsDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var streamConnectionsConfig tfStreamConnectionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamConnectionsConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := d.client.AtlasV2
+	projectID := streamConnectionsConfig.ProjectID.ValueString()
+	instanceName := streamConnectionsConfig.InstanceName.ValueString()
+	apiResp, _, err := connV2.StreamsApi.ListStreamConnectionsWithParams(ctx, &admin.ListStreamConnectionsApiParams{
+		GroupId:      projectID,
+		TenantName:   instanceName,
+		PageNum:      util.Int64PtrToIntPtr(streamConnectionsConfig.PageNum.ValueInt64Pointer()),
+		ItemsPerPage: util.Int64PtrToIntPtr(streamConnectionsConfig.ItemsPerPage.ValueInt64Pointer()),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching results", fmt.Sprintf(errorStreamConnectionsList, instanceName, err))
+		return
+	}
+
+	newStreamConnectionsModel := &tfStreamConnectionsModel{
+		ProjectID:    streamConnectionsConfig.ProjectID,
+		InstanceName: streamConnectionsConfig.InstanceName,
+		PageNum:      streamConnectionsConfig.PageNum,
+		ItemsPerPage: streamConnectionsConfig.ItemsPerPage,
+		TotalCount:   types.Int64Value(int64(apiResp.GetTotalCount())),
+		Results:      make([]tfStreamConnectionModel, 0, len(apiResp.Results)),
+	}
+	for i := range apiResp.Results {
+		connectionModel, diags := newTFStreamConnectionModel(ctx, projectID, instanceName, &apiResp.Results[i], nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		newStreamConnectionsModel.Results = append(newStreamConnectionsModel.Results, *connectionModel)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionsModel)...)
+}
@@ -0,0 +1,104 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StreamInstanceDS{}
+var _ datasource.DataSourceWithConfigure = &StreamInstanceDS{}
+
+func NewStreamInstanceDS() datasource.DataSource {
+	return &StreamInstanceDS{
+		DSCommon: DSCommon{
+			dataSourceName: streamInstanceName,
+		},
+	}
+}
+
+type StreamInstanceDS struct {
+	DSCommon
+}
+
+func (d *StreamInstanceDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := streamInstanceDSAttributes()
+	attributes["project_id"] = schema.StringAttribute{
+		Required: true,
+	}
+	attributes["instance_name"] = schema.StringAttribute{
+		Required: true,
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// streamInstanceDSAttributes returns the attributes of a stream instance in data sources, all of them computed.
+func streamInstanceDSAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"project_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"instance_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"hostnames": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"data_process_region": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"cloud_provider": schema.StringAttribute{
+						Computed: true,
+					},
+					"region": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+		"stream_config": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tier": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StreamInstanceDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var streamInstanceConfig tfStreamInstanceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamInstanceConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.client.Atlas
+	projectID := streamInstanceConfig.ProjectID.ValueString()
+	instanceName := streamInstanceConfig.InstanceName.ValueString()
+	instance, err := getStreamInstance(ctx, conn, projectID, instanceName)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorStreamInstanceRead, instanceName, err))
+		return
+	}
+
+	newStreamInstanceModel, diags := newTFStreamInstanceModel(ctx, projectID, instance, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstanceModel)...)
+}
@@ -0,0 +1,100 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	streamInstancesName      = "stream_instances"
+	errorStreamInstancesList = "error listing Stream Instances in project (%s): %s"
+)
+
+var _ datasource.DataSource = &StreamInstancesDS{}
+var _ datasource.DataSourceWithConfigure = &StreamInstancesDS{}
+
+func NewStreamInstancesDS() datasource.DataSource {
+	return &StreamInstancesDS{
+		DSCommon: DSCommon{
+			dataSourceName: streamInstancesName,
+		},
+	}
+}
+
+type StreamInstancesDS struct {
+	DSCommon
+}
+
+type tfStreamInstancesModel struct {
+	ProjectID    types.String            `tfsdk:"project_id"`
+	Results      []tfStreamInstanceModel `tfsdk:"results"`
+	PageNum      types.Int64             `tfsdk:"page_num"`
+	ItemsPerPage types.Int64             `tfsdk:"items_per_page"`
+	TotalCount   types.Int64             `tfsdk:"total_count"`
+}
+
+func (d *StreamInstancesDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required: true,
+			},
+			"page_num": schema.Int64Attribute{
+				Optional: true,
+			},
+			"items_per_page": schema.Int64Attribute{
+				Optional: true,
+			},
+			"total_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: streamInstanceDSAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *StreamInstancesDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var streamInstancesConfig tfStreamInstancesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamInstancesConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.client.Atlas
+	projectID := streamInstancesConfig.ProjectID.ValueString()
+	page, err := listStreamInstances(ctx, conn, projectID, &matlas.ListOptions{
+		PageNum:      int(streamInstancesConfig.PageNum.ValueInt64()),
+		ItemsPerPage: int(streamInstancesConfig.ItemsPerPage.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching results", fmt.Sprintf(errorStreamInstancesList, projectID, err))
+		return
+	}
+
+	newStreamInstancesModel := &tfStreamInstancesModel{
+		ProjectID:    streamInstancesConfig.ProjectID,
+		PageNum:      streamInstancesConfig.PageNum,
+		ItemsPerPage: streamInstancesConfig.ItemsPerPage,
+		TotalCount:   types.Int64Value(int64(page.TotalCount)),
+		Results:      make([]tfStreamInstanceModel, 0, len(page.Results)),
+	}
+	for i := range page.Results {
+		instanceModel, diags := newTFStreamInstanceModel(ctx, projectID, &page.Results[i], true)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		newStreamInstancesModel.Results = append(newStreamInstancesModel.Results, *instanceModel)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstancesModel)...)
+}
@@ -0,0 +1,54 @@
+package mongodbatlas
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStreamDSStreamInstances_basic(t *testing.T) {
+	var (
+		dataSourceName       = "data.mongodbatlas_stream_instance.test"
+		dataSourcePluralName = "data.mongodbatlas_stream_instances.test"
+		orgID                = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName          = acctest.RandomWithPrefix("test-acc-stream")
+		instanceName         = acctest.RandomWithPrefix("test-acc-instance")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasStreamInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasStreamInstancesDSConfig(orgID, projectName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instance_name", instanceName),
+					resource.TestCheckResourceAttr(dataSourceName, "data_process_region.0.region", "VIRGINIA_USA"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stream_config.0.tier"),
+					resource.TestCheckResourceAttr(dataSourcePluralName, "total_count", "1"),
+					resource.TestCheckResourceAttr(dataSourcePluralName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourcePluralName, "results.0.instance_name", instanceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasStreamInstancesDSConfig(orgID, projectName, instanceName string) string {
+	return fmt.Sprintf(`
+	%s
+
+	data "mongodbatlas_stream_instance" "test" {
+		project_id    = mongodbatlas_stream_instance.test.project_id
+		instance_name = mongodbatlas_stream_instance.test.instance_name
+	}
+
+	data "mongodbatlas_stream_instances" "test" {
+		project_id = mongodbatlas_stream_instance.test.project_id
+	}
+	`, testAccMongoDBAtlasStreamInstanceConfig(orgID, projectName, instanceName, "VIRGINIA_USA", "SP10"))
+}
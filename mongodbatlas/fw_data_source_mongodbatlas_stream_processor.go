@@ -0,0 +1,140 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/jsontypes"
+)
+
+var _ datasource.DataSource = &StreamProcessorDS{}
+var _ datasource.DataSourceWithConfigure = &StreamProcessorDS{}
+
+func NewStreamProcessorDS() datasource.DataSource {
+	return &StreamProcessorDS{
+		DSCommon: DSCommon{
+			dataSourceName: streamProcessorName,
+		},
+	}
+}
+
+type StreamProcessorDS struct {
+	DSCommon
+}
+
+// tfStreamProcessorDSModel adds the live statistics of the processor, which are kept out of the resource state
+// because they change on every read.
+type tfStreamProcessorDSModel struct {
+	ID            types.String                    `tfsdk:"id"`
+	ProjectID     types.String                    `tfsdk:"project_id"`
+	InstanceName  types.String                    `tfsdk:"instance_name"`
+	ProcessorName types.String                    `tfsdk:"processor_name"`
+	Pipeline      jsontypes.Normalized            `tfsdk:"pipeline"`
+	State         types.String                    `tfsdk:"state"`
+	Stats         types.String                    `tfsdk:"stats"`
+	Options       []tfStreamProcessorOptionsModel `tfsdk:"options"`
+}
+
+func (d *StreamProcessorDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := streamProcessorDSAttributes()
+	for _, name := range []string{"project_id", "instance_name", "processor_name"} {
+		attributes[name] = schema.StringAttribute{
+			Required: true,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// streamProcessorDSAttributes returns the attributes of a stream processor in data sources, all of them computed.
+func streamProcessorDSAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"project_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"instance_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"processor_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"pipeline": schema.StringAttribute{
+			CustomType: jsontypes.NormalizedType{},
+			Computed:   true,
+		},
+		"state": schema.StringAttribute{
+			Computed: true,
+		},
+		"stats": schema.StringAttribute{
+			Computed: true,
+		},
+		"options": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"dlq": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"coll": schema.StringAttribute{
+									Computed: true,
+								},
+								"connection_name": schema.StringAttribute{
+									Computed: true,
+								},
+								"db": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StreamProcessorDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var streamProcessorConfig tfStreamProcessorDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamProcessorConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.client.Atlas
+	projectID := streamProcessorConfig.ProjectID.ValueString()
+	instanceName := streamProcessorConfig.InstanceName.ValueString()
+	processorName := streamProcessorConfig.ProcessorName.ValueString()
+	processor, err := getStreamProcessor(ctx, conn, projectID, instanceName, processorName)
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorStreamProcessorRead, processorName, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFStreamProcessorDSModel(projectID, instanceName, processor))...)
+}
+
+func newTFStreamProcessorDSModel(projectID, instanceName string, processor *streamProcessor) *tfStreamProcessorDSModel {
+	model := newTFStreamProcessorModel(projectID, instanceName, processor)
+	dsModel := &tfStreamProcessorDSModel{
+		ID:            model.ID,
+		ProjectID:     model.ProjectID,
+		InstanceName:  model.InstanceName,
+		ProcessorName: model.ProcessorName,
+		Pipeline:      model.Pipeline,
+		State:         model.State,
+		Stats:         types.StringNull(),
+		Options:       model.Options,
+	}
+	if len(processor.Stats) > 0 {
+		dsModel.Stats = types.StringValue(string(processor.Stats))
+	}
+	return dsModel
+}
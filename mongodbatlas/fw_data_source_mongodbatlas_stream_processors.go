@@ -0,0 +1,101 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	streamProcessorsName      = "stream_processors"
+	errorStreamProcessorsList = "error listing Stream Processors of instance (%s): %s"
+)
+
+var _ datasource.DataSource = &StreamProcessorsDS{}
+var _ datasource.DataSourceWithConfigure = &StreamProcessorsDS{}
+
+func NewStreamProcessorsDS() datasource.DataSource {
+	return &StreamProcessorsDS{
+		DSCommon: DSCommon{
+			dataSourceName: streamProcessorsName,
+		},
+	}
+}
+
+type StreamProcessorsDS struct {
+	DSCommon
+}
+
+type tfStreamProcessorsModel struct {
+	ProjectID    types.String               `tfsdk:"project_id"`
+	InstanceName types.String               `tfsdk:"instance_name"`
+	Results      []tfStreamProcessorDSModel `tfsdk:"results"`
+	PageNum      types.Int64                `tfsdk:"page_num"`
+	ItemsPerPage types.Int64                `tfsdk:"items_per_page"`
+	TotalCount   types.Int64                `tfsdk:"total_count"`
+}
+
+func (d *StreamProcessorsDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required: true,
+			},
+			"instance_name": schema.StringAttribute{
+				Required: true,
+			},
+			"page_num": schema.Int64Attribute{
+				Optional: true,
+			},
+			"items_per_page": schema.Int64Attribute{
+				Optional: true,
+			},
+			"total_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"results": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: streamProcessorDSAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *StreamProcessorsDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var streamProcessorsConfig tfStreamProcessorsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamProcessorsConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.client.Atlas
+	projectID := streamProcessorsConfig.ProjectID.ValueString()
+	instanceName := streamProcessorsConfig.InstanceName.ValueString()
+	page, err := listStreamProcessors(ctx, conn, projectID, instanceName, &matlas.ListOptions{
+		PageNum:      int(streamProcessorsConfig.PageNum.ValueInt64()),
+		ItemsPerPage: int(streamProcessorsConfig.ItemsPerPage.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error fetching results", fmt.Sprintf(errorStreamProcessorsList, instanceName, err))
+		return
+	}
+
+	newStreamProcessorsModel := &tfStreamProcessorsModel{
+		ProjectID:    streamProcessorsConfig.ProjectID,
+		InstanceName: streamProcessorsConfig.InstanceName,
+		PageNum:      streamProcessorsConfig.PageNum,
+		ItemsPerPage: streamProcessorsConfig.ItemsPerPage,
+		TotalCount:   types.Int64Value(int64(page.TotalCount)),
+		Results:      make([]tfStreamProcessorDSModel, 0, len(page.Results)),
+	}
+	for i := range page.Results {
+		newStreamProcessorsModel.Results = append(newStreamProcessorsModel.Results, *newTFStreamProcessorDSModel(projectID, instanceName, &page.Results[i]))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorsModel)...)
+}
@@ -6,7 +6,9 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -31,11 +33,13 @@ const (
 	DeprecationByVersionMessageParameter  = "this parameter is deprecated and will be removed in version %s"
 	DeprecationMessage                    = "this resource is deprecated and will be removed in %s, please transition to %s"
 	endPointSTSDefault                    = "https://sts.amazonaws.com"
-	MissingAuthAttrError                  = "either Atlas Programmatic API Keys or AWS Secrets Manager attributes must be set"
+	MissingAuthAttrError                  = "either Atlas Programmatic API Keys, Service Account credentials or AWS Secrets Manager attributes must be set"
 	ProviderConfigError                   = "error in configuring the provider."
+	MissingServiceAccountAttrError        = "both client_id and client_secret must be set to use Service Account credentials"
 	AWS                                   = "AWS"
 	AZURE                                 = "AZURE"
 	GCP
+	providerTypeName      = "mongodbatlas"
 	errorConfigureSummary = "Unexpected Resource Configure Type"
 	errorConfigure        = "expected *MongoDBClient, got: %T. Please report this issue to the provider developers"
 )
@@ -44,8 +48,11 @@ type MongodbtlasProvider struct{}
 
 type tfMongodbAtlasProviderModel struct {
 	AssumeRole           types.List   `tfsdk:"assume_role"`
+	DefaultLabels        types.Map    `tfsdk:"default_labels"`
 	PublicKey            types.String `tfsdk:"public_key"`
 	PrivateKey           types.String `tfsdk:"private_key"`
+	ClientID             types.String `tfsdk:"client_id"`
+	ClientSecret         types.String `tfsdk:"client_secret"`
 	BaseURL              types.String `tfsdk:"base_url"`
 	RealmBaseURL         types.String `tfsdk:"realm_base_url"`
 	SecretName           types.String `tfsdk:"secret_name"`
@@ -54,7 +61,14 @@ type tfMongodbAtlasProviderModel struct {
 	AwsAccessKeyID       types.String `tfsdk:"aws_access_key_id"`
 	AwsSecretAccessKeyID types.String `tfsdk:"aws_secret_access_key"`
 	AwsSessionToken      types.String `tfsdk:"aws_session_token"`
+	DefaultProjectID     types.String `tfsdk:"default_project_id"`
+	Profile              types.String `tfsdk:"profile"`
+	ConfigFile           types.String `tfsdk:"config_file"`
+	MaxRetries           types.Int64  `tfsdk:"max_retries"`
+	MaxConcurrent        types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxConcurrentProject types.Int64  `tfsdk:"max_concurrent_requests_per_project"`
 	IsMongodbGovCloud    types.Bool   `tfsdk:"is_mongodbgov_cloud"`
+	ReadOnly             types.Bool   `tfsdk:"read_only"`
 }
 
 type tfAssumeRoleModel struct {
@@ -70,7 +84,7 @@ type tfAssumeRoleModel struct {
 }
 
 func (p *MongodbtlasProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "mongodbatlas"
+	resp.TypeName = providerTypeName
 	resp.Version = version.ProviderVersion
 }
 
@@ -89,6 +103,15 @@ func (p *MongodbtlasProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Description: "MongoDB Atlas Programmatic Private Key",
 				Sensitive:   true,
 			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "MongoDB Atlas Service Account Client ID",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Description: "MongoDB Atlas Service Account Client Secret",
+				Sensitive:   true,
+			},
 			"base_url": schema.StringAttribute{
 				Optional:    true,
 				Description: "MongoDB Atlas Base URL",
@@ -125,6 +148,51 @@ func (p *MongodbtlasProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:    true,
 				Description: "AWS Security Token Service provided session token.",
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for idempotent requests rejected by MongoDB Atlas with HTTP 429 or 503. Set to 0 to disable retries.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the Atlas CLI profile to read credentials and settings from.",
+			},
+			"config_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of the Atlas CLI configuration file, defaults to the location used by the Atlas CLI.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of requests sent to MongoDB Atlas at the same time. Defaults to 0, no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests_per_project": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of requests for the same project sent to MongoDB Atlas at the same time. Defaults to 0, no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Reject every request to MongoDB Atlas other than GET, so the provider can't change any resource.",
+			},
+			"default_project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Project ID used by the resources that don't set project_id.",
+			},
+			"default_labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOf(defaultLabel.Key)),
+				},
+				Description: "Labels added to all the clusters and database users managed by the provider. Labels with the same key set in a resource take precedence.",
+			},
 		},
 	}
 }
@@ -212,10 +280,30 @@ func (p *MongodbtlasProvider) Configure(ctx context.Context, req provider.Config
 	}
 
 	config := Config{
-		PublicKey:    data.PublicKey.ValueString(),
-		PrivateKey:   data.PrivateKey.ValueString(),
-		BaseURL:      data.BaseURL.ValueString(),
-		RealmBaseURL: data.RealmBaseURL.ValueString(),
+		PublicKey:                       data.PublicKey.ValueString(),
+		PrivateKey:                      data.PrivateKey.ValueString(),
+		ClientID:                        data.ClientID.ValueString(),
+		ClientSecret:                    data.ClientSecret.ValueString(),
+		BaseURL:                         data.BaseURL.ValueString(),
+		RealmBaseURL:                    data.RealmBaseURL.ValueString(),
+		MaxRetries:                      int(data.MaxRetries.ValueInt64()),
+		DefaultProjectID:                data.DefaultProjectID.ValueString(),
+		ReadOnly:                        data.ReadOnly.ValueBool(),
+		MaxConcurrentRequests:           int(data.MaxConcurrent.ValueInt64()),
+		MaxConcurrentRequestsPerProject: int(data.MaxConcurrentProject.ValueInt64()),
+	}
+	resp.Diagnostics.Append(data.DefaultLabels.ElementsAs(ctx, &config.DefaultLabels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if profile, configFile := data.Profile.ValueString(), data.ConfigFile.ValueString(); profile != "" || configFile != "" {
+		cliProfile, err := LoadAtlasCLIProfile(configFile, profile)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to read Atlas CLI profile", err.Error())
+			return
+		}
+		config.ApplyAtlasCLIProfile(cliProfile)
 	}
 
 	if awsRoleDefined {
@@ -292,12 +380,31 @@ func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsRoleD
 		}, "").(string))
 	}
 
+	if data.ClientID.ValueString() == "" {
+		data.ClientID = types.StringValue(MultiEnvDefaultFunc([]string{
+			"MONGODB_ATLAS_CLIENT_ID",
+		}, "").(string))
+	}
+
+	if data.ClientSecret.ValueString() == "" {
+		data.ClientSecret = types.StringValue(MultiEnvDefaultFunc([]string{
+			"MONGODB_ATLAS_CLIENT_SECRET",
+		}, "").(string))
+	}
+
+	serviceAccountDefined := data.ClientID.ValueString() != "" && data.ClientSecret.ValueString() != ""
+	if !serviceAccountDefined && (data.ClientID.ValueString() != "" || data.ClientSecret.ValueString() != "") {
+		resp.Diagnostics.AddError(ProviderConfigError, MissingServiceAccountAttrError)
+	}
+	// keys are read from the Atlas CLI profile later on, once the ones in the provider and environment are known
+	profileDefined := data.Profile.ValueString() != "" || data.ConfigFile.ValueString() != ""
+
 	if data.PublicKey.ValueString() == "" {
 		data.PublicKey = types.StringValue(MultiEnvDefaultFunc([]string{
 			"MONGODB_ATLAS_PUBLIC_KEY",
 			"MCLI_PUBLIC_API_KEY",
 		}, "").(string))
-		if data.PublicKey.ValueString() == "" && !awsRoleDefined {
+		if data.PublicKey.ValueString() == "" && !awsRoleDefined && !serviceAccountDefined && !profileDefined {
 			resp.Diagnostics.AddWarning(ProviderConfigError, MissingAuthAttrError)
 		}
 	}
@@ -307,7 +414,7 @@ func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsRoleD
 			"MONGODB_ATLAS_PRIVATE_KEY",
 			"MCLI_PRIVATE_API_KEY",
 		}, "").(string))
-		if data.PrivateKey.ValueString() == "" && !awsRoleDefined {
+		if data.PrivateKey.ValueString() == "" && !awsRoleDefined && !serviceAccountDefined && !profileDefined {
 			resp.Diagnostics.AddWarning(ProviderConfigError, MissingAuthAttrError)
 		}
 	}
@@ -353,6 +460,10 @@ func setDefaultValuesWithValidations(data *tfMongodbAtlasProviderModel, awsRoleD
 		}, "").(string))
 	}
 
+	if data.MaxRetries.IsNull() {
+		data.MaxRetries = types.Int64Value(defaultMaxRetries)
+	}
+
 	return *data
 }
 
@@ -367,6 +478,18 @@ func (p *MongodbtlasProvider) DataSources(context.Context) []func() datasource.D
 		NewProjectIPAccessListDS,
 		NewAtlasUserDS,
 		NewAtlasUsersDS,
+		NewStreamInstanceDS,
+		NewStreamInstancesDS,
+		NewStreamConnectionDS,
+		NewStreamConnectionsDS,
+		NewStreamProcessorDS,
+		NewStreamProcessorsDS,
+		NewSearchDeploymentDS,
+		NewPushBasedLogExportDS,
+		NewRegionsDS,
+		NewPerformanceAdvisorSuggestedIndexesDS,
+		NewPerformanceAdvisorSlowQueryLogsDS,
+		NewPerformanceAdvisorSchemaAdviceDS,
 	}
 }
 
@@ -377,6 +500,13 @@ func (p *MongodbtlasProvider) Resources(context.Context) []func() resource.Resou
 		NewDatabaseUserRS,
 		NewAlertConfigurationRS,
 		NewProjectIPAccessListRS,
+		NewStreamInstanceRS,
+		NewStreamConnectionRS,
+		NewStreamProcessorRS,
+		NewSearchDeploymentRS,
+		NewTeamProjectAssignmentRS,
+		NewPushBasedLogExportRS,
+		NewProjectIPAccessListsRS,
 	}
 }
 
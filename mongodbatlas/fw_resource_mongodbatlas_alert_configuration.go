@@ -3,7 +3,6 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"reflect"
 	"strings"
 
@@ -19,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/util"
 	"github.com/mwielbut/pointy"
@@ -40,6 +40,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &AlertConfigurationRS{}
 var _ resource.ResourceWithImportState = &AlertConfigurationRS{}
+var _ resource.ResourceWithModifyPlan = &AlertConfigurationRS{}
 
 func NewAlertConfigurationRS() resource.Resource {
 	return &AlertConfigurationRS{
@@ -124,8 +125,10 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"project_id": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -370,6 +373,7 @@ func (r *AlertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 }
 
 func (r *AlertConfigurationRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	conn := r.client.Atlas
 
 	var alertConfigPlan tfAlertConfigurationRSModel
@@ -428,10 +432,10 @@ func (r *AlertConfigurationRS) Read(ctx context.Context, req resource.ReadReques
 
 	ids := decodeStateID(alertConfigState.ID.ValueString())
 
-	alert, getResp, err := conn.AlertConfigurations.GetAnAlertConfig(context.Background(), ids[encodedIDKeyProjectID], ids[encodedIDKeyAlertID])
+	alert, _, err := conn.AlertConfigurations.GetAnAlertConfig(context.Background(), ids[encodedIDKeyProjectID], ids[encodedIDKeyAlertID])
 	if err != nil {
 		// deleted in the backend case
-		if getResp != nil && getResp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -446,6 +450,7 @@ func (r *AlertConfigurationRS) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *AlertConfigurationRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	conn := r.client.Atlas
 
 	var alertConfigState, alertConfigPlan tfAlertConfigurationRSModel
@@ -521,6 +526,7 @@ func (r *AlertConfigurationRS) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *AlertConfigurationRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	conn := r.client.Atlas
 
 	var alertConfigState tfAlertConfigurationRSModel
@@ -537,6 +543,10 @@ func (r *AlertConfigurationRS) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+func (r *AlertConfigurationRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
 func (r *AlertConfigurationRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, "-", 2)
 
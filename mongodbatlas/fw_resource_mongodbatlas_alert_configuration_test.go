@@ -7,21 +7,21 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 func TestAccConfigRSAlertConfiguration_basic(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -47,12 +47,13 @@ func TestAccConfigRSAlertConfiguration_basic(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_EmptyMetricThresholdConfig(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName = acctest.RandomWithPrefix("test-acc")
+		projectName = rnd.RandomWithPrefix("test-acc")
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -65,14 +66,15 @@ func TestAccConfigRSAlertConfiguration_EmptyMetricThresholdConfig(t *testing.T)
 }
 
 func TestAccConfigRSAlertConfiguration_EmptyMatcherMetricThresholdConfig(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -89,14 +91,15 @@ func TestAccConfigRSAlertConfiguration_EmptyMatcherMetricThresholdConfig(t *test
 	})
 }
 func TestAccConfigRSAlertConfiguration_Notifications(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -120,14 +123,15 @@ func TestAccConfigRSAlertConfiguration_Notifications(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_WithMatchers(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -171,14 +175,15 @@ func TestAccConfigRSAlertConfiguration_WithMatchers(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_withMetricUpdated(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -202,14 +207,15 @@ func TestAccConfigRSAlertConfiguration_withMetricUpdated(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_whitThresholdUpdated(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -240,14 +246,15 @@ func TestAccConfigRSAlertConfiguration_whitThresholdUpdated(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_whitoutRoles(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		alert        = &matlas.AlertConfiguration{}
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -264,14 +271,15 @@ func TestAccConfigRSAlertConfiguration_whitoutRoles(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_withoutOptionalAttributes(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		alert        = &matlas.AlertConfiguration{}
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -288,13 +296,14 @@ func TestAccConfigRSAlertConfiguration_withoutOptionalAttributes(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_importBasic(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		resourceName = "mongodbatlas_alert_configuration.test"
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -314,13 +323,14 @@ func TestAccConfigRSAlertConfiguration_importBasic(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_importIncorrectId(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		resourceName = "mongodbatlas_alert_configuration.test"
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -339,13 +349,14 @@ func TestAccConfigRSAlertConfiguration_importIncorrectId(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_importConfigNotifications(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		resourceName = "mongodbatlas_alert_configuration.test"
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -370,15 +381,16 @@ const dummy36CharKey = "11111111-1111-1111-1111-111111111111"
 
 // used for testing notification that does not define interval_min attribute
 func TestAccConfigRSAlertConfiguration_importPagerDuty(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		serviceKey   = dummy32CharKey
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -402,16 +414,17 @@ func TestAccConfigRSAlertConfiguration_importPagerDuty(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_UpdatePagerDutyWithNotifierId(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		serviceKey   = dummy32CharKey
 		notifierID   = "651dd9336afac13e1c112222"
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -438,16 +451,17 @@ func TestAccConfigRSAlertConfiguration_UpdatePagerDutyWithNotifierId(t *testing.
 }
 
 func TestAccConfigRSAlertConfiguration_DataDog(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		ddAPIKey     = dummy32CharKey
 		ddRegion     = "US"
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -464,15 +478,16 @@ func TestAccConfigRSAlertConfiguration_DataDog(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_PagerDuty(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		serviceKey   = dummy32CharKey
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -489,15 +504,16 @@ func TestAccConfigRSAlertConfiguration_PagerDuty(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_OpsGenie(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		apiKey       = dummy36CharKey
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
@@ -514,15 +530,16 @@ func TestAccConfigRSAlertConfiguration_OpsGenie(t *testing.T) {
 }
 
 func TestAccConfigRSAlertConfiguration_VictorOps(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_alert_configuration.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
 		apiKey       = dummy36CharKey
 		alert        = &matlas.AlertConfiguration{}
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasAlertConfigurationDestroy,
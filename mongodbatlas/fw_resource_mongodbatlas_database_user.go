@@ -3,7 +3,6 @@ package mongodbatlas
 import (
 	"context"
 	"errors"
-	"net/http"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -18,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -27,6 +27,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &DatabaseUserRS{}
 var _ resource.ResourceWithImportState = &DatabaseUserRS{}
+var _ resource.ResourceWithModifyPlan = &DatabaseUserRS{}
 
 type DatabaseUserRS struct {
 	RSCommon
@@ -52,6 +53,7 @@ type tfDatabaseUserModel struct {
 	AWSIAMType       types.String `tfsdk:"aws_iam_type"`
 	Roles            types.Set    `tfsdk:"roles"`
 	Labels           types.Set    `tfsdk:"labels"`
+	EffectiveLabels  types.Map    `tfsdk:"effective_labels"`
 	Scopes           types.Set    `tfsdk:"scopes"`
 }
 
@@ -97,8 +99,10 @@ func (r *DatabaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"project_id": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -154,6 +158,10 @@ func (r *DatabaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringvalidator.OneOf("NONE", "USER", "ROLE"),
 				},
 			},
+			"effective_labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"roles": schema.SetNestedBlock{
@@ -205,6 +213,7 @@ func (r *DatabaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 }
 
 func (r *DatabaseUserRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var databaseUserPlan *tfDatabaseUserModel
 
 	diags := req.Plan.Get(ctx, &databaseUserPlan)
@@ -218,6 +227,9 @@ func (r *DatabaseUserRS) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if defaultLabels := r.client.Config.DefaultLabels; len(defaultLabels) > 0 {
+		dbUserReq.Labels = mergeDefaultLabels(dbUserReq.Labels, defaultLabels)
+	}
 
 	conn := r.client.Atlas
 	dbUser, _, err := conn.DatabaseUsers.Create(ctx, databaseUserPlan.ProjectID.ValueString(), dbUserReq)
@@ -226,7 +238,7 @@ func (r *DatabaseUserRS) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	dbUserModel, diagnostic := newTFDatabaseUserModel(ctx, databaseUserPlan, dbUser)
+	dbUserModel, diagnostic := newTFDatabaseUserModel(ctx, databaseUserPlan, dbUser, r.client.Config.DefaultLabels)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -260,20 +272,19 @@ func (r *DatabaseUserRS) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	conn := r.client.Atlas
-	dbUser, httpResponse, err := conn.DatabaseUsers.Get(ctx, authDatabaseName, projectID, username)
+	dbUser, _, err := conn.DatabaseUsers.Get(ctx, authDatabaseName, projectID, username)
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
-			resp.Diagnostics.AddError("resource not found", err.Error())
 			return
 		}
 		resp.Diagnostics.AddError("error getting database user information", err.Error())
 		return
 	}
 
-	dbUserModel, diagnostic := newTFDatabaseUserModel(ctx, databaseUserState, dbUser)
+	dbUserModel, diagnostic := newTFDatabaseUserModel(ctx, databaseUserState, dbUser, r.client.Config.DefaultLabels)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -286,6 +297,7 @@ func (r *DatabaseUserRS) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *DatabaseUserRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var databaseUserPlan *tfDatabaseUserModel
 
 	diags := req.Plan.Get(ctx, &databaseUserPlan)
@@ -299,6 +311,9 @@ func (r *DatabaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if defaultLabels := r.client.Config.DefaultLabels; len(defaultLabels) > 0 {
+		dbUserReq.Labels = mergeDefaultLabels(dbUserReq.Labels, defaultLabels)
+	}
 
 	conn := r.client.Atlas
 	dbUser, _, err := conn.DatabaseUsers.Update(ctx, databaseUserPlan.ProjectID.ValueString(), databaseUserPlan.Username.ValueString(), dbUserReq)
@@ -307,7 +322,7 @@ func (r *DatabaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	dbUserModel, diagnostic := newTFDatabaseUserModel(ctx, databaseUserPlan, dbUser)
+	dbUserModel, diagnostic := newTFDatabaseUserModel(ctx, databaseUserPlan, dbUser, r.client.Config.DefaultLabels)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -320,6 +335,7 @@ func (r *DatabaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *DatabaseUserRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var databaseUserState *tfDatabaseUserModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &databaseUserState)...)
@@ -335,6 +351,11 @@ func (r *DatabaseUserRS) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *DatabaseUserRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+	modifyPlanDefaultLabels(ctx, r.client, req, resp)
+}
+
 func (r *DatabaseUserRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -374,13 +395,27 @@ func newMongoDBDatabaseUser(ctx context.Context, dbUserModel *tfDatabaseUserMode
 	}, nil
 }
 
-func newTFDatabaseUserModel(ctx context.Context, model *tfDatabaseUserModel, dbUser *matlas.DatabaseUser) (*tfDatabaseUserModel, diag.Diagnostics) {
+// newTFDatabaseUserModel returns the model of dbUser. The labels added from defaultLabels are only kept in the
+// effective labels, unless they were already in the labels of model.
+func newTFDatabaseUserModel(ctx context.Context, model *tfDatabaseUserModel, dbUser *matlas.DatabaseUser, defaultLabels map[string]string) (*tfDatabaseUserModel, diag.Diagnostics) {
 	rolesSet, diagnostic := types.SetValueFrom(ctx, RoleObjectType, newTFRolesModel(dbUser.Roles))
 	if diagnostic.HasError() {
 		return nil, diagnostic
 	}
 
-	labelsSet, diagnostic := types.SetValueFrom(ctx, LabelObjectType, newTFLabelsModel(dbUser.Labels))
+	var knownLabels []*tfLabelModel
+	if model != nil {
+		if diagnostic := model.Labels.ElementsAs(ctx, &knownLabels, false); diagnostic.HasError() {
+			return nil, diagnostic
+		}
+	}
+	labels := removeDefaultLabels(dbUser.Labels, newMongoDBAtlasLabels(knownLabels), defaultLabels)
+	labelsSet, diagnostic := types.SetValueFrom(ctx, LabelObjectType, newTFLabelsModel(labels))
+	if diagnostic.HasError() {
+		return nil, diagnostic
+	}
+
+	effectiveLabels, diagnostic := types.MapValueFrom(ctx, types.StringType, labelsMap(dbUser.Labels))
 	if diagnostic.HasError() {
 		return nil, diagnostic
 	}
@@ -407,6 +442,7 @@ func newTFDatabaseUserModel(ctx context.Context, model *tfDatabaseUserModel, dbU
 		AWSIAMType:       types.StringValue(dbUser.AWSIAMType),
 		Roles:            rolesSet,
 		Labels:           labelsSet,
+		EffectiveLabels:  effectiveLabels,
 		Scopes:           scopesSet,
 	}
 
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -640,6 +642,117 @@ func testAccCheckMongoDBAtlasDatabaseUserExists(resourceName string, dbUser *mat
 	}
 }
 
+func TestConfigRSDatabaseUser_offline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	var (
+		resourceName = "mongodbatlas_database_user.basic_ds"
+		username     = "offline-user"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasDatabaseUserDestroyOffline(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasDatabaseUserConfig("offline-project", offlineOrgID, "atlasAdmin", username, "key", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "auth_database_name", "admin"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.role_name", "atlasAdmin"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasDatabaseUserConfig("offline-project", offlineOrgID, "read", username, "key", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0.role_name", "read"),
+				),
+			},
+		},
+	})
+}
+
+func TestConfigRSDatabaseUser_offlineDefaultLabels(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	var (
+		resourceName = "mongodbatlas_database_user.basic_ds"
+		username     = "offline-user"
+		dbUser       matlas.DatabaseUser
+	)
+	providerConfig := func(defaultLabels string) string {
+		return fmt.Sprintf(`
+			provider "mongodbatlas" {
+				base_url       = %q
+				public_key     = "offline"
+				private_key    = "offline"
+				default_labels = %s
+			}
+		`, server.URL(), defaultLabels)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasDatabaseUserDestroyOffline(server),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(`{ team = "payments" }`) +
+					testAccMongoDBAtlasDatabaseUserConfig("offline-project", offlineOrgID, "atlasAdmin", username, "key", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "effective_labels.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "effective_labels.team", "payments"),
+				),
+			},
+			{
+				Config: providerConfig(`{ team = "payments", environment = "dev" }`) +
+					testAccMongoDBAtlasDatabaseUserConfig("offline-project", offlineOrgID, "atlasAdmin", username, "key", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasDatabaseUserExistsOffline(server, resourceName, &dbUser),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "effective_labels.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "effective_labels.environment", "dev"),
+					func(s *terraform.State) error {
+						if len(dbUser.Labels) != 3 {
+							return fmt.Errorf("expected the new default label to be added to the user, got %v", dbUser.Labels)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: providerConfig(`{ team = "payments", environment = "dev" }`) +
+					testAccMongoDBAtlasDatabaseUserConfig("offline-project", offlineOrgID, "atlasAdmin", username, "key", "value"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasDatabaseUserExistsOffline(server *atlastest.Server, resourceName string, dbUser *matlas.DatabaseUser) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		conn := testOfflineClient(server).Atlas
+		dbUserResp, _, err := conn.DatabaseUsers.Get(context.Background(), rs.Primary.Attributes["auth_database_name"],
+			rs.Primary.Attributes["project_id"], rs.Primary.Attributes["username"])
+		if err != nil {
+			return fmt.Errorf("database user (%s) does not exist: %s", rs.Primary.Attributes["username"], err)
+		}
+		*dbUser = *dbUserResp
+		return nil
+	}
+}
+
 func testAccCheckMongoDBAtlasDatabaseUserAttributes(dbUser *matlas.DatabaseUser, username string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		log.Printf("[DEBUG] difference dbUser.Username: %s , username : %s", dbUser.Username, username)
@@ -673,6 +786,28 @@ func testAccCheckMongoDBAtlasDatabaseUserDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckMongoDBAtlasDatabaseUserDestroyOffline(server *atlastest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testOfflineClient(server).Atlas
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "mongodbatlas_database_user" {
+				continue
+			}
+
+			projectID, username, authDatabaseName, err := splitDatabaseUserImportID(rs.Primary.ID)
+			if err != nil {
+				continue
+			}
+			if _, _, err := conn.DatabaseUsers.Get(context.Background(), authDatabaseName, projectID, username); !apierror.IsNotFound(err) {
+				return fmt.Errorf("database user (%s) still exists", username)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccMongoDBAtlasDatabaseUserConfig(projectName, orgID, roleName, username, keyLabel, valueLabel string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_project" "test" {
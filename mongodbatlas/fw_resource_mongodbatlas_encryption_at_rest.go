@@ -2,10 +2,8 @@ package mongodbatlas
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"reflect"
 	"time"
 
@@ -18,7 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
 	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	validators "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/validator"
@@ -35,6 +33,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &EncryptionAtRestRS{}
 var _ resource.ResourceWithImportState = &EncryptionAtRestRS{}
+var _ resource.ResourceWithModifyPlan = &EncryptionAtRestRS{}
 
 func NewEncryptionAtRestRS() resource.Resource {
 	return &EncryptionAtRestRS{
@@ -91,8 +90,10 @@ func (r *EncryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"project_id": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -201,6 +202,7 @@ func (r *EncryptionAtRestRS) Schema(ctx context.Context, req resource.SchemaRequ
 }
 
 func (r *EncryptionAtRestRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var encryptionAtRestPlan *tfEncryptionAtRestRSModel
 	var encryptionAtRestConfig *tfEncryptionAtRestRSModel
 	conn := r.client.Atlas
@@ -225,23 +227,23 @@ func (r *EncryptionAtRestRS) Create(ctx context.Context, req resource.CreateRequ
 		encryptionAtRestReq.GoogleCloudKms = *newAtlasGcpKms(encryptionAtRestPlan.GoogleCloudKmsConfig)
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{retrystrategy.RetryStrategyPendingState},
-		Target:     []string{retrystrategy.RetryStrategyCompletedState, retrystrategy.RetryStrategyErrorState},
-		Refresh:    resourceMongoDBAtlasEncryptionAtRestCreateRefreshFunc(ctx, projectID, conn, encryptionAtRestReq),
-		Timeout:    1 * time.Minute,
-		MinTimeout: 1 * time.Second,
-		Delay:      0,
+	waiter := &retrystrategy.Waiter[*matlas.EncryptionAtRest]{
+		Operation:    fmt.Sprintf("encryption at rest configuration of project %s", projectID),
+		Pending:      []string{retrystrategy.RetryStrategyPendingState},
+		Target:       []string{retrystrategy.RetryStrategyCompletedState, retrystrategy.RetryStrategyErrorState},
+		Refresh:      resourceMongoDBAtlasEncryptionAtRestCreateRefreshFunc(ctx, projectID, conn, encryptionAtRestReq),
+		Timeout:      1 * time.Minute,
+		PollInterval: 1 * time.Second,
+		Delay:        0,
 	}
 
-	var encryptionResp any
-	var err error
-	if encryptionResp, err = stateConf.WaitForStateContext(ctx); err != nil {
+	encryptionResp, err := waiter.Wait(ctx)
+	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf(errorCreateEncryptionAtRest, projectID), err.Error())
 		return
 	}
 
-	encryptionAtRestPlanNew := newTFEncryptionAtRestRSModel(ctx, projectID, encryptionResp.(*matlas.EncryptionAtRest), encryptionAtRestPlan)
+	encryptionAtRestPlanNew := newTFEncryptionAtRestRSModel(ctx, projectID, encryptionResp, encryptionAtRestPlan)
 	resetDefaultsFromConfigOrState(ctx, encryptionAtRestPlan, encryptionAtRestPlanNew, encryptionAtRestConfig)
 
 	// set state to fully populated data
@@ -252,13 +254,11 @@ func (r *EncryptionAtRestRS) Create(ctx context.Context, req resource.CreateRequ
 	}
 }
 
-func resourceMongoDBAtlasEncryptionAtRestCreateRefreshFunc(ctx context.Context, projectID string, conn *matlas.Client, encryptionAtRestReq *matlas.EncryptionAtRest) retry.StateRefreshFunc {
-	return func() (any, string, error) {
+func resourceMongoDBAtlasEncryptionAtRestCreateRefreshFunc(ctx context.Context, projectID string, conn *matlas.Client, encryptionAtRestReq *matlas.EncryptionAtRest) func() (*matlas.EncryptionAtRest, string, error) {
+	return func() (*matlas.EncryptionAtRest, string, error) {
 		encryptionResp, _, err := conn.EncryptionsAtRest.Create(ctx, encryptionAtRestReq)
 		if err != nil {
-			if errors.Is(err, errors.New("CANNOT_ASSUME_ROLE")) ||
-				errors.Is(err, errors.New("INVALID_AWS_CREDENTIALS")) ||
-				errors.Is(err, errors.New("CLOUD_PROVIDER_ACCESS_ROLE_NOT_AUTHORIZED")) {
+			if apierror.HasErrorCode(err, apierror.CodeCannotAssumeRole, "INVALID_AWS_CREDENTIALS", "CLOUD_PROVIDER_ACCESS_ROLE_NOT_AUTHORIZED") {
 				log.Printf("warning issue performing authorize EncryptionsAtRest not done try again: %s \n", err.Error())
 				log.Println("retrying ")
 
@@ -309,6 +309,7 @@ func (r *EncryptionAtRestRS) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *EncryptionAtRestRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var encryptionAtRestState *tfEncryptionAtRestRSModel
 	var encryptionAtRestConfig *tfEncryptionAtRestRSModel
 	var encryptionAtRestPlan *tfEncryptionAtRestRSModel
@@ -330,9 +331,9 @@ func (r *EncryptionAtRestRS) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	projectID := encryptionAtRestState.ProjectID.ValueString()
-	atlasEncryptionAtRest, atlasResp, err := conn.EncryptionsAtRest.Get(context.Background(), projectID)
+	atlasEncryptionAtRest, _, err := conn.EncryptionsAtRest.Get(context.Background(), projectID)
 	if err != nil {
-		if resp != nil && atlasResp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -366,6 +367,7 @@ func (r *EncryptionAtRestRS) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *EncryptionAtRestRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var encryptionAtRestState *tfEncryptionAtRestRSModel
 
 	// read prior state data into the model
@@ -384,6 +386,10 @@ func (r *EncryptionAtRestRS) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+func (r *EncryptionAtRestRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
 func (r *EncryptionAtRestRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
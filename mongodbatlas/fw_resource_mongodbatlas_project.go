@@ -2,14 +2,13 @@ package mongodbatlas
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"reflect"
 	"sort"
 	"time"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 
@@ -28,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	conversion "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 )
 
 const (
@@ -236,6 +236,7 @@ func (r *ProjectRS) Schema(ctx context.Context, req resource.SchemaRequest, resp
 }
 
 func (r *ProjectRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var projectPlan tfProjectRSModel
 	var teams []tfTeamModel
 	var limits []tfLimitModel
@@ -351,9 +352,9 @@ func (r *ProjectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	projectID := project.ID
-	projectRes, atlasResp, err := conn.Projects.GetOneProject(ctx, projectID)
+	projectRes, _, err := conn.Projects.GetOneProject(ctx, projectID)
 	if err != nil {
-		if resp != nil && atlasResp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -398,9 +399,9 @@ func (r *ProjectRS) Read(ctx context.Context, req resource.ReadRequest, resp *re
 	}
 
 	// get project
-	projectRes, atlasResp, err := conn.Projects.GetOneProject(ctx, projectID)
+	projectRes, _, err := conn.Projects.GetOneProject(ctx, projectID)
 	if err != nil {
-		if resp != nil && atlasResp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -427,6 +428,7 @@ func (r *ProjectRS) Read(ctx context.Context, req resource.ReadRequest, resp *re
 }
 
 func (r *ProjectRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var projectState tfProjectRSModel
 	var projectPlan tfProjectRSModel
 	conn := r.client.Atlas
@@ -468,9 +470,9 @@ func (r *ProjectRS) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	projectRes, atlasResp, err := conn.Projects.GetOneProject(ctx, projectID)
+	projectRes, _, err := conn.Projects.GetOneProject(ctx, projectID)
 	if err != nil {
-		if resp != nil && atlasResp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -495,6 +497,7 @@ func (r *ProjectRS) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (r *ProjectRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var project *tfProjectRSModel
 
 	// read Terraform prior state data into the model
@@ -521,6 +524,11 @@ func updatePlanFromConfig(projectPlanNewPtr, projectPlan *tfProjectRSModel) {
 	// https://discuss.hashicorp.com/t/boolean-optional-default-value-migration-to-framework/55932
 	projectPlanNewPtr.WithDefaultAlertsSettings = projectPlan.WithDefaultAlertsSettings
 	projectPlanNewPtr.ProjectOwnerID = projectPlan.ProjectOwnerID
+	// projects without teams blocks don't manage their teams, so they can be assigned with
+	// mongodbatlas_team_project_assignment. Imported projects have null teams and keep the ones assigned in Atlas.
+	if !projectPlan.Teams.IsNull() && len(projectPlan.Teams.Elements()) == 0 {
+		projectPlanNewPtr.Teams = projectPlan.Teams
+	}
 }
 
 func filterUserDefinedLimits(allAtlasLimits []admin.DataFederationLimit, tflimits []tfLimitModel) []admin.DataFederationLimit {
@@ -729,7 +737,9 @@ func updateProjectTeams(ctx context.Context, conn *matlas.Client, projectState,
 	_ = projectPlan.Teams.ElementsAs(ctx, &planTeams, false)
 	_ = projectState.Teams.ElementsAs(ctx, &stateTeams, false)
 
-	if !hasTeamsChanged(planTeams, stateTeams) {
+	// projects without teams blocks don't manage their teams, imported projects have the teams assigned in Atlas in
+	// their state until the first apply
+	if len(planTeams) == 0 || !hasTeamsChanged(planTeams, stateTeams) {
 		return nil
 	}
 
@@ -741,8 +751,7 @@ func updateProjectTeams(ctx context.Context, conn *matlas.Client, projectState,
 		teamID := team.TeamID.ValueString()
 		_, err := conn.Teams.RemoveTeamFromProject(ctx, projectID, team.TeamID.ValueString())
 		if err != nil {
-			var target *matlas.ErrorResponse
-			if errors.As(err, &target) && target.ErrorCode != "USER_UNAUTHORIZED" {
+			if _, ok := apierror.From(err); ok && !apierror.HasErrorCode(err, apierror.CodeUserUnauthorized) {
 				return fmt.Errorf("error removing team(%s) from the project(%s): %s", teamID, projectID, err)
 			}
 			log.Printf("[WARN] error removing team(%s) from the project(%s): %s", teamID, projectID, err)
@@ -812,16 +821,17 @@ func newProjectUpdateRequest(tfProject *tfProjectRSModel) *matlas.ProjectUpdateR
 }
 
 func deleteProject(ctx context.Context, conn *matlas.Client, projectID string) error {
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{projectDependentsStateDeleting, projectDependentsStateRetry},
-		Target:     []string{projectDependentsStateIdle},
-		Refresh:    resourceProjectDependentsDeletingRefreshFunc(ctx, projectID, conn),
-		Timeout:    30 * time.Minute,
-		MinTimeout: 30 * time.Second,
-		Delay:      0,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of the clusters of project %s", projectID),
+		Pending:      []string{projectDependentsStateDeleting, projectDependentsStateRetry},
+		Target:       []string{projectDependentsStateIdle},
+		Refresh:      resourceProjectDependentsDeletingRefreshFunc(ctx, projectID, conn),
+		Timeout:      30 * time.Minute,
+		PollInterval: 30 * time.Second,
+		Delay:        0,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := waiter.Wait(ctx)
 
 	if err != nil {
 		tflog.Info(ctx, fmt.Sprintf("[ERROR] could not determine MongoDB project %s dependents status: %s", projectID, err.Error()))
@@ -843,11 +853,10 @@ Else retry
 */
 func resourceProjectDependentsDeletingRefreshFunc(ctx context.Context, projectID string, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		var target *matlas.ErrorResponse
 		clusters, _, err := client.AdvancedClusters.List(ctx, projectID, nil)
 		dependents := AtlastProjectDependents{AdvancedClusters: clusters}
 
-		if errors.As(err, &target) {
+		if _, ok := apierror.From(err); ok {
 			return nil, "", err
 		}
 
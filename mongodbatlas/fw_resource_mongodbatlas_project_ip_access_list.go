@@ -3,7 +3,6 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 	"time"
 
@@ -17,19 +16,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	cstmvalidator "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/validator"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 const (
-	errorAccessListCreate          = "error creating Project IP Access List information: %s"
-	errorAccessListRead            = "error getting Project IP Access List information: %s"
-	errorAccessListDelete          = "error deleting Project IP Access List information: %s"
-	projectIPAccessListTimeout     = 45 * time.Minute
-	projectIPAccessListTimeoutRead = 2 * time.Minute
-	projectIPAccessListMinTimeout  = 2 * time.Second
-	projectIPAccessListDelay       = 4 * time.Second
-	projectIPAccessListRetry       = 2 * time.Minute
+	errorAccessListCreate           = "error creating Project IP Access List information: %s"
+	errorAccessListRead             = "error getting Project IP Access List information: %s"
+	errorAccessListDelete           = "error deleting Project IP Access List information: %s"
+	projectIPAccessListTimeout      = 45 * time.Minute
+	projectIPAccessListTimeoutRead  = 2 * time.Minute
+	projectIPAccessListPollInterval = 2 * time.Second
+	projectIPAccessListDelay        = 4 * time.Second
+	projectIPAccessListRetry        = 2 * time.Minute
+	projectIPAccessListPageSize     = 500
 )
 
 type tfProjectIPAccessListModel struct {
@@ -56,6 +58,7 @@ func NewProjectIPAccessListRS() resource.Resource {
 
 var _ resource.ResourceWithConfigure = &ProjectIPAccessListRS{}
 var _ resource.ResourceWithImportState = &ProjectIPAccessListRS{}
+var _ resource.ResourceWithModifyPlan = &ProjectIPAccessListRS{}
 
 func (r *ProjectIPAccessListRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -64,8 +67,10 @@ func (r *ProjectIPAccessListRS) Schema(ctx context.Context, req resource.SchemaR
 				Computed: true,
 			},
 			"project_id": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -128,6 +133,7 @@ func (r *ProjectIPAccessListRS) Schema(ctx context.Context, req resource.SchemaR
 }
 
 func (r *ProjectIPAccessListRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var projectIPAccessListModel *tfProjectIPAccessListModel
 
 	diags := req.Plan.Get(ctx, &projectIPAccessListModel)
@@ -143,15 +149,14 @@ func (r *ProjectIPAccessListRS) Create(ctx context.Context, req resource.CreateR
 
 	conn := r.client.Atlas
 	projectID := projectIPAccessListModel.ProjectID.ValueString()
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{"pending"},
-		Target:  []string{"created", "failed"},
-		Refresh: func() (any, string, error) {
+	waiter := &retrystrategy.Waiter[*matlas.ProjectIPAccessList]{
+		Operation: fmt.Sprintf("creation of access list entry in project %s", projectID),
+		Pending:   []string{"pending"},
+		Target:    []string{"created", "failed"},
+		Refresh: func() (*matlas.ProjectIPAccessList, string, error) {
 			_, _, err := conn.ProjectIPAccessList.Create(ctx, projectID, newMongoDBProjectIPAccessList(projectIPAccessListModel))
 			if err != nil {
-				if strings.Contains(err.Error(), "Unexpected error") ||
-					strings.Contains(err.Error(), "UNEXPECTED_ERROR") ||
-					strings.Contains(err.Error(), "500") {
+				if apierror.IsRetryable(err) {
 					return nil, "pending", nil
 				}
 				return nil, "failed", fmt.Errorf(errorAccessListCreate, err)
@@ -166,10 +171,7 @@ func (r *ProjectIPAccessListRS) Create(ctx context.Context, req resource.CreateR
 
 			entry, exists, err := isEntryInProjectAccessList(ctx, conn, projectID, accessListEntry)
 			if err != nil {
-				if strings.Contains(err.Error(), "500") {
-					return nil, "pending", nil
-				}
-				if strings.Contains(err.Error(), "404") {
+				if apierror.IsRetryable(err) || apierror.IsNotFound(err) {
 					return nil, "pending", nil
 				}
 				return nil, "failed", fmt.Errorf(errorAccessListCreate, err)
@@ -180,24 +182,18 @@ func (r *ProjectIPAccessListRS) Create(ctx context.Context, req resource.CreateR
 
 			return entry, "created", nil
 		},
-		Timeout:    projectIPAccessListTimeout,
-		Delay:      projectIPAccessListDelay,
-		MinTimeout: projectIPAccessListMinTimeout,
+		Timeout:      projectIPAccessListTimeout,
+		Delay:        projectIPAccessListDelay,
+		PollInterval: projectIPAccessListPollInterval,
 	}
 
 	// Wait, catching any errors
-	accessList, err := stateConf.WaitForStateContext(ctx)
+	entry, err := waiter.Wait(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("error while waiting for resource creation", err.Error())
 		return
 	}
 
-	entry, ok := accessList.(*matlas.ProjectIPAccessList)
-	if !ok {
-		resp.Diagnostics.AddError("error", errorAccessListCreate)
-		return
-	}
-
 	projectIPAccessListNewModel := newTFProjectIPAccessListModel(projectIPAccessListModel, entry)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &projectIPAccessListNewModel)...)
 	if resp.Diagnostics.HasError() {
@@ -261,17 +257,16 @@ func (r *ProjectIPAccessListRS) Read(ctx context.Context, req resource.ReadReque
 
 	conn := r.client.Atlas
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		accessList, httpResponse, err := conn.ProjectIPAccessList.Get(ctx, decodedIDMap["project_id"], decodedIDMap["entry"])
+		accessList, err := getProjectIPAccessListEntry(ctx, conn, decodedIDMap["project_id"], decodedIDMap["entry"])
 		if err != nil {
 			// case 404
 			// deleted in the backend case
-			if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			if apierror.IsNotFound(err) {
 				resp.State.RemoveResource(ctx)
-				resp.Diagnostics.AddError("resource not found", err.Error())
 				return nil
 			}
 
-			if httpResponse != nil && httpResponse.StatusCode == http.StatusInternalServerError {
+			if apierror.IsRetryable(err) {
 				return retry.RetryableError(err)
 			}
 
@@ -279,6 +274,11 @@ func (r *ProjectIPAccessListRS) Read(ctx context.Context, req resource.ReadReque
 			return nil
 		}
 
+		if accessList == nil {
+			resp.State.RemoveResource(ctx)
+			return nil
+		}
+
 		projectIPAccessListNewModel := newTFProjectIPAccessListModel(projectIPAccessListModelState, accessList)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &projectIPAccessListNewModel)...)
 		return nil
@@ -289,7 +289,29 @@ func (r *ProjectIPAccessListRS) Read(ctx context.Context, req resource.ReadReque
 	}
 }
 
+// getProjectIPAccessListEntry finds entry in the access list of the project, returning nil if it doesn't exist. Entries
+// are read from the whole list instead of individually so the concurrent refresh of all the entries of a project is
+// coalesced into a few identical requests.
+func getProjectIPAccessListEntry(ctx context.Context, conn *matlas.Client, projectID, entry string) (*matlas.ProjectIPAccessList, error) {
+	options := &matlas.ListOptions{ItemsPerPage: projectIPAccessListPageSize}
+	for options.PageNum = 1; ; options.PageNum++ {
+		accessList, _, err := conn.ProjectIPAccessList.List(ctx, projectID, options)
+		if err != nil {
+			return nil, err
+		}
+		for i := range accessList.Results {
+			if result := &accessList.Results[i]; result.IPAddress == entry || result.CIDRBlock == entry || result.AwsSecurityGroup == entry {
+				return result, nil
+			}
+		}
+		if len(accessList.Results) < options.ItemsPerPage || options.PageNum*options.ItemsPerPage >= accessList.TotalCount {
+			return nil, nil
+		}
+	}
+}
+
 func (r *ProjectIPAccessListRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
 	var projectIPAccessListModelState *tfProjectIPAccessListModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &projectIPAccessListModelState)...)
@@ -314,13 +336,13 @@ func (r *ProjectIPAccessListRS) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		httpResponse, err := conn.ProjectIPAccessList.Delete(ctx, projectID, entry)
+		_, err := conn.ProjectIPAccessList.Delete(ctx, projectID, entry)
 		if err != nil {
-			if httpResponse != nil && httpResponse.StatusCode == http.StatusInternalServerError {
+			if apierror.IsRetryable(err) {
 				return retry.RetryableError(err)
 			}
 
-			if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			if apierror.IsNotFound(err) {
 				return nil
 			}
 
@@ -328,9 +350,9 @@ func (r *ProjectIPAccessListRS) Delete(ctx context.Context, req resource.DeleteR
 			return retry.NonRetryableError(fmt.Errorf(errorAccessListDelete, err))
 		}
 
-		entry, httpResponse, err := conn.ProjectIPAccessList.Get(ctx, projectID, entry)
+		entry, _, err := conn.ProjectIPAccessList.Get(ctx, projectID, entry)
 		if err != nil {
-			if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			if apierror.IsNotFound(err) {
 				return nil
 			}
 
@@ -349,6 +371,10 @@ func (r *ProjectIPAccessListRS) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+func (r *ProjectIPAccessListRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
 func (r *ProjectIPAccessListRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, "-", 2)
 
@@ -369,12 +395,12 @@ func (r *ProjectIPAccessListRS) ImportState(ctx context.Context, req resource.Im
 func isEntryInProjectAccessList(ctx context.Context, conn *matlas.Client, projectID, entry string) (*matlas.ProjectIPAccessList, bool, error) {
 	var out matlas.ProjectIPAccessList
 	err := retry.RetryContext(ctx, projectIPAccessListRetry, func() *retry.RetryError {
-		accessList, httpResponse, err := conn.ProjectIPAccessList.Get(ctx, projectID, entry)
+		accessList, _, err := conn.ProjectIPAccessList.Get(ctx, projectID, entry)
 		if err != nil {
 			switch {
-			case httpResponse != nil && httpResponse.StatusCode == http.StatusInternalServerError:
+			case apierror.IsRetryable(err):
 				return retry.RetryableError(err)
-			case httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound:
+			case apierror.IsNotFound(err):
 				return retry.RetryableError(err)
 			default:
 				return retry.NonRetryableError(fmt.Errorf(errorAccessListRead, err))
@@ -394,4 +420,5 @@ func isEntryInProjectAccessList(ctx context.Context, conn *matlas.Client, projec
 
 // Update is not supported
 func (r *ProjectIPAccessListRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
 }
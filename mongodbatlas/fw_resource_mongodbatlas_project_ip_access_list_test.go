@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
 )
 
 func TestAccProjectRSProjectIPAccesslist_SettingIPAddress(t *testing.T) {
@@ -246,6 +248,39 @@ func TestAccProjectRSProjectIPAccessList_importIncorrectId(t *testing.T) {
 	})
 }
 
+func TestProjectRSProjectIPAccessList_offline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	resourceName := "mongodbatlas_project_ip_access_list.test"
+	ipAddress := "179.154.226.10"
+	updatedIPAddress := "179.154.228.10"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectIPAccessListDestroyOffline(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasProjectIPAccessListConfigSettingIPAddress(offlineOrgID, "offline-project", ipAddress, "offline"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", ipAddress),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", ipAddress+"/32"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "offline"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasProjectIPAccessListConfigSettingIPAddress(offlineOrgID, "offline-project", updatedIPAddress, "offline updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip_address", updatedIPAddress),
+					resource.TestCheckResourceAttr(resourceName, "comment", "offline updated"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasProjectIPAccessListExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testMongoDBClient.(*MongoDBClient).Atlas
@@ -289,6 +324,26 @@ func testAccCheckMongoDBAtlasProjectIPAccessListDestroy(s *terraform.State) erro
 	return nil
 }
 
+func testAccCheckMongoDBAtlasProjectIPAccessListDestroyOffline(server *atlastest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testOfflineClient(server).Atlas
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "mongodbatlas_project_ip_access_list" {
+				continue
+			}
+
+			ids := decodeStateID(rs.Primary.ID)
+
+			if _, _, err := conn.ProjectIPAccessList.Get(context.Background(), ids["project_id"], ids["entry"]); !apierror.IsNotFound(err) {
+				return fmt.Errorf("project ip access list entry (%s) still exists", ids["entry"])
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckMongoDBAtlasProjectIPAccessListImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
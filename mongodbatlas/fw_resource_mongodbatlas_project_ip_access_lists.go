@@ -0,0 +1,494 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
+	cstmvalidator "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/validator"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	projectIPAccessLists          = "project_ip_access_lists"
+	projectIPAccessListsBatchSize = 100
+	errorAccessListsApply         = "error applying the access list of project (%s): %s"
+	errorAccessListsRead          = "error getting the access list of project (%s): %s"
+	errorAccessListsDelete        = "error deleting the access list of project (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &ProjectIPAccessListsRS{}
+var _ resource.ResourceWithImportState = &ProjectIPAccessListsRS{}
+var _ resource.ResourceWithModifyPlan = &ProjectIPAccessListsRS{}
+
+func NewProjectIPAccessListsRS() resource.Resource {
+	return &ProjectIPAccessListsRS{
+		RSCommon: RSCommon{
+			resourceName: projectIPAccessLists,
+		},
+	}
+}
+
+// ProjectIPAccessListsRS manages a set of entries of the access list of a project with a single list request per
+// operation and batched creations. Entries that aren't configured are deleted when the resource is authoritative
+// and reported in unmanaged_entries otherwise.
+type ProjectIPAccessListsRS struct {
+	RSCommon
+}
+
+type tfProjectIPAccessListsModel struct {
+	ID               types.String                       `tfsdk:"id"`
+	ProjectID        types.String                       `tfsdk:"project_id"`
+	Authoritative    types.Bool                         `tfsdk:"authoritative"`
+	Entries          []tfProjectIPAccessListsEntryModel `tfsdk:"entries"`
+	UnmanagedEntries types.Set                          `tfsdk:"unmanaged_entries"`
+	Timeouts         timeouts.Value                     `tfsdk:"timeouts"`
+}
+
+type tfProjectIPAccessListsEntryModel struct {
+	CIDRBlock        types.String `tfsdk:"cidr_block"`
+	IPAddress        types.String `tfsdk:"ip_address"`
+	AWSSecurityGroup types.String `tfsdk:"aws_security_group"`
+	Comment          types.String `tfsdk:"comment"`
+	DeleteAfterDate  types.String `tfsdk:"delete_after_date"`
+}
+
+func (r *ProjectIPAccessListsRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"unmanaged_entries": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"entries": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_block": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								cstmvalidator.ValidCIDR(),
+								stringvalidator.ExactlyOneOf(path.Expressions{
+									path.MatchRelative().AtParent().AtName("ip_address"),
+									path.MatchRelative().AtParent().AtName("aws_security_group"),
+								}...),
+							},
+						},
+						"ip_address": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								cstmvalidator.ValidIP(),
+							},
+						},
+						"aws_security_group": schema.StringAttribute{
+							Optional: true,
+						},
+						"comment": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"delete_after_date": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ProjectIPAccessListsRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var accessListsPlan tfProjectIPAccessListsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &accessListsPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := accessListsPlan.Timeouts.Create(ctx, projectIPAccessListTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &accessListsPlan, nil, timeout, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ProjectIPAccessListsRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var accessListsState tfProjectIPAccessListsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &accessListsState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := accessListsState.ProjectID.ValueString()
+	current, err := listProjectIPAccessList(ctx, r.client.Atlas, projectID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorAccessListsRead, projectID, err))
+		return
+	}
+
+	// an imported resource has no authoritative value yet, all the entries of the project are imported
+	includeAll := accessListsState.Authoritative.IsNull() || accessListsState.Authoritative.ValueBool()
+	if accessListsState.Authoritative.IsNull() {
+		accessListsState.Authoritative = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(setProjectIPAccessListsState(ctx, &resp.State, &accessListsState, current, includeAll)...)
+}
+
+func (r *ProjectIPAccessListsRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var accessListsPlan, accessListsState tfProjectIPAccessListsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &accessListsPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &accessListsState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := accessListsPlan.Timeouts.Update(ctx, projectIPAccessListTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &accessListsPlan, accessListsState.Entries, timeout, &resp.State, &resp.Diagnostics)
+}
+
+func (r *ProjectIPAccessListsRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var accessListsState tfProjectIPAccessListsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &accessListsState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := accessListsState.Timeouts.Delete(ctx, projectIPAccessListTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := accessListsState.ProjectID.ValueString()
+	toDelete := make([]string, len(accessListsState.Entries))
+	for i := range accessListsState.Entries {
+		toDelete[i] = accessListsState.Entries[i].key()
+	}
+	if _, err := applyProjectIPAccessListChanges(ctx, r.client.Atlas, projectID, nil, toDelete, timeout); err != nil {
+		resp.Diagnostics.AddError("error during resource delete", fmt.Sprintf(errorAccessListsDelete, projectID, err))
+	}
+}
+
+func (r *ProjectIPAccessListsRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
+func (r *ProjectIPAccessListsRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+// apply makes the access list of the project match plan, previous are the entries managed before the change.
+func (r *ProjectIPAccessListsRS) apply(ctx context.Context, plan *tfProjectIPAccessListsModel, previous []tfProjectIPAccessListsEntryModel,
+	timeout time.Duration, state *tfsdk.State, diags *diag.Diagnostics) {
+	conn := r.client.Atlas
+	projectID := plan.ProjectID.ValueString()
+	current, err := listProjectIPAccessList(ctx, conn, projectID)
+	if err != nil {
+		diags.AddError("error fetching resource", fmt.Sprintf(errorAccessListsRead, projectID, err))
+		return
+	}
+
+	toCreate, toDelete, _ := projectIPAccessListChanges(current, plan.Entries, previous, plan.Authoritative.ValueBool())
+	current, err = applyProjectIPAccessListChanges(ctx, conn, projectID, toCreate, toDelete, timeout)
+	if err != nil {
+		diags.AddError("error applying resource", fmt.Sprintf(errorAccessListsApply, projectID, err))
+		return
+	}
+
+	diags.Append(setProjectIPAccessListsState(ctx, state, plan, current, false)...)
+}
+
+func setProjectIPAccessListsState(ctx context.Context, state *tfsdk.State, model *tfProjectIPAccessListsModel,
+	current []matlas.ProjectIPAccessList, includeAll bool) diag.Diagnostics {
+	entries, unmanaged := newTFProjectIPAccessListsEntries(current, model.Entries, includeAll)
+	unmanagedEntries, diags := types.SetValueFrom(ctx, types.StringType, unmanaged)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.ID = model.ProjectID
+	model.Entries = entries
+	model.UnmanagedEntries = unmanagedEntries
+	diags.Append(state.Set(ctx, model)...)
+	return diags
+}
+
+// listProjectIPAccessList returns all the entries of the access list of a project.
+func listProjectIPAccessList(ctx context.Context, conn *matlas.Client, projectID string) ([]matlas.ProjectIPAccessList, error) {
+	var entries []matlas.ProjectIPAccessList
+	options := &matlas.ListOptions{ItemsPerPage: projectIPAccessListPageSize}
+	for options.PageNum = 1; ; options.PageNum++ {
+		accessList, _, err := conn.ProjectIPAccessList.List(ctx, projectID, options)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, accessList.Results...)
+		if len(accessList.Results) < options.ItemsPerPage || options.PageNum*options.ItemsPerPage >= accessList.TotalCount {
+			return entries, nil
+		}
+	}
+}
+
+// projectIPAccessListChanges compares the current access list of a project with the configured entries. It returns
+// the entries to create or update, the keys of the entries to delete and the keys of the entries that are left
+// unmanaged. Entries that aren't configured are deleted if authoritative is true or if they were in previous.
+func projectIPAccessListChanges(current []matlas.ProjectIPAccessList, entries, previous []tfProjectIPAccessListsEntryModel,
+	authoritative bool) (toCreate []*matlas.ProjectIPAccessList, toDelete, unmanaged []string) {
+	currentByKey := make(map[string]*matlas.ProjectIPAccessList, len(current))
+	for i := range current {
+		currentByKey[projectIPAccessListEntryKey(&current[i])] = &current[i]
+	}
+
+	configured := make(map[string]bool, len(entries))
+	for i := range entries {
+		entry := entries[i].newProjectIPAccessList()
+		key := entries[i].key()
+		configured[key] = true
+		if existing, ok := currentByKey[key]; !ok || existing.Comment != entry.Comment ||
+			!equalDeleteAfterDate(existing.DeleteAfterDate, entry.DeleteAfterDate) {
+			toCreate = append(toCreate, entry)
+		}
+	}
+
+	managed := make(map[string]bool, len(previous))
+	for i := range previous {
+		managed[previous[i].key()] = true
+	}
+	for key := range currentByKey {
+		switch {
+		case configured[key]:
+		case authoritative || managed[key]:
+			toDelete = append(toDelete, key)
+		default:
+			unmanaged = append(unmanaged, key)
+		}
+	}
+	sort.Strings(toDelete)
+	sort.Strings(unmanaged)
+	return toCreate, toDelete, unmanaged
+}
+
+// applyProjectIPAccessListChanges creates the entries in toCreate in batches and deletes the entries in toDelete,
+// then waits until the access list reflects the changes and returns it. The requests are retried on rate limiting and
+// server errors, which the retry transport doesn't retry for POST requests or 500 responses.
+func applyProjectIPAccessListChanges(ctx context.Context, conn *matlas.Client, projectID string, toCreate []*matlas.ProjectIPAccessList,
+	toDelete []string, timeout time.Duration) ([]matlas.ProjectIPAccessList, error) {
+	for start := 0; start < len(toCreate); start += projectIPAccessListsBatchSize {
+		end := start + projectIPAccessListsBatchSize
+		if end > len(toCreate) {
+			end = len(toCreate)
+		}
+		batch := toCreate[start:end]
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			if _, _, err := conn.ProjectIPAccessList.Create(ctx, projectID, batch); err != nil {
+				if apierror.IsRetryable(err) {
+					return retry.RetryableError(err)
+				}
+				return retry.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, entry := range toDelete {
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			if _, err := conn.ProjectIPAccessList.Delete(ctx, projectID, entry); err != nil && !apierror.IsNotFound(err) {
+				if apierror.IsRetryable(err) {
+					return retry.RetryableError(err)
+				}
+				return retry.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	waiter := &retrystrategy.Waiter[[]matlas.ProjectIPAccessList]{
+		Operation: fmt.Sprintf("update of access list of project %s", projectID),
+		Pending:   []string{"pending"},
+		Target:    []string{"applied"},
+		Refresh: func() ([]matlas.ProjectIPAccessList, string, error) {
+			current, err := listProjectIPAccessList(ctx, conn, projectID)
+			if err != nil {
+				return nil, "", err
+			}
+			keys := make(map[string]bool, len(current))
+			for i := range current {
+				keys[projectIPAccessListEntryKey(&current[i])] = true
+			}
+			for _, entry := range toCreate {
+				if !keys[projectIPAccessListEntryKey(entry)] {
+					return nil, "pending", nil
+				}
+			}
+			for _, entry := range toDelete {
+				if keys[entry] {
+					return nil, "pending", nil
+				}
+			}
+			return current, "applied", nil
+		},
+		Timeout:      timeout,
+		PollInterval: projectIPAccessListPollInterval,
+	}
+	return waiter.Wait(ctx)
+}
+
+// newTFProjectIPAccessListsEntries returns the entries of the current access list that are in entries, or all of
+// them if includeAll is true, along with the keys of the other entries. Entries keep the attributes they are
+// configured with, e.g. a single IP address isn't reported as its /32 CIDR block.
+func newTFProjectIPAccessListsEntries(current []matlas.ProjectIPAccessList, entries []tfProjectIPAccessListsEntryModel,
+	includeAll bool) (tfEntries []tfProjectIPAccessListsEntryModel, unmanaged []string) {
+	byKey := make(map[string]*tfProjectIPAccessListsEntryModel, len(entries))
+	for i := range entries {
+		byKey[entries[i].key()] = &entries[i]
+	}
+
+	tfEntries = make([]tfProjectIPAccessListsEntryModel, 0, len(entries))
+	unmanaged = make([]string, 0)
+	for i := range current {
+		key := projectIPAccessListEntryKey(&current[i])
+		configured, ok := byKey[key]
+		if !ok && !includeAll {
+			unmanaged = append(unmanaged, key)
+			continue
+		}
+		tfEntries = append(tfEntries, newTFProjectIPAccessListsEntry(&current[i], configured))
+	}
+	sort.Strings(unmanaged)
+	return tfEntries, unmanaged
+}
+
+func newTFProjectIPAccessListsEntry(entry *matlas.ProjectIPAccessList, configured *tfProjectIPAccessListsEntryModel) tfProjectIPAccessListsEntryModel {
+	tfEntry := tfProjectIPAccessListsEntryModel{
+		CIDRBlock:        types.StringNull(),
+		IPAddress:        types.StringNull(),
+		AWSSecurityGroup: types.StringNull(),
+		Comment:          types.StringNull(),
+		DeleteAfterDate:  types.StringNull(),
+	}
+	switch {
+	case entry.AwsSecurityGroup != "":
+		tfEntry.AWSSecurityGroup = types.StringValue(entry.AwsSecurityGroup)
+	case configured != nil && !configured.IPAddress.IsNull():
+		tfEntry.IPAddress = configured.IPAddress
+	case configured != nil && !configured.CIDRBlock.IsNull():
+		tfEntry.CIDRBlock = configured.CIDRBlock
+	case entry.IPAddress != "":
+		tfEntry.IPAddress = types.StringValue(entry.IPAddress)
+	default:
+		tfEntry.CIDRBlock = types.StringValue(entry.CIDRBlock)
+	}
+	if entry.Comment != "" {
+		tfEntry.Comment = types.StringValue(entry.Comment)
+	}
+	if entry.DeleteAfterDate != "" {
+		tfEntry.DeleteAfterDate = types.StringValue(entry.DeleteAfterDate)
+		if configured != nil && equalDeleteAfterDate(entry.DeleteAfterDate, configured.DeleteAfterDate.ValueString()) {
+			tfEntry.DeleteAfterDate = configured.DeleteAfterDate
+		}
+	}
+	return tfEntry
+}
+
+func (m *tfProjectIPAccessListsEntryModel) newProjectIPAccessList() *matlas.ProjectIPAccessList {
+	return &matlas.ProjectIPAccessList{
+		CIDRBlock:        m.CIDRBlock.ValueString(),
+		IPAddress:        m.IPAddress.ValueString(),
+		AwsSecurityGroup: m.AWSSecurityGroup.ValueString(),
+		Comment:          m.Comment.ValueString(),
+		DeleteAfterDate:  m.DeleteAfterDate.ValueString(),
+	}
+}
+
+func (m *tfProjectIPAccessListsEntryModel) key() string {
+	return projectIPAccessListEntryKey(m.newProjectIPAccessList())
+}
+
+// projectIPAccessListEntryKey returns the value Atlas identifies an entry with, single IP addresses are identified
+// by their /32 (or /128) CIDR block as Atlas reports both for them.
+func projectIPAccessListEntryKey(entry *matlas.ProjectIPAccessList) string {
+	switch {
+	case entry.AwsSecurityGroup != "":
+		return entry.AwsSecurityGroup
+	case entry.CIDRBlock != "":
+		return entry.CIDRBlock
+	case strings.Contains(entry.IPAddress, ":"):
+		return entry.IPAddress + "/128"
+	default:
+		return entry.IPAddress + "/32"
+	}
+}
+
+// equalDeleteAfterDate compares two expiration dates, which Atlas can report in a different format than the one
+// they were configured with.
+func equalDeleteAfterDate(a, b string) bool {
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return timeA.Equal(timeB)
+}
@@ -0,0 +1,305 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAccProjectRSProjectIPAccessLists_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_project_ip_access_lists.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc-access-lists")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasProjectIPAccessListsConfig(orgID, projectName, false, "179.154.226.10", "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListsEntries(testMongoDBClient.(*MongoDBClient).Atlas, resourceName, "10.1.0.0/16", "179.154.226.10/32"),
+					resource.TestCheckResourceAttr(resourceName, "entries.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_entries.#", "0"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasProjectIPAccessListsConfig(orgID, projectName, false, "179.154.226.11", "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListsEntries(testMongoDBClient.(*MongoDBClient).Atlas, resourceName, "10.1.0.0/16", "179.154.226.11/32"),
+					resource.TestCheckResourceAttr(resourceName, "entries.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasProjectIPAccessListsImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+// TestProjectRSProjectIPAccessLists_offline checks that entries created outside of the resource are reported while
+// the resource isn't authoritative and removed once it is.
+func TestProjectRSProjectIPAccessLists_offline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	resourceName := "mongodbatlas_project_ip_access_lists.test"
+	addUnmanagedEntry := func() {
+		conn := testOfflineClient(server).Atlas
+		projects, _, err := conn.Projects.GetAllProjects(context.Background(), nil)
+		if err != nil || len(projects.Results) == 0 {
+			t.Fatalf("unexpected error listing the projects: %v", err)
+		}
+		if _, _, err := conn.ProjectIPAccessList.Create(context.Background(), projects.Results[0].ID,
+			[]*matlas.ProjectIPAccessList{{CIDRBlock: "192.168.0.0/24"}}); err != nil {
+			t.Fatalf("unexpected error creating the unmanaged entry: %s", err)
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasProjectIPAccessListsConfig(offlineOrgID, "offline-project", false, "179.154.226.10", "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListsEntries(testOfflineClient(server).Atlas, resourceName, "10.1.0.0/16", "179.154.226.10/32"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_entries.#", "0"),
+				),
+			},
+			{
+				PreConfig: addUnmanagedEntry,
+				Config:    testOfflineProviderConfig(server) + testAccMongoDBAtlasProjectIPAccessListsConfig(offlineOrgID, "offline-project", false, "179.154.226.10", "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListsEntries(testOfflineClient(server).Atlas, resourceName, "10.1.0.0/16", "179.154.226.10/32", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_entries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_entries.0", "192.168.0.0/24"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasProjectIPAccessListsConfig(offlineOrgID, "offline-project", true, "179.154.226.10", "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasProjectIPAccessListsEntries(testOfflineClient(server).Atlas, resourceName, "10.1.0.0/16", "179.154.226.10/32"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_entries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestProjectIPAccessListChanges(t *testing.T) {
+	entry := func(attr, value, comment string) tfProjectIPAccessListsEntryModel {
+		model := tfProjectIPAccessListsEntryModel{
+			CIDRBlock:        types.StringNull(),
+			IPAddress:        types.StringNull(),
+			AWSSecurityGroup: types.StringNull(),
+			Comment:          types.StringNull(),
+			DeleteAfterDate:  types.StringNull(),
+		}
+		switch attr {
+		case "ip_address":
+			model.IPAddress = types.StringValue(value)
+		case "cidr_block":
+			model.CIDRBlock = types.StringValue(value)
+		default:
+			model.AWSSecurityGroup = types.StringValue(value)
+		}
+		if comment != "" {
+			model.Comment = types.StringValue(comment)
+		}
+		return model
+	}
+	current := []matlas.ProjectIPAccessList{
+		{IPAddress: "1.2.3.4", CIDRBlock: "1.2.3.4/32", Comment: "office"},
+		{CIDRBlock: "10.0.0.0/16"},
+		{AwsSecurityGroup: "sg-12345"},
+		{CIDRBlock: "192.168.0.0/24"},
+	}
+
+	tests := []struct {
+		name          string
+		entries       []tfProjectIPAccessListsEntryModel
+		previous      []tfProjectIPAccessListsEntryModel
+		authoritative bool
+		wantCreate    []string
+		wantDelete    []string
+		wantUnmanaged []string
+	}{
+		{
+			name:          "ip address matches its cidr block",
+			entries:       []tfProjectIPAccessListsEntryModel{entry("ip_address", "1.2.3.4", "office"), entry("cidr_block", "10.0.0.0/16", "")},
+			wantUnmanaged: []string{"192.168.0.0/24", "sg-12345"},
+		},
+		{
+			name:          "new entry and comment change",
+			entries:       []tfProjectIPAccessListsEntryModel{entry("cidr_block", "1.2.3.4/32", "home"), entry("ip_address", "5.6.7.8", "")},
+			wantCreate:    []string{"1.2.3.4/32", "5.6.7.8/32"},
+			wantUnmanaged: []string{"10.0.0.0/16", "192.168.0.0/24", "sg-12345"},
+		},
+		{
+			name:          "entry removed from configuration",
+			entries:       []tfProjectIPAccessListsEntryModel{entry("ip_address", "1.2.3.4", "office")},
+			previous:      []tfProjectIPAccessListsEntryModel{entry("ip_address", "1.2.3.4", "office"), entry("aws_security_group", "sg-12345", "")},
+			wantDelete:    []string{"sg-12345"},
+			wantUnmanaged: []string{"10.0.0.0/16", "192.168.0.0/24"},
+		},
+		{
+			name:          "authoritative",
+			entries:       []tfProjectIPAccessListsEntryModel{entry("ip_address", "1.2.3.4", "office")},
+			authoritative: true,
+			wantDelete:    []string{"10.0.0.0/16", "192.168.0.0/24", "sg-12345"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toCreate, toDelete, unmanaged := projectIPAccessListChanges(current, tt.entries, tt.previous, tt.authoritative)
+			created := make([]string, len(toCreate))
+			for i := range toCreate {
+				created[i] = projectIPAccessListEntryKey(toCreate[i])
+			}
+			if fmt.Sprint(created) != fmt.Sprint(tt.wantCreate) || fmt.Sprint(toDelete) != fmt.Sprint(tt.wantDelete) ||
+				fmt.Sprint(unmanaged) != fmt.Sprint(tt.wantUnmanaged) {
+				t.Errorf("expected to create %v, delete %v and leave %v, got %v, %v and %v",
+					tt.wantCreate, tt.wantDelete, tt.wantUnmanaged, created, toDelete, unmanaged)
+			}
+		})
+	}
+}
+
+func TestApplyProjectIPAccessListChanges(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	// record the requests sent to the fake Atlas to check the creations are batched, and rate limit the first one
+	var requests []string
+	rateLimited := false
+	target, _ := url.Parse(server.URL())
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	recorder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/accessList") {
+			requests = append(requests, r.Method)
+			if r.Method == http.MethodPost && !rateLimited {
+				rateLimited = true
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"error":429,"errorCode":"RATE_LIMITED","reason":"Too Many Requests"}`))
+				return
+			}
+		}
+		proxy.ServeHTTP(w, r)
+	}))
+	defer recorder.Close()
+
+	conn, err := matlas.New(recorder.Client(), matlas.SetBaseURL(recorder.URL+"/"))
+	if err != nil {
+		t.Fatalf("unexpected error creating the client: %s", err)
+	}
+	ctx := context.Background()
+	project, _, err := conn.Projects.Create(ctx, &matlas.Project{Name: "access-lists", OrgID: offlineOrgID}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating the project: %s", err)
+	}
+
+	toCreate := make([]*matlas.ProjectIPAccessList, projectIPAccessListsBatchSize+10)
+	for i := range toCreate {
+		toCreate[i] = &matlas.ProjectIPAccessList{IPAddress: fmt.Sprintf("10.0.%d.%d", i/250, i%250)}
+	}
+	current, err := applyProjectIPAccessListChanges(ctx, conn, project.ID, toCreate, nil, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error creating the entries: %s", err)
+	}
+	if len(current) != len(toCreate) {
+		t.Errorf("expected %d entries, got %d", len(toCreate), len(current))
+	}
+
+	current, err = applyProjectIPAccessListChanges(ctx, conn, project.ID, nil, []string{"10.0.0.0/32", "10.0.0.1/32"}, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error deleting the entries: %s", err)
+	}
+	if len(current) != len(toCreate)-2 {
+		t.Errorf("expected %d entries, got %d", len(toCreate)-2, len(current))
+	}
+
+	expected := []string{"POST", "POST", "POST", "GET", "DELETE", "DELETE", "GET"}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectIPAccessListsEntries(conn *matlas.Client, resourceName string, entries ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		current, err := listProjectIPAccessList(context.Background(), conn, rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return fmt.Errorf(errorAccessListsRead, rs.Primary.Attributes["project_id"], err)
+		}
+		keys := make([]string, len(current))
+		for i := range current {
+			keys[i] = projectIPAccessListEntryKey(&current[i])
+		}
+		sort.Strings(keys)
+		if fmt.Sprint(keys) != fmt.Sprint(entries) {
+			return fmt.Errorf("expected the access list %v, got %v", entries, keys)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasProjectIPAccessListsImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["project_id"], nil
+	}
+}
+
+func testAccMongoDBAtlasProjectIPAccessListsConfig(orgID, projectName string, authoritative bool, ipAddress, cidrBlock string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_project" "test" {
+		name   = %[2]q
+		org_id = %[1]q
+	}
+
+	resource "mongodbatlas_project_ip_access_lists" "test" {
+		project_id    = mongodbatlas_project.test.id
+		authoritative = %[3]t
+
+		entries {
+			ip_address = %[4]q
+			comment    = "single address"
+		}
+
+		entries {
+			cidr_block = %[5]q
+		}
+	}
+	`, orgID, projectName, authoritative, ipAddress, cidrBlock)
+}
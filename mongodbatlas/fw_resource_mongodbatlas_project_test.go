@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
 	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -441,6 +446,112 @@ func TestAccProjectRSProject_withInvalidLimitNameOnUpdate(t *testing.T) {
 	})
 }
 
+func TestProjectRSProject_offline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	var (
+		resourceName = "mongodbatlas_project.test"
+		projectName  = "offline-project"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroyOffline(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasProjectConfig(projectName, offlineOrgID, nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", projectName),
+					resource.TestCheckResourceAttr(resourceName, "org_id", offlineOrgID),
+					resource.TestCheckResourceAttr(resourceName, "cluster_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", "true"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasProjectConfig(projectName+"-updated", offlineOrgID, nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", projectName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+// TestUpdateProjectTeams checks that the teams of a project are only updated when it has teams blocks, so imported
+// projects keep the teams assigned in Atlas on their first apply.
+func TestUpdateProjectTeams(t *testing.T) {
+	const teamID = "6b0a1f1d2e3c4b5a69788796"
+	ctx := context.Background()
+	teams := func(roleNames ...string) types.Set {
+		if len(roleNames) == 0 {
+			return types.SetValueMust(tfTeamObjectType, []attr.Value{})
+		}
+		roles, _ := types.SetValueFrom(ctx, types.StringType, roleNames)
+		set, _ := types.SetValueFrom(ctx, tfTeamObjectType, []tfTeamModel{{TeamID: types.StringValue(teamID), RoleNames: roles}})
+		return set
+	}
+
+	testCases := []struct {
+		planTeams        types.Set
+		name             string
+		expectedRoles    []string
+		expectedRequests []string
+	}{
+		{
+			name:          "imported project without teams blocks",
+			planTeams:     teams(),
+			expectedRoles: []string{"GROUP_READ_ONLY"},
+		},
+		{
+			name:             "changed roles",
+			planTeams:        teams("GROUP_OWNER"),
+			expectedRoles:    []string{"GROUP_OWNER"},
+			expectedRequests: []string{"PATCH /teams/" + teamID, "POST /teams"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := atlastest.NewServer()
+			defer server.Close()
+			conn := testOfflineClient(server).Atlas
+
+			project, _, err := conn.Projects.Create(ctx, &matlas.Project{Name: "imported", OrgID: offlineOrgID}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error creating the project: %s", err)
+			}
+			if _, _, err := conn.Projects.AddTeamsToProject(ctx, project.ID, []*matlas.ProjectTeam{{TeamID: teamID, RoleNames: []string{"GROUP_READ_ONLY"}}}); err != nil {
+				t.Fatalf("unexpected error assigning the team: %s", err)
+			}
+			setupRequests := len(server.Requests())
+
+			state := &tfProjectRSModel{ID: types.StringValue(project.ID), Teams: teams("GROUP_READ_ONLY")}
+			plan := &tfProjectRSModel{ID: types.StringValue(project.ID), Teams: tc.planTeams}
+			if err := updateProjectTeams(ctx, conn, state, plan); err != nil {
+				t.Fatalf("unexpected error updating the teams: %s", err)
+			}
+
+			var requests []string
+			for _, request := range server.Requests()[setupRequests:] {
+				requests = append(requests, strings.Replace(request, "/api/atlas/v1.0/groups/"+project.ID, "", 1))
+			}
+			if !reflect.DeepEqual(requests, tc.expectedRequests) {
+				t.Errorf("expected requests %v, got %v", tc.expectedRequests, requests)
+			}
+			assigned, _, err := conn.Projects.GetProjectTeamsAssigned(ctx, project.ID)
+			if err != nil {
+				t.Fatalf("unexpected error reading the teams: %s", err)
+			}
+			if team := findAssignedTeam(assigned, teamID); team == nil || !reflect.DeepEqual(team.RoleNames, tc.expectedRoles) {
+				t.Errorf("expected team (%s) to have roles %v, got %+v", teamID, tc.expectedRoles, team)
+			}
+		})
+	}
+}
+
 func testAccCheckMongoDBAtlasProjectExists(resourceName string, project *matlas.Project) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testMongoDBClient.(*MongoDBClient).Atlas
@@ -492,6 +603,24 @@ func testAccCheckMongoDBAtlasProjectDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckMongoDBAtlasProjectDestroyOffline(server *atlastest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testOfflineClient(server).Atlas
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "mongodbatlas_project" {
+				continue
+			}
+
+			if _, _, err := conn.Projects.GetOneProject(context.Background(), rs.Primary.ID); !apierror.IsNotFound(err) {
+				return fmt.Errorf("project (%s) still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckMongoDBAtlasProjectImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -0,0 +1,248 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	pushBasedLogExportName        = "push_based_log_export"
+	pushBasedLogExportTimeout     = 15 * time.Minute
+	errorPushBasedLogExportCreate = "error creating push-based log export for project (%s): %s"
+	errorPushBasedLogExportRead   = "error getting push-based log export for project (%s): %s"
+	errorPushBasedLogExportUpdate = "error updating push-based log export for project (%s): %s"
+	errorPushBasedLogExportDelete = "error deleting push-based log export for project (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &PushBasedLogExportRS{}
+var _ resource.ResourceWithImportState = &PushBasedLogExportRS{}
+var _ resource.ResourceWithModifyPlan = &PushBasedLogExportRS{}
+
+func NewPushBasedLogExportRS() resource.Resource {
+	return &PushBasedLogExportRS{
+		RSCommon: RSCommon{
+			resourceName: pushBasedLogExportName,
+		},
+	}
+}
+
+// PushBasedLogExportRS configures a project to push its logs to an S3 bucket. A project has at most one
+// configuration, so the project ID identifies it.
+type PushBasedLogExportRS struct {
+	RSCommon
+}
+
+type tfPushBasedLogExportModel struct {
+	ID         types.String   `tfsdk:"id"`
+	ProjectID  types.String   `tfsdk:"project_id"`
+	BucketName types.String   `tfsdk:"bucket_name"`
+	IamRoleID  types.String   `tfsdk:"iam_role_id"`
+	PrefixPath types.String   `tfsdk:"prefix_path"`
+	State      types.String   `tfsdk:"state"`
+	CreateDate types.String   `tfsdk:"create_date"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *PushBasedLogExportRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+			},
+			"iam_role_id": schema.StringAttribute{
+				Required: true,
+			},
+			"prefix_path": schema.StringAttribute{
+				Required: true,
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"create_date": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *PushBasedLogExportRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var logExportPlan tfPushBasedLogExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &logExportPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := logExportPlan.Timeouts.Create(ctx, pushBasedLogExportTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.client.AtlasV2
+	projectID := logExportPlan.ProjectID.ValueString()
+	if _, err := connV2.PushBasedLogExportApi.CreatePushBasedLogConfiguration(ctx, projectID, newPushBasedLogExport(&logExportPlan)).Execute(); err != nil {
+		resp.Diagnostics.AddError("error during push-based log export creation", fmt.Sprintf(errorPushBasedLogExportCreate, projectID, err))
+		return
+	}
+
+	logExport, err := newPushBasedLogExportWaiter(ctx, connV2, projectID, "creation", false, timeout).Wait(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("error while waiting for push-based log export creation", fmt.Sprintf(errorPushBasedLogExportCreate, projectID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFPushBasedLogExportModel(projectID, logExport, logExportPlan.Timeouts))...)
+}
+
+func (r *PushBasedLogExportRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var logExportState tfPushBasedLogExportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &logExportState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := logExportState.ProjectID.ValueString()
+	logExport, _, err := r.client.AtlasV2.PushBasedLogExportApi.GetPushBasedLogConfiguration(ctx, projectID).Execute()
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorPushBasedLogExportRead, projectID, err))
+		return
+	}
+	if logExport.GetState() == pushBasedLogExportStateUnconfigured {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFPushBasedLogExportModel(projectID, logExport, logExportState.Timeouts))...)
+}
+
+func (r *PushBasedLogExportRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var logExportPlan tfPushBasedLogExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &logExportPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := logExportPlan.Timeouts.Update(ctx, pushBasedLogExportTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.client.AtlasV2
+	projectID := logExportPlan.ProjectID.ValueString()
+	if _, err := connV2.PushBasedLogExportApi.UpdatePushBasedLogConfiguration(ctx, projectID, newPushBasedLogExport(&logExportPlan)).Execute(); err != nil {
+		resp.Diagnostics.AddError("error updating resource", fmt.Sprintf(errorPushBasedLogExportUpdate, projectID, err))
+		return
+	}
+
+	logExport, err := newPushBasedLogExportWaiter(ctx, connV2, projectID, "update", false, timeout).Wait(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("error while waiting for push-based log export update", fmt.Sprintf(errorPushBasedLogExportUpdate, projectID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFPushBasedLogExportModel(projectID, logExport, logExportPlan.Timeouts))...)
+}
+
+func (r *PushBasedLogExportRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var logExportState tfPushBasedLogExportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &logExportState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := logExportState.Timeouts.Delete(ctx, pushBasedLogExportTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.client.AtlasV2
+	projectID := logExportState.ProjectID.ValueString()
+	if _, err := connV2.PushBasedLogExportApi.DeletePushBasedLogConfiguration(ctx, projectID).Execute(); err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("error during resource delete", fmt.Sprintf(errorPushBasedLogExportDelete, projectID, err))
+		return
+	}
+
+	if _, err := newPushBasedLogExportWaiter(ctx, connV2, projectID, "deletion", true, timeout).Wait(ctx); err != nil {
+		resp.Diagnostics.AddError("error while waiting for push-based log export deletion", fmt.Sprintf(errorPushBasedLogExportDelete, projectID, err))
+	}
+}
+
+func (r *PushBasedLogExportRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
+func (r *PushBasedLogExportRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+func newPushBasedLogExport(model *tfPushBasedLogExportModel) *admin.PushBasedLogExportProject {
+	return &admin.PushBasedLogExportProject{
+		BucketName: model.BucketName.ValueStringPointer(),
+		IamRoleId:  model.IamRoleID.ValueStringPointer(),
+		PrefixPath: model.PrefixPath.ValueStringPointer(),
+	}
+}
+
+func newTFPushBasedLogExportModel(projectID string, logExport *admin.PushBasedLogExportProject, timeout timeouts.Value) *tfPushBasedLogExportModel {
+	createDate := types.StringNull()
+	if logExport.CreateDate != nil {
+		createDate = types.StringValue(logExport.CreateDate.Format(time.RFC3339))
+	}
+	return &tfPushBasedLogExportModel{
+		ID:         types.StringValue(projectID),
+		ProjectID:  types.StringValue(projectID),
+		BucketName: types.StringValue(logExport.GetBucketName()),
+		IamRoleID:  types.StringValue(logExport.GetIamRoleId()),
+		PrefixPath: types.StringValue(logExport.GetPrefixPath()),
+		State:      types.StringValue(logExport.GetState()),
+		CreateDate: createDate,
+		Timeouts:   timeout,
+	}
+}
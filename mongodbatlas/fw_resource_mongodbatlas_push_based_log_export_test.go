@@ -0,0 +1,179 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccProjectRSPushBasedLogExport_basic(t *testing.T) {
+	SkipTestExtCred(t)
+	var (
+		resourceName   = "mongodbatlas_push_based_log_export.test"
+		dataSourceName = "data.mongodbatlas_push_based_log_export.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc-log-export")
+		bucketName     = acctest.RandomWithPrefix("test-acc-log-export")
+		roleName       = acctest.RandomWithPrefix("test-acc-log-export")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheckBasic(t) },
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"aws": {
+				VersionConstraint: "5.1.0",
+				Source:            "hashicorp/aws",
+			},
+		},
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasPushBasedLogExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasPushBasedLogExportConfig(orgID, projectName, bucketName, roleName, "atlas-logs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasPushBasedLogExportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "prefix_path", "atlas-logs"),
+					resource.TestCheckResourceAttr(resourceName, "state", pushBasedLogExportStateActive),
+					resource.TestCheckResourceAttrSet(resourceName, "create_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_id", "mongodbatlas_cloud_provider_access_authorization.test", "role_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket_name", resourceName, "bucket_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_role_id", resourceName, "iam_role_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", pushBasedLogExportStateActive),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasPushBasedLogExportConfig(orgID, projectName, bucketName, roleName, "atlas-logs/updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasPushBasedLogExportExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "prefix_path", "atlas-logs/updated"),
+					resource.TestCheckResourceAttr(resourceName, "state", pushBasedLogExportStateActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasPushBasedLogExportImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasPushBasedLogExportExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.Attributes["project_id"] == "" {
+			return fmt.Errorf("no project_id is set")
+		}
+
+		connV2 := testAccProviderSdkV2.Meta().(*MongoDBClient).AtlasV2
+		logExport, _, err := connV2.PushBasedLogExportApi.GetPushBasedLogConfiguration(context.Background(), rs.Primary.Attributes["project_id"]).Execute()
+		if err != nil || logExport.GetState() == pushBasedLogExportStateUnconfigured {
+			return fmt.Errorf("push-based log export of project (%s) does not exist: %v", rs.Primary.Attributes["project_id"], err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasPushBasedLogExportDestroy(s *terraform.State) error {
+	connV2 := testAccProviderSdkV2.Meta().(*MongoDBClient).AtlasV2
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_push_based_log_export" {
+			continue
+		}
+		logExport, _, err := connV2.PushBasedLogExportApi.GetPushBasedLogConfiguration(context.Background(), rs.Primary.Attributes["project_id"]).Execute()
+		if err == nil && logExport.GetState() != pushBasedLogExportStateUnconfigured {
+			return fmt.Errorf("push-based log export of project (%s) still exists", rs.Primary.Attributes["project_id"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasPushBasedLogExportImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["project_id"], nil
+	}
+}
+
+func testAccMongoDBAtlasPushBasedLogExportConfig(orgID, projectName, bucketName, roleName, prefixPath string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_project" "test" {
+		name   = %[2]q
+		org_id = %[1]q
+	}
+
+	resource "aws_s3_bucket" "test" {
+		bucket        = %[3]q
+		force_destroy = true
+	}
+
+	resource "aws_iam_role" "test" {
+		name = %[4]q
+		assume_role_policy = jsonencode({
+			Version = "2012-10-17"
+			Statement = [{
+				Effect    = "Allow"
+				Principal = { AWS = mongodbatlas_cloud_provider_access_setup.test.aws_config[0].atlas_aws_account_arn }
+				Action    = "sts:AssumeRole"
+				Condition = {
+					StringEquals = { "sts:ExternalId" = mongodbatlas_cloud_provider_access_setup.test.aws_config[0].atlas_assumed_role_external_id }
+				}
+			}]
+		})
+	}
+
+	resource "aws_iam_role_policy" "test" {
+		name = %[4]q
+		role = aws_iam_role.test.id
+		policy = jsonencode({
+			Version = "2012-10-17"
+			Statement = [{
+				Effect   = "Allow"
+				Action   = ["s3:ListBucket", "s3:PutObject", "s3:GetObject", "s3:GetBucketLocation"]
+				Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+			}]
+		})
+	}
+
+	resource "mongodbatlas_cloud_provider_access_setup" "test" {
+		project_id    = mongodbatlas_project.test.id
+		provider_name = "AWS"
+	}
+
+	resource "mongodbatlas_cloud_provider_access_authorization" "test" {
+		project_id = mongodbatlas_project.test.id
+		role_id    = mongodbatlas_cloud_provider_access_setup.test.role_id
+
+		aws {
+			iam_assumed_role_arn = aws_iam_role.test.arn
+		}
+	}
+
+	resource "mongodbatlas_push_based_log_export" "test" {
+		project_id  = mongodbatlas_project.test.id
+		bucket_name = aws_s3_bucket.test.bucket
+		iam_role_id = mongodbatlas_cloud_provider_access_authorization.test.role_id
+		prefix_path = %[5]q
+
+		depends_on = [aws_iam_role_policy.test]
+	}
+
+	data "mongodbatlas_push_based_log_export" "test" {
+		project_id = mongodbatlas_push_based_log_export.test.project_id
+	}
+	`, orgID, projectName, bucketName, roleName, prefixPath)
+}
@@ -0,0 +1,296 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	searchDeploymentName        = "search_deployment"
+	searchDeploymentTimeout     = 3 * time.Hour
+	errorSearchDeploymentCreate = "error creating search deployment for cluster (%s): %s"
+	errorSearchDeploymentRead   = "error getting search deployment for cluster (%s): %s"
+	errorSearchDeploymentUpdate = "error updating search deployment for cluster (%s): %s"
+	errorSearchDeploymentDelete = "error deleting search deployment for cluster (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &SearchDeploymentRS{}
+var _ resource.ResourceWithImportState = &SearchDeploymentRS{}
+var _ resource.ResourceWithModifyPlan = &SearchDeploymentRS{}
+
+func NewSearchDeploymentRS() resource.Resource {
+	return &SearchDeploymentRS{
+		RSCommon: RSCommon{
+			resourceName: searchDeploymentName,
+		},
+	}
+}
+
+type SearchDeploymentRS struct {
+	RSCommon
+}
+
+type tfSearchDeploymentModel struct {
+	ID          types.String                  `tfsdk:"id"`
+	ProjectID   types.String                  `tfsdk:"project_id"`
+	ClusterName types.String                  `tfsdk:"cluster_name"`
+	StateName   types.String                  `tfsdk:"state_name"`
+	Specs       []tfSearchDeploymentSpecModel `tfsdk:"specs"`
+	Timeouts    timeouts.Value                `tfsdk:"timeouts"`
+}
+
+type tfSearchDeploymentSpecModel struct {
+	InstanceSize types.String `tfsdk:"instance_size"`
+	NodeCount    types.Int64  `tfsdk:"node_count"`
+}
+
+func (r *SearchDeploymentRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"specs": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_size": schema.StringAttribute{
+							Required: true,
+						},
+						"node_count": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(2, 32),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SearchDeploymentRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var searchDeploymentPlan tfSearchDeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &searchDeploymentPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := searchDeploymentPlan.Timeouts.Create(ctx, searchDeploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := searchDeploymentPlan.ProjectID.ValueString()
+	clusterName := searchDeploymentPlan.ClusterName.ValueString()
+	if _, err := createSearchDeployment(ctx, conn, projectID, clusterName, newSearchDeployment(&searchDeploymentPlan)); err != nil {
+		resp.Diagnostics.AddError("error during search deployment creation", fmt.Sprintf(errorSearchDeploymentCreate, clusterName, err))
+		return
+	}
+
+	deployment, err := newSearchDeploymentWaiter(ctx, conn, projectID, clusterName, "creation", false, timeout).Wait(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("error while waiting for search deployment creation", fmt.Sprintf(errorSearchDeploymentCreate, clusterName, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFSearchDeploymentModel(projectID, clusterName, deployment, searchDeploymentPlan.Timeouts))...)
+}
+
+func (r *SearchDeploymentRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var searchDeploymentState tfSearchDeploymentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &searchDeploymentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := searchDeploymentState.ProjectID.ValueString()
+	clusterName := searchDeploymentState.ClusterName.ValueString()
+	deployment, err := getSearchDeployment(ctx, r.client.Atlas, projectID, clusterName)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorSearchDeploymentRead, clusterName, err))
+		return
+	}
+	// Atlas can also report a deleted deployment as an empty one
+	if deployment.ID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFSearchDeploymentModel(projectID, clusterName, deployment, searchDeploymentState.Timeouts))...)
+}
+
+func (r *SearchDeploymentRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var searchDeploymentPlan tfSearchDeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &searchDeploymentPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := searchDeploymentPlan.Timeouts.Update(ctx, searchDeploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := searchDeploymentPlan.ProjectID.ValueString()
+	clusterName := searchDeploymentPlan.ClusterName.ValueString()
+	if _, err := updateSearchDeployment(ctx, conn, projectID, clusterName, newSearchDeployment(&searchDeploymentPlan)); err != nil {
+		resp.Diagnostics.AddError("error updating resource", fmt.Sprintf(errorSearchDeploymentUpdate, clusterName, err))
+		return
+	}
+
+	deployment, err := newSearchDeploymentWaiter(ctx, conn, projectID, clusterName, "update", false, timeout).Wait(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("error while waiting for search deployment update", fmt.Sprintf(errorSearchDeploymentUpdate, clusterName, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFSearchDeploymentModel(projectID, clusterName, deployment, searchDeploymentPlan.Timeouts))...)
+}
+
+func (r *SearchDeploymentRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var searchDeploymentState tfSearchDeploymentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &searchDeploymentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := searchDeploymentState.Timeouts.Delete(ctx, searchDeploymentTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := searchDeploymentState.ProjectID.ValueString()
+	clusterName := searchDeploymentState.ClusterName.ValueString()
+	if err := deleteSearchDeployment(ctx, conn, projectID, clusterName); err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("error during resource delete", fmt.Sprintf(errorSearchDeploymentDelete, clusterName, err))
+		return
+	}
+
+	if _, err := newSearchDeploymentWaiter(ctx, conn, projectID, clusterName, "deletion", true, timeout).Wait(ctx); err != nil {
+		resp.Diagnostics.AddError("error while waiting for search deployment deletion", fmt.Sprintf(errorSearchDeploymentDelete, clusterName, err))
+	}
+}
+
+func (r *SearchDeploymentRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
+func (r *SearchDeploymentRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, clusterName, err := splitSearchDeploymentImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("import format error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+}
+
+func splitSearchDeploymentImportID(id string) (projectID, clusterName string, err error) {
+	var re = regexp.MustCompile(`(?s)^([0-9a-fA-F]{24})-(.*)$`)
+	parts := re.FindStringSubmatch(id)
+
+	if len(parts) != 3 || parts[2] == "" {
+		err = errors.New("to import a search deployment, use the format {project_id}-{cluster_name}")
+		return
+	}
+
+	projectID = parts[1]
+	clusterName = parts[2]
+
+	return
+}
+
+func newSearchDeployment(model *tfSearchDeploymentModel) *searchDeployment {
+	deployment := &searchDeployment{
+		Specs: make([]searchDeploymentSpec, len(model.Specs)),
+	}
+	for i, spec := range model.Specs {
+		deployment.Specs[i] = searchDeploymentSpec{
+			InstanceSize: spec.InstanceSize.ValueString(),
+			NodeCount:    int(spec.NodeCount.ValueInt64()),
+		}
+	}
+	return deployment
+}
+
+func newTFSearchDeploymentModel(projectID, clusterName string, deployment *searchDeployment, timeout timeouts.Value) *tfSearchDeploymentModel {
+	model := &tfSearchDeploymentModel{
+		ID:          types.StringValue(deployment.ID),
+		ProjectID:   types.StringValue(projectID),
+		ClusterName: types.StringValue(clusterName),
+		StateName:   types.StringValue(deployment.StateName),
+		Specs:       make([]tfSearchDeploymentSpecModel, len(deployment.Specs)),
+		Timeouts:    timeout,
+	}
+	for i, spec := range deployment.Specs {
+		model.Specs[i] = tfSearchDeploymentSpecModel{
+			InstanceSize: types.StringValue(spec.InstanceSize),
+			NodeCount:    types.Int64Value(int64(spec.NodeCount)),
+		}
+	}
+	return model
+}
@@ -0,0 +1,144 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSearchRSSearchDeployment_basic(t *testing.T) {
+	var (
+		resourceName   = "mongodbatlas_search_deployment.test"
+		dataSourceName = "data.mongodbatlas_search_deployment.test"
+		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName    = acctest.RandomWithPrefix("test-acc-search-dep")
+		clusterName    = acctest.RandomWithPrefix("test-acc-search-dep")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasSearchDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSearchDeploymentConfig(orgID, projectName, clusterName, "S20_HIGHCPU_NVME", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasSearchDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", clusterName),
+					resource.TestCheckResourceAttr(resourceName, "state_name", searchDeploymentStateIdle),
+					resource.TestCheckResourceAttr(resourceName, "specs.0.instance_size", "S20_HIGHCPU_NVME"),
+					resource.TestCheckResourceAttr(resourceName, "specs.0.node_count", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "specs.0.instance_size", "S20_HIGHCPU_NVME"),
+					resource.TestCheckResourceAttr(dataSourceName, "specs.0.node_count", "2"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasSearchDeploymentConfig(orgID, projectName, clusterName, "S30_HIGHCPU_NVME", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasSearchDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state_name", searchDeploymentStateIdle),
+					resource.TestCheckResourceAttr(resourceName, "specs.0.instance_size", "S30_HIGHCPU_NVME"),
+					resource.TestCheckResourceAttr(resourceName, "specs.0.node_count", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasSearchDeploymentImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasSearchDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+		deployment, err := getSearchDeployment(context.Background(), conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["cluster_name"])
+		if err != nil || deployment.ID == "" {
+			return fmt.Errorf("search deployment of cluster (%s) does not exist: %v", rs.Primary.Attributes["cluster_name"], err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasSearchDeploymentDestroy(s *terraform.State) error {
+	conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_search_deployment" {
+			continue
+		}
+		deployment, err := getSearchDeployment(context.Background(), conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["cluster_name"])
+		if err == nil && deployment.ID != "" {
+			return fmt.Errorf("search deployment of cluster (%s) still exists", rs.Primary.Attributes["cluster_name"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasSearchDeploymentImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["cluster_name"]), nil
+	}
+}
+
+func testAccMongoDBAtlasSearchDeploymentConfig(orgID, projectName, clusterName, instanceSize string, nodeCount int) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_project" "test" {
+		name   = %[2]q
+		org_id = %[1]q
+	}
+
+	resource "mongodbatlas_advanced_cluster" "test" {
+		project_id   = mongodbatlas_project.test.id
+		name         = %[3]q
+		cluster_type = "REPLICASET"
+
+		replication_specs {
+			region_configs {
+				electable_specs {
+					instance_size = "M10"
+					node_count    = 3
+				}
+				provider_name = "AWS"
+				priority      = 7
+				region_name   = "US_EAST_1"
+			}
+		}
+	}
+
+	resource "mongodbatlas_search_deployment" "test" {
+		project_id   = mongodbatlas_advanced_cluster.test.project_id
+		cluster_name = mongodbatlas_advanced_cluster.test.name
+
+		specs {
+			instance_size = %[4]q
+			node_count    = %[5]d
+		}
+	}
+
+	data "mongodbatlas_search_deployment" "test" {
+		project_id   = mongodbatlas_search_deployment.test.project_id
+		cluster_name = mongodbatlas_search_deployment.test.cluster_name
+	}
+	`, orgID, projectName, clusterName, instanceSize, nodeCount)
+}
@@ -0,0 +1,401 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/conversion"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	streamConnectionName          = "stream_connection"
+	streamConnectionTypeKafka     = "Kafka"
+	streamConnectionTypeCluster   = "Cluster"
+	streamConnectionTypeSample    = "Sample"
+	errorStreamConnectionCreate   = "error creating Stream Connection: %s"
+	errorStreamConnectionRead     = "error getting Stream Connection (%s): %s"
+	errorStreamConnectionUpdate   = "error updating Stream Connection (%s): %s"
+	errorStreamConnectionDelete   = "error deleting Stream Connection (%s): %s"
+	errorStreamConnectionValidate = "%s is required for %s connections"
+)
+
+var _ resource.ResourceWithConfigure = &StreamConnectionRS{}
+var _ resource.ResourceWithImportState = &StreamConnectionRS{}
+var _ resource.ResourceWithModifyPlan = &StreamConnectionRS{}
+
+func NewStreamConnectionRS() resource.Resource {
+	return &StreamConnectionRS{
+		RSCommon: RSCommon{
+			resourceName: streamConnectionName,
+		},
+	}
+}
+
+type StreamConnectionRS struct {
+	RSCommon
+}
+
+type tfStreamConnectionModel struct {
+	ID               types.String                            `tfsdk:"id"`
+	ProjectID        types.String                            `tfsdk:"project_id"`
+	InstanceName     types.String                            `tfsdk:"instance_name"`
+	ConnectionName   types.String                            `tfsdk:"connection_name"`
+	Type             types.String                            `tfsdk:"type"`
+	ClusterName      types.String                            `tfsdk:"cluster_name"`
+	BootstrapServers types.String                            `tfsdk:"bootstrap_servers"`
+	Config           types.Map                               `tfsdk:"config"`
+	Authentication   []tfStreamConnectionAuthenticationModel `tfsdk:"authentication"`
+	Security         []tfStreamConnectionSecurityModel       `tfsdk:"security"`
+}
+
+type tfStreamConnectionAuthenticationModel struct {
+	Mechanism types.String `tfsdk:"mechanism"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+}
+
+type tfStreamConnectionSecurityModel struct {
+	Protocol                types.String `tfsdk:"protocol"`
+	BrokerPublicCertificate types.String `tfsdk:"broker_public_certificate"`
+}
+
+func (r *StreamConnectionRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(streamConnectionTypeKafka, streamConnectionTypeCluster, streamConnectionTypeSample),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"bootstrap_servers": schema.StringAttribute{
+				Optional: true,
+			},
+			"config": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"authentication": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mechanism": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("PLAIN", "SCRAM-256", "SCRAM-512"),
+							},
+						},
+						"username": schema.StringAttribute{
+							Required: true,
+						},
+						"password": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"security": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"protocol": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("PLAINTEXT", "SSL", "SASL_PLAINTEXT", "SASL_SSL"),
+							},
+						},
+						"broker_public_certificate": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *StreamConnectionRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamConnectionPlan tfStreamConnectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamConnectionPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateStreamConnection(&streamConnectionPlan); err != nil {
+		resp.Diagnostics.AddError("validation error", err.Error())
+		return
+	}
+
+	connV2 := r.client.AtlasV2
+	projectID := streamConnectionPlan.ProjectID.ValueString()
+	instanceName := streamConnectionPlan.InstanceName.ValueString()
+	streamsConnection, diags := newStreamsConnection(ctx, &streamConnectionPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connection, _, err := connV2.StreamsApi.CreateStreamConnection(ctx, projectID, instanceName, streamsConnection).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error during stream connection creation", fmt.Sprintf(errorStreamConnectionCreate, err))
+		return
+	}
+
+	newStreamConnectionModel, diags := newTFStreamConnectionModel(ctx, projectID, instanceName, connection, &streamConnectionPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
+}
+
+func (r *StreamConnectionRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var streamConnectionState tfStreamConnectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &streamConnectionState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.client.AtlasV2
+	projectID := streamConnectionState.ProjectID.ValueString()
+	instanceName := streamConnectionState.InstanceName.ValueString()
+	connectionName := streamConnectionState.ConnectionName.ValueString()
+	connection, _, err := connV2.StreamsApi.GetStreamConnection(ctx, projectID, instanceName, connectionName).Execute()
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorStreamConnectionRead, connectionName, err))
+		return
+	}
+
+	newStreamConnectionModel, diags := newTFStreamConnectionModel(ctx, projectID, instanceName, connection, &streamConnectionState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
+}
+
+func (r *StreamConnectionRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamConnectionPlan tfStreamConnectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamConnectionPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateStreamConnection(&streamConnectionPlan); err != nil {
+		resp.Diagnostics.AddError("validation error", err.Error())
+		return
+	}
+
+	connV2 := r.client.AtlasV2
+	projectID := streamConnectionPlan.ProjectID.ValueString()
+	instanceName := streamConnectionPlan.InstanceName.ValueString()
+	connectionName := streamConnectionPlan.ConnectionName.ValueString()
+	streamsConnection, diags := newStreamsConnection(ctx, &streamConnectionPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connection, _, err := connV2.StreamsApi.UpdateStreamConnection(ctx, projectID, instanceName, connectionName, streamsConnection).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("error updating resource", fmt.Sprintf(errorStreamConnectionUpdate, connectionName, err))
+		return
+	}
+
+	newStreamConnectionModel, diags := newTFStreamConnectionModel(ctx, projectID, instanceName, connection, &streamConnectionPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamConnectionModel)...)
+}
+
+func (r *StreamConnectionRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamConnectionState tfStreamConnectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &streamConnectionState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.client.AtlasV2
+	connectionName := streamConnectionState.ConnectionName.ValueString()
+	_, _, err := connV2.StreamsApi.DeleteStreamConnection(ctx, streamConnectionState.ProjectID.ValueString(),
+		streamConnectionState.InstanceName.ValueString(), connectionName).Execute()
+	if err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.AddError("error during resource delete", fmt.Sprintf(errorStreamConnectionDelete, connectionName, err))
+	}
+}
+
+func (r *StreamConnectionRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
+func (r *StreamConnectionRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, instanceName, connectionName, ok := splitStreamImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("import format error", "to import a stream connection, use the format {project_id}-{instance_name}--{connection_name}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_name"), instanceName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_name"), connectionName)...)
+}
+
+// splitStreamImportID splits the import ID of a connection or processor of a stream instance. Names can contain hyphens,
+// so the instance name is separated from the name of the connection or processor by a double hyphen.
+func splitStreamImportID(id string) (projectID, instanceName, name string, ok bool) {
+	var re = regexp.MustCompile(`(?s)^([0-9a-fA-F]{24})-(.+?)--(.+)$`)
+	parts := re.FindStringSubmatch(id)
+	if len(parts) != 4 {
+		return "", "", "", false
+	}
+	return parts[1], parts[2], parts[3], true
+}
+
+// validateStreamConnection checks the arguments required by the type of the connection.
+func validateStreamConnection(model *tfStreamConnectionModel) error {
+	connectionType := model.Type.ValueString()
+	switch connectionType {
+	case streamConnectionTypeCluster:
+		if model.ClusterName.ValueString() == "" {
+			return fmt.Errorf(errorStreamConnectionValidate, "cluster_name", connectionType)
+		}
+	case streamConnectionTypeKafka:
+		if model.BootstrapServers.ValueString() == "" {
+			return fmt.Errorf(errorStreamConnectionValidate, "bootstrap_servers", connectionType)
+		}
+		if len(model.Authentication) == 0 {
+			return fmt.Errorf(errorStreamConnectionValidate, "authentication", connectionType)
+		}
+		if len(model.Security) == 0 {
+			return fmt.Errorf(errorStreamConnectionValidate, "security", connectionType)
+		}
+	}
+	return nil
+}
+
+func newStreamsConnection(ctx context.Context, model *tfStreamConnectionModel) (*admin.StreamsConnection, diag.Diagnostics) {
+	connection := &admin.StreamsConnection{
+		Name:             model.ConnectionName.ValueStringPointer(),
+		Type:             model.Type.ValueStringPointer(),
+		ClusterName:      model.ClusterName.ValueStringPointer(),
+		BootstrapServers: model.BootstrapServers.ValueStringPointer(),
+	}
+	if !model.Config.IsNull() && !model.Config.IsUnknown() {
+		config := make(map[string]string)
+		if diags := model.Config.ElementsAs(ctx, &config, false); diags.HasError() {
+			return nil, diags
+		}
+		connection.Config = &config
+	}
+	if len(model.Authentication) > 0 {
+		connection.Authentication = &admin.StreamsKafkaAuthentication{
+			Mechanism: model.Authentication[0].Mechanism.ValueStringPointer(),
+			Username:  model.Authentication[0].Username.ValueStringPointer(),
+			Password:  model.Authentication[0].Password.ValueStringPointer(),
+		}
+	}
+	if len(model.Security) > 0 {
+		connection.Security = &admin.StreamsKafkaSecurity{
+			Protocol:                model.Security[0].Protocol.ValueStringPointer(),
+			BrokerPublicCertificate: model.Security[0].BrokerPublicCertificate.ValueStringPointer(),
+		}
+	}
+	return connection, nil
+}
+
+// newTFStreamConnectionModel converts a connection returned by Atlas. The password of the Kafka authentication isn't
+// returned by Atlas so it's kept from current, which can be nil in data sources.
+func newTFStreamConnectionModel(ctx context.Context, projectID, instanceName string, connection *admin.StreamsConnection,
+	current *tfStreamConnectionModel) (*tfStreamConnectionModel, diag.Diagnostics) {
+	connectionName := connection.GetName()
+	model := &tfStreamConnectionModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"project_id":      projectID,
+			"instance_name":   instanceName,
+			"connection_name": connectionName,
+		})),
+		ProjectID:        types.StringValue(projectID),
+		InstanceName:     types.StringValue(instanceName),
+		ConnectionName:   types.StringValue(connectionName),
+		Type:             types.StringValue(connection.GetType()),
+		ClusterName:      conversion.StringPtrNullIfEmpty(connection.ClusterName),
+		BootstrapServers: conversion.StringPtrNullIfEmpty(connection.BootstrapServers),
+		Config:           types.MapNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	if config := connection.GetConfig(); len(config) > 0 || current != nil && !current.Config.IsNull() {
+		model.Config, diags = types.MapValueFrom(ctx, types.StringType, config)
+	}
+	if authentication, ok := connection.GetAuthenticationOk(); ok {
+		password := types.StringNull()
+		if current != nil && len(current.Authentication) > 0 {
+			password = current.Authentication[0].Password
+		}
+		model.Authentication = []tfStreamConnectionAuthenticationModel{{
+			Mechanism: conversion.StringPtrNullIfEmpty(authentication.Mechanism),
+			Username:  conversion.StringPtrNullIfEmpty(authentication.Username),
+			Password:  password,
+		}}
+	}
+	if security, ok := connection.GetSecurityOk(); ok {
+		model.Security = []tfStreamConnectionSecurityModel{{
+			Protocol:                conversion.StringPtrNullIfEmpty(security.Protocol),
+			BrokerPublicCertificate: conversion.StringPtrNullIfEmpty(security.BrokerPublicCertificate),
+		}}
+	}
+	return model, diags
+}
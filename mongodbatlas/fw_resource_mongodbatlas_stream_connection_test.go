@@ -0,0 +1,248 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStreamRSStreamConnection_kafka(t *testing.T) {
+	var (
+		resourceName         = "mongodbatlas_stream_connection.test"
+		dataSourceName       = "data.mongodbatlas_stream_connection.test"
+		dataSourcePluralName = "data.mongodbatlas_stream_connections.test"
+		orgID                = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName          = acctest.RandomWithPrefix("test-acc-stream")
+		instanceName         = acctest.RandomWithPrefix("test-acc-instance")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasStreamConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasStreamConnectionKafkaConfig(orgID, projectName, instanceName, "localhost:9092,localhost:9093"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Kafka"),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_servers", "localhost:9092,localhost:9093"),
+					resource.TestCheckResourceAttr(resourceName, "authentication.0.mechanism", "PLAIN"),
+					resource.TestCheckResourceAttr(resourceName, "authentication.0.username", "user"),
+					resource.TestCheckResourceAttr(resourceName, "security.0.protocol", "SSL"),
+					resource.TestCheckResourceAttr(resourceName, "config.auto.offset.reset", "earliest"),
+					resource.TestCheckResourceAttr(dataSourceName, "bootstrap_servers", "localhost:9092,localhost:9093"),
+					resource.TestCheckResourceAttr(dataSourcePluralName, "results.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasStreamConnectionKafkaConfig(orgID, projectName, instanceName, "localhost:9094"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_servers", "localhost:9094"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasStreamConnectionImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authentication.0.password"},
+			},
+		},
+	})
+}
+
+func TestAccStreamRSStreamConnection_sample(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_stream_connection.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc-stream")
+		instanceName = acctest.RandomWithPrefix("test-acc-instance")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasStreamConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasStreamConnectionSampleConfig(orgID, projectName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_name", "sample_stream_solar"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Sample"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateStreamConnection(t *testing.T) {
+	tests := []struct {
+		name    string
+		model   tfStreamConnectionModel
+		wantErr string
+	}{
+		{
+			name:  "sample",
+			model: tfStreamConnectionModel{Type: types.StringValue(streamConnectionTypeSample)},
+		},
+		{
+			name:    "cluster without cluster name",
+			model:   tfStreamConnectionModel{Type: types.StringValue(streamConnectionTypeCluster)},
+			wantErr: "cluster_name is required for Cluster connections",
+		},
+		{
+			name:    "kafka without authentication",
+			model:   tfStreamConnectionModel{Type: types.StringValue(streamConnectionTypeKafka), BootstrapServers: types.StringValue("localhost:9092")},
+			wantErr: "authentication is required for Kafka connections",
+		},
+		{
+			name: "kafka",
+			model: tfStreamConnectionModel{
+				Type:             types.StringValue(streamConnectionTypeKafka),
+				BootstrapServers: types.StringValue("localhost:9092"),
+				Authentication:   []tfStreamConnectionAuthenticationModel{{Mechanism: types.StringValue("PLAIN")}},
+				Security:         []tfStreamConnectionSecurityModel{{Protocol: types.StringValue("SSL")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStreamConnection(&tt.model)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func testAccCheckMongoDBAtlasStreamConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		connV2 := testAccProviderSdkV2.Meta().(*MongoDBClient).AtlasV2
+		_, _, err := connV2.StreamsApi.GetStreamConnection(context.Background(), rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["instance_name"], rs.Primary.Attributes["connection_name"]).Execute()
+		if err != nil {
+			return fmt.Errorf("stream connection (%s) does not exist: %s", rs.Primary.Attributes["connection_name"], err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasStreamConnectionDestroy(s *terraform.State) error {
+	connV2 := testAccProviderSdkV2.Meta().(*MongoDBClient).AtlasV2
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_stream_connection" {
+			continue
+		}
+		_, _, err := connV2.StreamsApi.GetStreamConnection(context.Background(), rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["instance_name"], rs.Primary.Attributes["connection_name"]).Execute()
+		if err == nil {
+			return fmt.Errorf("stream connection (%s) still exists", rs.Primary.Attributes["connection_name"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasStreamConnectionImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s--%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["instance_name"],
+			rs.Primary.Attributes["connection_name"]), nil
+	}
+}
+
+func testAccMongoDBAtlasStreamConnectionKafkaConfig(orgID, projectName, instanceName, bootstrapServers string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "mongodbatlas_stream_connection" "test" {
+		project_id        = mongodbatlas_stream_instance.test.project_id
+		instance_name     = mongodbatlas_stream_instance.test.instance_name
+		connection_name   = "kafka"
+		type              = "Kafka"
+		bootstrap_servers = %[2]q
+		config = {
+			"auto.offset.reset" : "earliest"
+		}
+
+		authentication {
+			mechanism = "PLAIN"
+			username  = "user"
+			password  = "password"
+		}
+
+		security {
+			protocol = "SSL"
+		}
+	}
+
+	data "mongodbatlas_stream_connection" "test" {
+		project_id      = mongodbatlas_stream_connection.test.project_id
+		instance_name   = mongodbatlas_stream_connection.test.instance_name
+		connection_name = mongodbatlas_stream_connection.test.connection_name
+	}
+
+	data "mongodbatlas_stream_connections" "test" {
+		project_id    = mongodbatlas_stream_connection.test.project_id
+		instance_name = mongodbatlas_stream_connection.test.instance_name
+	}
+	`, testAccMongoDBAtlasStreamInstanceConfig(orgID, projectName, instanceName, "VIRGINIA_USA", "SP10"), bootstrapServers)
+}
+
+func testAccMongoDBAtlasStreamConnectionSampleConfig(orgID, projectName, instanceName string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "mongodbatlas_stream_connection" "test" {
+		project_id      = mongodbatlas_stream_instance.test.project_id
+		instance_name   = mongodbatlas_stream_instance.test.instance_name
+		connection_name = "sample_stream_solar"
+		type            = "Sample"
+	}
+	`, testAccMongoDBAtlasStreamInstanceConfig(orgID, projectName, instanceName, "VIRGINIA_USA", "SP10"))
+}
+
+func TestSplitStreamImportID(t *testing.T) {
+	tests := []struct {
+		id           string
+		projectID    string
+		instanceName string
+		name         string
+		ok           bool
+	}{
+		{"650972848269185c55f40ca1-instance--kafka", "650972848269185c55f40ca1", "instance", "kafka", true},
+		{"650972848269185c55f40ca1-test-acc-instance-123--test-acc-connection", "650972848269185c55f40ca1", "test-acc-instance-123", "test-acc-connection", true},
+		{"650972848269185c55f40ca1-instance-kafka", "", "", "", false},
+		{"project-instance--kafka", "", "", "", false},
+		{"650972848269185c55f40ca1-instance--", "", "", "", false},
+	}
+	for _, tt := range tests {
+		projectID, instanceName, name, ok := splitStreamImportID(tt.id)
+		if projectID != tt.projectID || instanceName != tt.instanceName || name != tt.name || ok != tt.ok {
+			t.Errorf("splitStreamImportID(%q) = (%q, %q, %q, %v); want (%q, %q, %q, %v)", tt.id, projectID, instanceName, name, ok,
+				tt.projectID, tt.instanceName, tt.name, tt.ok)
+		}
+	}
+}
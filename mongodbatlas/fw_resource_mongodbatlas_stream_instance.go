@@ -0,0 +1,282 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	streamInstanceName        = "stream_instance"
+	errorStreamInstanceCreate = "error creating Stream Instance: %s"
+	errorStreamInstanceRead   = "error getting Stream Instance (%s): %s"
+	errorStreamInstanceUpdate = "error updating Stream Instance (%s): %s"
+	errorStreamInstanceDelete = "error deleting Stream Instance (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &StreamInstanceRS{}
+var _ resource.ResourceWithImportState = &StreamInstanceRS{}
+var _ resource.ResourceWithModifyPlan = &StreamInstanceRS{}
+
+func NewStreamInstanceRS() resource.Resource {
+	return &StreamInstanceRS{
+		RSCommon: RSCommon{
+			resourceName: streamInstanceName,
+		},
+	}
+}
+
+type StreamInstanceRS struct {
+	RSCommon
+}
+
+type tfStreamInstanceModel struct {
+	ID                types.String                     `tfsdk:"id"`
+	ProjectID         types.String                     `tfsdk:"project_id"`
+	InstanceName      types.String                     `tfsdk:"instance_name"`
+	Hostnames         types.List                       `tfsdk:"hostnames"`
+	DataProcessRegion []tfStreamDataProcessRegionModel `tfsdk:"data_process_region"`
+	StreamConfig      []tfStreamConfigModel            `tfsdk:"stream_config"`
+}
+
+type tfStreamDataProcessRegionModel struct {
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Region        types.String `tfsdk:"region"`
+}
+
+type tfStreamConfigModel struct {
+	Tier types.String `tfsdk:"tier"`
+}
+
+func (r *StreamInstanceRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostnames": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"data_process_region": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cloud_provider": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("AWS", "AZURE"),
+							},
+						},
+						"region": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"stream_config": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tier": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("SP10", "SP30", "SP50"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *StreamInstanceRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamInstancePlan tfStreamInstanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamInstancePlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := streamInstancePlan.ProjectID.ValueString()
+	instance, err := createStreamInstance(ctx, conn, projectID, newStreamInstance(&streamInstancePlan))
+	if err != nil {
+		resp.Diagnostics.AddError("error during stream instance creation", fmt.Sprintf(errorStreamInstanceCreate, err))
+		return
+	}
+
+	newStreamInstanceModel, diags := newTFStreamInstanceModel(ctx, projectID, instance, len(streamInstancePlan.StreamConfig) > 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstanceModel)...)
+}
+
+func (r *StreamInstanceRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var streamInstanceState tfStreamInstanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &streamInstanceState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := streamInstanceState.ProjectID.ValueString()
+	instanceName := streamInstanceState.InstanceName.ValueString()
+	instance, err := getStreamInstance(ctx, conn, projectID, instanceName)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorStreamInstanceRead, instanceName, err))
+		return
+	}
+
+	newStreamInstanceModel, diags := newTFStreamInstanceModel(ctx, projectID, instance, len(streamInstanceState.StreamConfig) > 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstanceModel)...)
+}
+
+func (r *StreamInstanceRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamInstancePlan tfStreamInstanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamInstancePlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := streamInstancePlan.ProjectID.ValueString()
+	instanceName := streamInstancePlan.InstanceName.ValueString()
+	// the data process region is the only setting of an instance that can be updated
+	instance, err := updateStreamInstance(ctx, conn, projectID, instanceName, newStreamInstance(&streamInstancePlan).DataProcessRegion)
+	if err != nil {
+		resp.Diagnostics.AddError("error updating resource", fmt.Sprintf(errorStreamInstanceUpdate, instanceName, err))
+		return
+	}
+
+	newStreamInstanceModel, diags := newTFStreamInstanceModel(ctx, projectID, instance, len(streamInstancePlan.StreamConfig) > 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstanceModel)...)
+}
+
+func (r *StreamInstanceRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamInstanceState tfStreamInstanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &streamInstanceState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	instanceName := streamInstanceState.InstanceName.ValueString()
+	if err := deleteStreamInstance(ctx, conn, streamInstanceState.ProjectID.ValueString(), instanceName); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.AddError("error during resource delete", fmt.Sprintf(errorStreamInstanceDelete, instanceName, err))
+	}
+}
+
+func (r *StreamInstanceRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
+func (r *StreamInstanceRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("import format error", "to import a stream instance, use the format {project_id}-{instance_name}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_name"), parts[1])...)
+}
+
+func newStreamInstance(model *tfStreamInstanceModel) *streamInstance {
+	instance := &streamInstance{
+		Name: model.InstanceName.ValueString(),
+	}
+	if len(model.DataProcessRegion) > 0 {
+		instance.DataProcessRegion = &streamDataProcessRegion{
+			CloudProvider: model.DataProcessRegion[0].CloudProvider.ValueString(),
+			Region:        model.DataProcessRegion[0].Region.ValueString(),
+		}
+	}
+	if len(model.StreamConfig) > 0 {
+		instance.StreamConfig = &streamConfig{Tier: model.StreamConfig[0].Tier.ValueString()}
+	}
+	return instance
+}
+
+// newTFStreamInstanceModel converts an instance returned by Atlas. The stream configuration is only set if it was
+// configured, as Atlas returns the default tier of instances created without one.
+func newTFStreamInstanceModel(ctx context.Context, projectID string, instance *streamInstance, withStreamConfig bool) (*tfStreamInstanceModel, diag.Diagnostics) {
+	hostnames, diags := types.ListValueFrom(ctx, types.StringType, instance.Hostnames)
+	model := &tfStreamInstanceModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"project_id":    projectID,
+			"instance_name": instance.Name,
+		})),
+		ProjectID:    types.StringValue(projectID),
+		InstanceName: types.StringValue(instance.Name),
+		Hostnames:    hostnames,
+	}
+	if instance.DataProcessRegion != nil {
+		model.DataProcessRegion = []tfStreamDataProcessRegionModel{{
+			CloudProvider: types.StringValue(instance.DataProcessRegion.CloudProvider),
+			Region:        types.StringValue(instance.DataProcessRegion.Region),
+		}}
+	}
+	if withStreamConfig && instance.StreamConfig != nil {
+		model.StreamConfig = []tfStreamConfigModel{{Tier: types.StringValue(instance.StreamConfig.Tier)}}
+	}
+	return model, diags
+}
@@ -0,0 +1,118 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStreamRSStreamInstance_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_stream_instance.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc-stream")
+		instanceName = acctest.RandomWithPrefix("test-acc-instance")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasStreamInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasStreamInstanceConfig(orgID, projectName, instanceName, "VIRGINIA_USA", "SP30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", instanceName),
+					resource.TestCheckResourceAttr(resourceName, "data_process_region.0.cloud_provider", "AWS"),
+					resource.TestCheckResourceAttr(resourceName, "data_process_region.0.region", "VIRGINIA_USA"),
+					resource.TestCheckResourceAttr(resourceName, "stream_config.0.tier", "SP30"),
+					resource.TestCheckResourceAttrSet(resourceName, "hostnames.#"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasStreamInstanceConfig(orgID, projectName, instanceName, "OREGON_USA", "SP30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_process_region.0.region", "OREGON_USA"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasStreamInstanceImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stream_config"},
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasStreamInstanceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+		if _, err := getStreamInstance(context.Background(), conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["instance_name"]); err != nil {
+			return fmt.Errorf("stream instance (%s) does not exist: %s", rs.Primary.Attributes["instance_name"], err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasStreamInstanceDestroy(s *terraform.State) error {
+	conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_stream_instance" {
+			continue
+		}
+		if _, err := getStreamInstance(context.Background(), conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["instance_name"]); err == nil {
+			return fmt.Errorf("stream instance (%s) still exists", rs.Primary.Attributes["instance_name"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasStreamInstanceImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["instance_name"]), nil
+	}
+}
+
+func testAccMongoDBAtlasStreamInstanceConfig(orgID, projectName, instanceName, region, tier string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_project" "test" {
+		name   = %[2]q
+		org_id = %[1]q
+	}
+
+	resource "mongodbatlas_stream_instance" "test" {
+		project_id    = mongodbatlas_project.test.id
+		instance_name = %[3]q
+
+		data_process_region {
+			cloud_provider = "AWS"
+			region         = %[4]q
+		}
+
+		stream_config {
+			tier = %[5]q
+		}
+	}
+	`, orgID, projectName, instanceName, region, tier)
+}
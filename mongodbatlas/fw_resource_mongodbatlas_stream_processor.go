@@ -0,0 +1,344 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/jsontypes"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
+	cstmvalidator "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/validator"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	streamProcessorName              = "stream_processor"
+	errorStreamProcessorCreate       = "error creating Stream Processor: %s"
+	errorStreamProcessorRead         = "error getting Stream Processor (%s): %s"
+	errorStreamProcessorUpdate       = "error updating Stream Processor (%s): %s"
+	errorStreamProcessorDelete       = "error deleting Stream Processor (%s): %s"
+	streamProcessorStateTimeout      = 10 * time.Minute
+	streamProcessorStatePollInterval = 5 * time.Second
+)
+
+var _ resource.ResourceWithConfigure = &StreamProcessorRS{}
+var _ resource.ResourceWithImportState = &StreamProcessorRS{}
+var _ resource.ResourceWithModifyPlan = &StreamProcessorRS{}
+
+func NewStreamProcessorRS() resource.Resource {
+	return &StreamProcessorRS{
+		RSCommon: RSCommon{
+			resourceName: streamProcessorName,
+		},
+	}
+}
+
+type StreamProcessorRS struct {
+	RSCommon
+}
+
+type tfStreamProcessorModel struct {
+	ID            types.String                    `tfsdk:"id"`
+	ProjectID     types.String                    `tfsdk:"project_id"`
+	InstanceName  types.String                    `tfsdk:"instance_name"`
+	ProcessorName types.String                    `tfsdk:"processor_name"`
+	Pipeline      jsontypes.Normalized            `tfsdk:"pipeline"`
+	State         types.String                    `tfsdk:"state"`
+	Options       []tfStreamProcessorOptionsModel `tfsdk:"options"`
+}
+
+type tfStreamProcessorOptionsModel struct {
+	DLQ []tfStreamProcessorDLQModel `tfsdk:"dlq"`
+}
+
+type tfStreamProcessorDLQModel struct {
+	Coll           types.String `tfsdk:"coll"`
+	ConnectionName types.String `tfsdk:"connection_name"`
+	DB             types.String `tfsdk:"db"`
+}
+
+func (r *StreamProcessorRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"processor_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pipeline": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					cstmvalidator.StringIsJSON(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(streamProcessorStateCreated, streamProcessorStateStarted, streamProcessorStateStopped),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"options": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"dlq": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"coll": schema.StringAttribute{
+										Required: true,
+									},
+									"connection_name": schema.StringAttribute{
+										Required: true,
+									},
+									"db": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *StreamProcessorRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamProcessorPlan tfStreamProcessorModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamProcessorPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetState := streamProcessorPlan.State.ValueString()
+	if targetState == streamProcessorStateStopped {
+		resp.Diagnostics.AddError("validation error", "a stream processor can only be created in the CREATED or STARTED state")
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := streamProcessorPlan.ProjectID.ValueString()
+	instanceName := streamProcessorPlan.InstanceName.ValueString()
+	processorName := streamProcessorPlan.ProcessorName.ValueString()
+	processor, err := createStreamProcessor(ctx, conn, projectID, instanceName, newStreamProcessor(&streamProcessorPlan))
+	if err != nil {
+		resp.Diagnostics.AddError("error during stream processor creation", fmt.Sprintf(errorStreamProcessorCreate, err))
+		return
+	}
+
+	if targetState == streamProcessorStateStarted {
+		if processor, err = changeStreamProcessorState(ctx, conn, projectID, instanceName, processorName, targetState); err != nil {
+			resp.Diagnostics.AddError("error during stream processor creation", fmt.Sprintf(errorStreamProcessorCreate, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFStreamProcessorModel(projectID, instanceName, processor))...)
+}
+
+func (r *StreamProcessorRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var streamProcessorState tfStreamProcessorModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &streamProcessorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := streamProcessorState.ProjectID.ValueString()
+	instanceName := streamProcessorState.InstanceName.ValueString()
+	processorName := streamProcessorState.ProcessorName.ValueString()
+	processor, err := getStreamProcessor(ctx, conn, projectID, instanceName, processorName)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorStreamProcessorRead, processorName, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFStreamProcessorModel(projectID, instanceName, processor))...)
+}
+
+// Update starts or stops the processor, any other change replaces it.
+func (r *StreamProcessorRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamProcessorPlan, streamProcessorState tfStreamProcessorModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamProcessorPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &streamProcessorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := streamProcessorPlan.ProjectID.ValueString()
+	instanceName := streamProcessorPlan.InstanceName.ValueString()
+	processorName := streamProcessorPlan.ProcessorName.ValueString()
+	targetState := streamProcessorPlan.State.ValueString()
+	if targetState == streamProcessorStateCreated && streamProcessorState.State.ValueString() != streamProcessorStateCreated {
+		resp.Diagnostics.AddError("validation error", "a stream processor that was started can't return to the CREATED state, use STOPPED instead")
+		return
+	}
+
+	processor, err := getStreamProcessor(ctx, conn, projectID, instanceName, processorName)
+	if err == nil && targetState != "" && processor.State != targetState {
+		processor, err = changeStreamProcessorState(ctx, conn, projectID, instanceName, processorName, targetState)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("error updating resource", fmt.Sprintf(errorStreamProcessorUpdate, processorName, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFStreamProcessorModel(projectID, instanceName, processor))...)
+}
+
+func (r *StreamProcessorRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var streamProcessorState tfStreamProcessorModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &streamProcessorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	processorName := streamProcessorState.ProcessorName.ValueString()
+	err := deleteStreamProcessor(ctx, conn, streamProcessorState.ProjectID.ValueString(), streamProcessorState.InstanceName.ValueString(), processorName)
+	if err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.AddError("error during resource delete", fmt.Sprintf(errorStreamProcessorDelete, processorName, err))
+	}
+}
+
+func (r *StreamProcessorRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
+func (r *StreamProcessorRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, instanceName, processorName, ok := splitStreamImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("import format error", "to import a stream processor, use the format {project_id}-{instance_name}--{processor_name}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_name"), instanceName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("processor_name"), processorName)...)
+}
+
+// changeStreamProcessorState starts or stops the processor and waits for it to reach targetState.
+func changeStreamProcessorState(ctx context.Context, conn *matlas.Client, projectID, instanceName, processorName, targetState string) (*streamProcessor, error) {
+	if err := startStreamProcessor(ctx, conn, projectID, instanceName, processorName, targetState == streamProcessorStateStarted); err != nil {
+		return nil, err
+	}
+
+	waiter := &retrystrategy.Waiter[*streamProcessor]{
+		Operation: fmt.Sprintf("stream processor %q to reach state %s", processorName, targetState),
+		Target:    []string{targetState},
+		Refresh: func() (*streamProcessor, string, error) {
+			processor, err := getStreamProcessor(ctx, conn, projectID, instanceName, processorName)
+			if err != nil {
+				return nil, "", err
+			}
+			if processor.State == streamProcessorStateFailed {
+				return nil, "", fmt.Errorf("stream processor %q failed", processorName)
+			}
+			return processor, processor.State, nil
+		},
+		Timeout:      streamProcessorStateTimeout,
+		PollInterval: streamProcessorStatePollInterval,
+	}
+	return waiter.Wait(ctx)
+}
+
+func newStreamProcessor(model *tfStreamProcessorModel) *streamProcessor {
+	processor := &streamProcessor{
+		Name:     model.ProcessorName.ValueString(),
+		Pipeline: json.RawMessage(model.Pipeline.ValueString()),
+	}
+	if len(model.Options) > 0 && len(model.Options[0].DLQ) > 0 {
+		dlq := model.Options[0].DLQ[0]
+		processor.Options = &streamProcessorOptions{
+			DLQ: &streamProcessorDLQ{
+				Coll:           dlq.Coll.ValueString(),
+				ConnectionName: dlq.ConnectionName.ValueString(),
+				DB:             dlq.DB.ValueString(),
+			},
+		}
+	}
+	return processor
+}
+
+func newTFStreamProcessorModel(projectID, instanceName string, processor *streamProcessor) *tfStreamProcessorModel {
+	model := &tfStreamProcessorModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"project_id":     projectID,
+			"instance_name":  instanceName,
+			"processor_name": processor.Name,
+		})),
+		ProjectID:     types.StringValue(projectID),
+		InstanceName:  types.StringValue(instanceName),
+		ProcessorName: types.StringValue(processor.Name),
+		Pipeline:      jsontypes.NewNormalizedValue(string(processor.Pipeline)),
+		State:         types.StringValue(processor.State),
+	}
+	if processor.Options != nil && processor.Options.DLQ != nil {
+		model.Options = []tfStreamProcessorOptionsModel{{
+			DLQ: []tfStreamProcessorDLQModel{{
+				Coll:           types.StringValue(processor.Options.DLQ.Coll),
+				ConnectionName: types.StringValue(processor.Options.DLQ.ConnectionName),
+				DB:             types.StringValue(processor.Options.DLQ.DB),
+			}},
+		}}
+	}
+	return model
+}
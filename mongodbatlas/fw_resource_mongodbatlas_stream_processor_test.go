@@ -0,0 +1,138 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStreamRSStreamProcessor_basic(t *testing.T) {
+	var (
+		resourceName         = "mongodbatlas_stream_processor.test"
+		dataSourceName       = "data.mongodbatlas_stream_processor.test"
+		dataSourcePluralName = "data.mongodbatlas_stream_processors.test"
+		orgID                = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName          = acctest.RandomWithPrefix("test-acc-stream")
+		instanceName         = acctest.RandomWithPrefix("test-acc-instance")
+		processorName        = acctest.RandomWithPrefix("test-acc-processor")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasStreamProcessorConfig(orgID, projectName, instanceName, processorName, streamProcessorStateCreated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamProcessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "processor_name", processorName),
+					resource.TestCheckResourceAttr(resourceName, "state", streamProcessorStateCreated),
+					resource.TestCheckResourceAttr(dataSourceName, "state", streamProcessorStateCreated),
+					resource.TestCheckResourceAttrSet(dataSourceName, "pipeline"),
+					resource.TestCheckResourceAttr(dataSourcePluralName, "results.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasStreamProcessorConfig(orgID, projectName, instanceName, processorName, streamProcessorStateStarted),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamProcessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", streamProcessorStateStarted),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stats"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasStreamProcessorConfig(orgID, projectName, instanceName, processorName, streamProcessorStateStopped),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasStreamProcessorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", streamProcessorStateStopped),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasStreamProcessorImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasStreamProcessorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+		_, err := getStreamProcessor(context.Background(), conn, rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["instance_name"], rs.Primary.Attributes["processor_name"])
+		if err != nil {
+			return fmt.Errorf("stream processor (%s) does not exist: %s", rs.Primary.Attributes["processor_name"], err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasStreamProcessorDestroy(s *terraform.State) error {
+	conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_stream_processor" {
+			continue
+		}
+		_, err := getStreamProcessor(context.Background(), conn, rs.Primary.Attributes["project_id"],
+			rs.Primary.Attributes["instance_name"], rs.Primary.Attributes["processor_name"])
+		if err == nil {
+			return fmt.Errorf("stream processor (%s) still exists", rs.Primary.Attributes["processor_name"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasStreamProcessorImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s--%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["instance_name"],
+			rs.Primary.Attributes["processor_name"]), nil
+	}
+}
+
+func testAccMongoDBAtlasStreamProcessorConfig(orgID, projectName, instanceName, processorName, state string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "mongodbatlas_stream_processor" "test" {
+		project_id     = mongodbatlas_stream_connection.test.project_id
+		instance_name  = mongodbatlas_stream_connection.test.instance_name
+		processor_name = %[2]q
+		state          = %[3]q
+		pipeline = jsonencode([
+			{ "$source" = { "connectionName" = mongodbatlas_stream_connection.test.connection_name } },
+			{ "$emit" = { "connectionName" = "__testLog" } }
+		])
+	}
+
+	data "mongodbatlas_stream_processor" "test" {
+		project_id     = mongodbatlas_stream_processor.test.project_id
+		instance_name  = mongodbatlas_stream_processor.test.instance_name
+		processor_name = mongodbatlas_stream_processor.test.processor_name
+	}
+
+	data "mongodbatlas_stream_processors" "test" {
+		project_id    = mongodbatlas_stream_processor.test.project_id
+		instance_name = mongodbatlas_stream_processor.test.instance_name
+	}
+	`, testAccMongoDBAtlasStreamConnectionSampleConfig(orgID, projectName, instanceName), processorName, state)
+}
@@ -0,0 +1,241 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	teamProjectAssignmentName        = "team_project_assignment"
+	errorTeamProjectAssignmentCreate = "error assigning team (%s) to project (%s): %s"
+	errorTeamProjectAssignmentRead   = "error getting teams of project (%s): %s"
+	errorTeamProjectAssignmentUpdate = "error updating roles of team (%s) in project (%s): %s"
+	errorTeamProjectAssignmentDelete = "error removing team (%s) from project (%s): %s"
+)
+
+var _ resource.ResourceWithConfigure = &TeamProjectAssignmentRS{}
+var _ resource.ResourceWithImportState = &TeamProjectAssignmentRS{}
+var _ resource.ResourceWithModifyPlan = &TeamProjectAssignmentRS{}
+
+func NewTeamProjectAssignmentRS() resource.Resource {
+	return &TeamProjectAssignmentRS{
+		RSCommon: RSCommon{
+			resourceName: teamProjectAssignmentName,
+		},
+	}
+}
+
+// TeamProjectAssignmentRS manages the roles of one team in a project, independently of the mongodbatlas_project
+// resource. Projects that don't declare teams don't track the teams assigned to them.
+type TeamProjectAssignmentRS struct {
+	RSCommon
+}
+
+type tfTeamProjectAssignmentModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	RoleNames types.Set    `tfsdk:"role_names"`
+}
+
+func (r *TeamProjectAssignmentRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_names": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamProjectAssignmentRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var assignmentPlan tfTeamProjectAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &assignmentPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := assignmentPlan.ProjectID.ValueString()
+	teamID := assignmentPlan.TeamID.ValueString()
+	var roleNames []string
+	resp.Diagnostics.Append(assignmentPlan.RoleNames.ElementsAs(ctx, &roleNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, _, err := conn.Projects.AddTeamsToProject(ctx, projectID, []*matlas.ProjectTeam{{TeamID: teamID, RoleNames: roleNames}})
+	if err != nil {
+		resp.Diagnostics.AddError("error during team project assignment creation", fmt.Sprintf(errorTeamProjectAssignmentCreate, teamID, projectID, err))
+		return
+	}
+
+	team := findAssignedTeam(teams, teamID)
+	if team == nil {
+		resp.Diagnostics.AddError("error during team project assignment creation",
+			fmt.Sprintf(errorTeamProjectAssignmentCreate, teamID, projectID, "the team is not assigned to the project"))
+		return
+	}
+	newAssignmentModel, diags := newTFTeamProjectAssignmentModel(ctx, projectID, team)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newAssignmentModel)...)
+}
+
+func (r *TeamProjectAssignmentRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var assignmentState tfTeamProjectAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &assignmentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := assignmentState.ProjectID.ValueString()
+	teams, _, err := conn.Projects.GetProjectTeamsAssigned(ctx, projectID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error fetching resource", fmt.Sprintf(errorTeamProjectAssignmentRead, projectID, err))
+		return
+	}
+
+	team := findAssignedTeam(teams, assignmentState.TeamID.ValueString())
+	if team == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newAssignmentModel, diags := newTFTeamProjectAssignmentModel(ctx, projectID, team)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newAssignmentModel)...)
+}
+
+func (r *TeamProjectAssignmentRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var assignmentPlan tfTeamProjectAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &assignmentPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := assignmentPlan.ProjectID.ValueString()
+	teamID := assignmentPlan.TeamID.ValueString()
+	var roleNames []string
+	resp.Diagnostics.Append(assignmentPlan.RoleNames.ElementsAs(ctx, &roleNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, _, err := conn.Teams.UpdateTeamRoles(ctx, projectID, teamID, &matlas.TeamUpdateRoles{RoleNames: roleNames}); err != nil {
+		resp.Diagnostics.AddError("error updating resource", fmt.Sprintf(errorTeamProjectAssignmentUpdate, teamID, projectID, err))
+		return
+	}
+
+	newAssignmentModel, diags := newTFTeamProjectAssignmentModel(ctx, projectID, &matlas.Result{TeamID: teamID, RoleNames: roleNames})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newAssignmentModel)...)
+}
+
+func (r *TeamProjectAssignmentRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = r.contextWithResourceName(ctx)
+	var assignmentState tfTeamProjectAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &assignmentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.client.Atlas
+	projectID := assignmentState.ProjectID.ValueString()
+	teamID := assignmentState.TeamID.ValueString()
+	if _, err := conn.Teams.RemoveTeamFromProject(ctx, projectID, teamID); err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.AddError("error during resource delete", fmt.Sprintf(errorTeamProjectAssignmentDelete, teamID, projectID, err))
+	}
+}
+
+func (r *TeamProjectAssignmentRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultProjectID(ctx, r.client, req, resp)
+}
+
+func (r *TeamProjectAssignmentRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("import format error", "to import a team project assignment, use the format {project_id}-{team_id}")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[1])...)
+}
+
+func findAssignedTeam(teams *matlas.TeamsAssigned, teamID string) *matlas.Result {
+	if teams == nil {
+		return nil
+	}
+	for _, team := range teams.Results {
+		if team != nil && team.TeamID == teamID {
+			return team
+		}
+	}
+	return nil
+}
+
+func newTFTeamProjectAssignmentModel(ctx context.Context, projectID string, team *matlas.Result) (*tfTeamProjectAssignmentModel, diag.Diagnostics) {
+	roleNames, diags := types.SetValueFrom(ctx, types.StringType, team.RoleNames)
+	return &tfTeamProjectAssignmentModel{
+		ID: types.StringValue(encodeStateID(map[string]string{
+			"project_id": projectID,
+			"team_id":    team.TeamID,
+		})),
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(team.TeamID),
+		RoleNames: roleNames,
+	}, diags
+}
@@ -0,0 +1,150 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAccProjectRSTeamProjectAssignment_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_team_project_assignment.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc-team-assignment")
+		teamID       = strings.Split(os.Getenv("MONGODB_ATLAS_TEAMS_IDS"), ",")[0]
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t); testCheckTeamsIds(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasTeamProjectAssignmentConfig(orgID, projectName, teamID, `"GROUP_READ_ONLY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamProjectAssignmentRoles(testMongoDBClient.(*MongoDBClient).Atlas, resourceName, "GROUP_READ_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasTeamProjectAssignmentConfig(orgID, projectName, teamID, `"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamProjectAssignmentRoles(testMongoDBClient.(*MongoDBClient).Atlas, resourceName, "GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "role_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasTeamProjectAssignmentImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestProjectRSTeamProjectAssignment_offline checks that a project without teams blocks doesn't try to remove the teams
+// assigned with mongodbatlas_team_project_assignment, every step fails if the plan isn't empty after the apply.
+func TestProjectRSTeamProjectAssignment_offline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	const teamID = "6b0a1f1d2e3c4b5a69788796"
+	resourceName := "mongodbatlas_team_project_assignment.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasTeamProjectAssignmentConfig(offlineOrgID, "offline-project", teamID, `"GROUP_READ_ONLY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamProjectAssignmentRoles(testOfflineClient(server).Atlas, resourceName, "GROUP_READ_ONLY"),
+					resource.TestCheckResourceAttr("mongodbatlas_project.test", "teams.#", "0"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasTeamProjectAssignmentConfig(offlineOrgID, "offline-project", teamID, `"GROUP_OWNER"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamProjectAssignmentRoles(testOfflineClient(server).Atlas, resourceName, "GROUP_OWNER"),
+				),
+			},
+			{
+				// the imported project has the assigned team in its state, the next apply must not remove it
+				ResourceName:       "mongodbatlas_project.test",
+				ImportStateIdFunc:  testAccCheckMongoDBAtlasProjectImportStateIDFunc("mongodbatlas_project.test"),
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasTeamProjectAssignmentConfig(offlineOrgID, "offline-project", teamID, `"GROUP_OWNER"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasTeamProjectAssignmentRoles(testOfflineClient(server).Atlas, resourceName, "GROUP_OWNER"),
+					resource.TestCheckResourceAttr("mongodbatlas_project.test", "teams.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasTeamProjectAssignmentImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasTeamProjectAssignmentRoles(conn *matlas.Client, resourceName string, roleNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		teams, _, err := conn.Projects.GetProjectTeamsAssigned(context.Background(), rs.Primary.Attributes["project_id"])
+		if err != nil {
+			return fmt.Errorf("teams of project (%s) can't be read: %s", rs.Primary.Attributes["project_id"], err)
+		}
+		team := findAssignedTeam(teams, rs.Primary.Attributes["team_id"])
+		if team == nil {
+			return fmt.Errorf("team (%s) is not assigned to project (%s)", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["project_id"])
+		}
+		if len(team.RoleNames) != len(roleNames) {
+			return fmt.Errorf("expected roles %v for team (%s), got %v", roleNames, team.TeamID, team.RoleNames)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasTeamProjectAssignmentImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["team_id"]), nil
+	}
+}
+
+func testAccMongoDBAtlasTeamProjectAssignmentConfig(orgID, projectName, teamID, roleNames string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_project" "test" {
+		name   = %[2]q
+		org_id = %[1]q
+	}
+
+	resource "mongodbatlas_team_project_assignment" "test" {
+		project_id = mongodbatlas_project.test.id
+		team_id    = %[3]q
+		role_names = [%[4]s]
+	}
+	`, orgID, projectName, teamID, roleNames)
+}
@@ -0,0 +1,803 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/spf13/cast"
+	"github.com/zclconf/go-cty/cty"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	generateConfigPageSize = 500
+
+	projectResourceType               = "mongodbatlas_project"
+	databaseUserResourceType          = "mongodbatlas_database_user"
+	customDBRoleResourceType          = "mongodbatlas_custom_db_role"
+	projectIPAccessListResourceType   = "mongodbatlas_project_ip_access_list"
+	alertConfigurationResourceType    = "mongodbatlas_alert_configuration"
+	cloudBackupScheduleResourceType   = "mongodbatlas_cloud_backup_schedule"
+	searchIndexResourceType           = "mongodbatlas_search_index"
+	thirdPartyIntegrationResourceType = "mongodbatlas_third_party_integration"
+)
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// GenerateConfigOptions selects what GenerateConfig writes the configuration of. Exactly one of OrgID and ProjectID
+// must be set.
+type GenerateConfigOptions struct {
+	OrgID     string
+	ProjectID string
+	// SearchNamespaces are the `database.collection` namespaces whose search indexes are included, Atlas can only list
+	// search indexes by namespace.
+	SearchNamespaces []string
+}
+
+// ApplyAtlasCLIProfile selects the organization of an Atlas CLI profile when neither an organization nor a project
+// is set.
+func (o *GenerateConfigOptions) ApplyAtlasCLIProfile(profile *AtlasCLIProfile) {
+	if o.OrgID == "" && o.ProjectID == "" {
+		o.OrgID = profile.OrgID
+	}
+}
+
+// GenerateConfig reads the projects selected by opts, either one project or all the projects of an organization, and
+// returns the configuration of the project and of its clusters, database users, custom roles, IP access list entries,
+// alert configurations, backup schedules, search indexes and third-party integrations, along with the import blocks
+// adopting them. The result maps file names to their content: one file with the resources and another with the import
+// blocks for every project, plus a file with the variables for the secrets Atlas doesn't return, if any.
+func GenerateConfig(ctx context.Context, client *MongoDBClient, opts *GenerateConfigOptions) (map[string][]byte, error) {
+	if (opts.OrgID == "") == (opts.ProjectID == "") {
+		return nil, errors.New("either an organization or a project must be set to generate its configuration")
+	}
+	for _, namespace := range opts.SearchNamespaces {
+		if database, collection, ok := strings.Cut(namespace, "."); !ok || database == "" || collection == "" {
+			return nil, fmt.Errorf("invalid search index namespace %q, use the format database.collection", namespace)
+		}
+	}
+
+	var projects []admin.Group
+	if opts.ProjectID != "" {
+		project, _, err := client.AtlasV2.ProjectsApi.GetProject(ctx, opts.ProjectID).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error getting project (%s): %s", opts.ProjectID, err)
+		}
+		projects = append(projects, *project)
+	} else {
+		var err error
+		projects, err = listAllPages(func(pageNum int) ([]admin.Group, *int, error) {
+			page, _, err := client.AtlasV2.OrganizationsApi.ListOrganizationProjects(ctx, opts.OrgID).
+				IncludeCount(true).ItemsPerPage(generateConfigPageSize).PageNum(pageNum).Execute()
+			if err != nil {
+				return nil, nil, err
+			}
+			return page.GetResults(), page.TotalCount, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting projects of organization (%s): %s", opts.OrgID, err)
+		}
+	}
+
+	g := &configGenerator{
+		client:           client,
+		searchNamespaces: opts.SearchNamespaces,
+		labels:           map[string]map[string]bool{},
+		files:            map[string][]byte{},
+		variables:        hclwrite.NewEmptyFile(),
+	}
+	for i := range projects {
+		if err := g.generateProject(ctx, &projects[i]); err != nil {
+			return nil, err
+		}
+	}
+	if len(g.variables.Body().Blocks()) > 0 {
+		g.files["variables.tf"] = g.variables.Bytes()
+	}
+	return g.files, nil
+}
+
+// listAllPages returns the results of all the pages of a paginated list, list must return the results of the page and
+// the total count.
+func listAllPages[T any](list func(pageNum int) ([]T, *int, error)) ([]T, error) {
+	var all []T
+	for pageNum := 1; ; pageNum++ {
+		results, totalCount, err := list(pageNum)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, results...)
+		if len(results) == 0 || totalCount == nil || len(all) >= *totalCount {
+			return all, nil
+		}
+	}
+}
+
+type configGenerator struct {
+	client           *MongoDBClient
+	labels           map[string]map[string]bool
+	files            map[string][]byte
+	variables        *hclwrite.File
+	searchNamespaces []string
+}
+
+// projectConfig is the configuration being generated for a project.
+type projectConfig struct {
+	resources *hclwrite.File
+	imports   *hclwrite.File
+	projectID string
+	// reference is the traversal to the ID of the project resource
+	reference hcl.Traversal
+}
+
+func (g *configGenerator) generateProject(ctx context.Context, project *admin.Group) error {
+	label := g.label(projectResourceType, project.Name)
+	p := &projectConfig{
+		resources: hclwrite.NewEmptyFile(),
+		imports:   hclwrite.NewEmptyFile(),
+		projectID: project.GetId(),
+		reference: resourceTraversal(projectResourceType, label, "id"),
+	}
+
+	values := map[string]any{
+		"name":   project.Name,
+		"org_id": project.OrgId,
+	}
+	if restrictions := project.GetRegionUsageRestrictions(); restrictions != "" && restrictions != "NONE" {
+		values["region_usage_restrictions"] = restrictions
+	}
+	p.appendResource(projectResourceType, label, newFrameworkResourceConfigSchema(NewProjectRS()), values, p.projectID)
+
+	steps := []func(context.Context, *projectConfig) error{
+		g.generateClusters,
+		g.generateDatabaseUsers,
+		g.generateCustomDBRoles,
+		g.generateAccessListEntries,
+		g.generateAlertConfigurations,
+		g.generateThirdPartyIntegrations,
+	}
+	for _, step := range steps {
+		if err := step(ctx, p); err != nil {
+			return fmt.Errorf("error generating configuration of project (%s): %s", p.projectID, err)
+		}
+	}
+
+	g.files[label+".tf"] = p.resources.Bytes()
+	g.files[label+"_imports.tf"] = p.imports.Bytes()
+	return nil
+}
+
+func (g *configGenerator) generateClusters(ctx context.Context, p *projectConfig) error {
+	clusters, err := listAllPages(func(pageNum int) ([]admin.AdvancedClusterDescription, *int, error) {
+		page, _, err := g.client.AtlasV2.ClustersApi.ListClusters(ctx, p.projectID).
+			IncludeCount(true).ItemsPerPage(generateConfigPageSize).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.GetResults(), page.TotalCount, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error getting clusters: %s", err)
+	}
+
+	for i := range clusters {
+		cluster := &clusters[i]
+		label := g.label(advancedClusterResourceType, cluster.GetName())
+		values := newAdvancedClusterConfig(cluster)
+		values["project_id"] = p.reference
+		p.appendResource(advancedClusterResourceType, label, newSDKConfigSchema(resourceMongoDBAtlasAdvancedCluster().Schema), values,
+			fmt.Sprintf("%s-%s", p.projectID, cluster.GetName()))
+
+		clusterName := resourceTraversal(advancedClusterResourceType, label, "name")
+		if cluster.GetBackupEnabled() {
+			if err := g.generateBackupSchedule(ctx, p, cluster.GetName(), clusterName); err != nil {
+				return err
+			}
+		}
+		if err := g.generateSearchIndexes(ctx, p, cluster.GetName(), clusterName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newAdvancedClusterConfig returns the arguments of the advanced cluster that describe cluster, leaving out the
+// computed ones Atlas sets on its own.
+func newAdvancedClusterConfig(cluster *admin.AdvancedClusterDescription) map[string]any {
+	values := map[string]any{
+		"name":                           cluster.GetName(),
+		"cluster_type":                   cluster.GetClusterType(),
+		"backup_enabled":                 cluster.GetBackupEnabled(),
+		"pit_enabled":                    cluster.GetPitEnabled(),
+		"termination_protection_enabled": cluster.GetTerminationProtectionEnabled(),
+		"version_release_system":         cluster.GetVersionReleaseSystem(),
+	}
+	if cluster.GetVersionReleaseSystem() != "CONTINUOUS" && cluster.GetMongoDBMajorVersion() != "" {
+		values["mongo_db_major_version"] = cluster.GetMongoDBMajorVersion()
+	}
+	if cluster.GetPaused() {
+		values["paused"] = true
+	}
+	if provider := cluster.GetEncryptionAtRestProvider(); provider != "" && provider != "NONE" {
+		values["encryption_at_rest_provider"] = provider
+	}
+	if biConnector := cluster.BiConnector; biConnector.GetEnabled() {
+		values["bi_connector_config"] = []any{map[string]any{
+			"enabled":         true,
+			"read_preference": biConnector.GetReadPreference(),
+		}}
+	}
+
+	diskAutoScaling := false
+	replicationSpecs := make([]any, len(cluster.ReplicationSpecs))
+	for i := range cluster.ReplicationSpecs {
+		spec := &cluster.ReplicationSpecs[i]
+		regionConfigs := make([]any, len(spec.RegionConfigs))
+		for j := range spec.RegionConfigs {
+			regionConfigs[j] = newAdvancedClusterRegionConfigFromAPI(&spec.RegionConfigs[j])
+			if autoScaling := spec.RegionConfigs[j].AutoScaling; autoScaling != nil {
+				diskAutoScaling = diskAutoScaling || autoScaling.DiskGB.GetEnabled()
+			}
+		}
+		replicationSpecs[i] = map[string]any{
+			"num_shards":     spec.GetNumShards(),
+			"zone_name":      spec.GetZoneName(),
+			"region_configs": regionConfigs,
+		}
+	}
+	values["replication_specs"] = replicationSpecs
+	// the disk grows on its own when it auto-scales, setting it would revert it
+	if cluster.DiskSizeGB != nil && !diskAutoScaling {
+		values["disk_size_gb"] = cluster.GetDiskSizeGB()
+	}
+
+	// the label the provider adds to every cluster can't be configured
+	labels := make([]matlas.Label, len(cluster.Labels))
+	for i, label := range cluster.Labels {
+		labels[i] = matlas.Label{Key: label.GetKey(), Value: label.GetValue()}
+	}
+	if labels := newKeyValueConfig(removeLabel(labels, defaultLabel), func(l matlas.Label) (key, value string) {
+		return l.Key, l.Value
+	}); labels != nil {
+		values["labels"] = labels
+	}
+	if tags := newKeyValueConfig(cluster.Tags, func(t admin.ResourceTag) (key, value string) {
+		return t.GetKey(), t.GetValue()
+	}); tags != nil {
+		values["tags"] = tags
+	}
+	return values
+}
+
+func newAdvancedClusterRegionConfigFromAPI(regionConfig *admin.CloudRegionConfig) map[string]any {
+	values := map[string]any{
+		"provider_name": regionConfig.GetProviderName(),
+		"region_name":   regionConfig.GetRegionName(),
+		"priority":      regionConfig.GetPriority(),
+	}
+	if regionConfig.GetProviderName() == "TENANT" {
+		values["backing_provider_name"] = regionConfig.GetBackingProviderName()
+		values["electable_specs"] = []any{map[string]any{"instance_size": regionConfig.ElectableSpecs.GetInstanceSize()}}
+		return values
+	}
+
+	electable := regionConfig.GetElectableSpecs()
+	if electable.GetNodeCount() > 0 {
+		values["electable_specs"] = []any{newAdvancedClusterHardwareSpecConfig(regionConfig.GetProviderName(),
+			electable.GetInstanceSize(), electable.GetNodeCount(), electable.GetEbsVolumeType(), electable.GetDiskIOPS())}
+	}
+	if readOnly := regionConfig.GetReadOnlySpecs(); readOnly.GetNodeCount() > 0 {
+		values["read_only_specs"] = []any{newAdvancedClusterHardwareSpecConfig(regionConfig.GetProviderName(),
+			readOnly.GetInstanceSize(), readOnly.GetNodeCount(), readOnly.GetEbsVolumeType(), readOnly.GetDiskIOPS())}
+	}
+	if analytics := regionConfig.GetAnalyticsSpecs(); analytics.GetNodeCount() > 0 {
+		values["analytics_specs"] = []any{newAdvancedClusterHardwareSpecConfig(regionConfig.GetProviderName(),
+			analytics.GetInstanceSize(), analytics.GetNodeCount(), analytics.GetEbsVolumeType(), analytics.GetDiskIOPS())}
+		if regionConfig.AnalyticsAutoScaling != nil {
+			values["analytics_auto_scaling"] = []any{newAdvancedClusterAutoScalingConfig(regionConfig.AnalyticsAutoScaling)}
+		}
+	}
+	if regionConfig.AutoScaling != nil {
+		values["auto_scaling"] = []any{newAdvancedClusterAutoScalingConfig(regionConfig.AutoScaling)}
+	}
+	return values
+}
+
+func newAdvancedClusterHardwareSpecConfig(providerName, instanceSize string, nodeCount int, ebsVolumeType string, diskIOPS int) map[string]any {
+	values := map[string]any{
+		"instance_size": instanceSize,
+		"node_count":    nodeCount,
+	}
+	if providerName == "AWS" && ebsVolumeType != "" {
+		values["ebs_volume_type"] = ebsVolumeType
+		if ebsVolumeType == "PROVISIONED" {
+			values["disk_iops"] = diskIOPS
+		}
+	}
+	return values
+}
+
+func newAdvancedClusterAutoScalingConfig(autoScaling *admin.AdvancedAutoScalingSettings) map[string]any {
+	compute := autoScaling.Compute
+	values := map[string]any{
+		"disk_gb_enabled":            autoScaling.DiskGB.GetEnabled(),
+		"compute_enabled":            compute.GetEnabled(),
+		"compute_scale_down_enabled": compute.GetScaleDownEnabled(),
+	}
+	if compute.GetEnabled() {
+		values["compute_min_instance_size"] = compute.GetMinInstanceSize()
+		values["compute_max_instance_size"] = compute.GetMaxInstanceSize()
+	}
+	return values
+}
+
+func newKeyValueConfig[T any](elements []T, keyValue func(T) (key, value string)) []any {
+	if len(elements) == 0 {
+		return nil
+	}
+	values := make([]any, len(elements))
+	for i, element := range elements {
+		key, value := keyValue(element)
+		values[i] = map[string]any{"key": key, "value": value}
+	}
+	return values
+}
+
+func (g *configGenerator) generateBackupSchedule(ctx context.Context, p *projectConfig, clusterName string, clusterReference hcl.Traversal) error {
+	schedule, _, err := g.client.AtlasV2.CloudBackupsApi.GetBackupSchedule(ctx, p.projectID, clusterName).Execute()
+	if err != nil {
+		return fmt.Errorf("error getting backup schedule of cluster (%s): %s", clusterName, err)
+	}
+
+	values := map[string]any{
+		"project_id":               p.reference,
+		"cluster_name":             clusterReference,
+		"reference_hour_of_day":    schedule.GetReferenceHourOfDay(),
+		"reference_minute_of_hour": schedule.GetReferenceMinuteOfHour(),
+		"restore_window_days":      schedule.GetRestoreWindowDays(),
+		"auto_export_enabled":      schedule.GetAutoExportEnabled(),
+	}
+	for _, policy := range schedule.Policies {
+		for _, item := range policy.PolicyItems {
+			key := "policy_item_" + strings.ToLower(item.FrequencyType)
+			values[key] = append(cast.ToSlice(values[key]), map[string]any{
+				"frequency_interval": item.FrequencyInterval,
+				"retention_unit":     item.RetentionUnit,
+				"retention_value":    item.RetentionValue,
+			})
+		}
+	}
+	// there is a single schedule per cluster, so it's named after the cluster
+	label := g.label(cloudBackupScheduleResourceType, clusterName)
+	p.appendResource(cloudBackupScheduleResourceType, label, newSDKConfigSchema(resourceMongoDBAtlasCloudBackupSchedule().Schema), values,
+		fmt.Sprintf("%s-%s", p.projectID, clusterName))
+	return nil
+}
+
+func (g *configGenerator) generateSearchIndexes(ctx context.Context, p *projectConfig, clusterName string, clusterReference hcl.Traversal) error {
+	for _, namespace := range g.searchNamespaces {
+		database, collection, _ := strings.Cut(namespace, ".")
+		indexes, err := listVersionedSearchIndexes(ctx, g.client.Atlas, p.projectID, clusterName, database, collection)
+		if err != nil {
+			return fmt.Errorf("error getting search indexes of cluster (%s) in %s: %s", clusterName, namespace, err)
+		}
+
+		for i := range indexes {
+			index := &indexes[i]
+			values := map[string]any{
+				"project_id":      p.reference,
+				"cluster_name":    clusterReference,
+				"name":            index.Name,
+				"database":        index.Database,
+				"collection_name": index.CollectionName,
+			}
+			if index.Type == searchIndexTypeVectorSearch {
+				values["type"] = index.Type
+				fields, err := marshalSearchIndex(index.Fields)
+				if err != nil {
+					return err
+				}
+				values["fields"] = fields
+			} else {
+				values["analyzer"] = index.GetAnalyzer()
+				values["search_analyzer"] = index.GetSearchAnalyzer()
+				values["mappings_dynamic"] = index.Mappings.GetDynamic()
+				if len(index.Mappings.GetFields()) > 0 {
+					fields, err := marshalSearchIndex(index.Mappings.Fields)
+					if err != nil {
+						return err
+					}
+					values["mappings_fields"] = fields
+				}
+				if len(index.Analyzers) > 0 {
+					analyzers, err := marshalSearchIndex(index.Analyzers)
+					if err != nil {
+						return err
+					}
+					values["analyzers"] = analyzers
+				}
+				if synonyms := flattenSearchIndexSynonyms(index.Synonyms); len(synonyms) > 0 {
+					values["synonyms"] = synonyms
+				}
+			}
+
+			label := g.label(searchIndexResourceType, clusterName+"_"+index.Name)
+			p.appendResource(searchIndexResourceType, label, newSDKConfigSchema(resourceMongoDBAtlasSearchIndex().Schema), values,
+				fmt.Sprintf("%s--%s--%s", p.projectID, clusterName, index.GetIndexID()))
+		}
+	}
+	return nil
+}
+
+func (g *configGenerator) generateDatabaseUsers(ctx context.Context, p *projectConfig) error {
+	users, err := listAllPages(func(pageNum int) ([]admin.CloudDatabaseUser, *int, error) {
+		page, _, err := g.client.AtlasV2.DatabaseUsersApi.ListDatabaseUsers(ctx, p.projectID).
+			IncludeCount(true).ItemsPerPage(generateConfigPageSize).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.GetResults(), page.TotalCount, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error getting database users: %s", err)
+	}
+
+	for i := range users {
+		user := &users[i]
+		values := map[string]any{
+			"project_id":         p.reference,
+			"username":           user.Username,
+			"auth_database_name": user.DatabaseName,
+		}
+		for key, authType := range map[string]string{
+			"x509_type":      user.GetX509Type(),
+			"oidc_auth_type": user.GetOidcAuthType(),
+			"ldap_auth_type": user.GetLdapAuthType(),
+			"aws_iam_type":   user.GetAwsIAMType(),
+		} {
+			if authType != "" && authType != "NONE" {
+				values[key] = authType
+			}
+		}
+
+		roles := make([]any, len(user.Roles))
+		for j, role := range user.Roles {
+			roleValues := map[string]any{
+				"role_name":     role.RoleName,
+				"database_name": role.DatabaseName,
+			}
+			if role.GetCollectionName() != "" {
+				roleValues["collection_name"] = role.GetCollectionName()
+			}
+			roles[j] = roleValues
+		}
+		values["roles"] = roles
+		if labels := newKeyValueConfig(user.Labels, func(l admin.ComponentLabel) (key, value string) {
+			return l.GetKey(), l.GetValue()
+		}); labels != nil {
+			values["labels"] = labels
+		}
+		if len(user.Scopes) > 0 {
+			scopes := make([]any, len(user.Scopes))
+			for j, scope := range user.Scopes {
+				scopes[j] = map[string]any{"name": scope.Name, "type": scope.Type}
+			}
+			values["scopes"] = scopes
+		}
+
+		// the password of a SCRAM user isn't returned by Atlas, it is only needed to create the user again
+		label := g.label(databaseUserResourceType, user.Username)
+		p.appendResource(databaseUserResourceType, label, newFrameworkResourceConfigSchema(NewDatabaseUserRS()), values,
+			fmt.Sprintf("%s-%s-%s", p.projectID, user.Username, user.DatabaseName))
+	}
+	return nil
+}
+
+func (g *configGenerator) generateCustomDBRoles(ctx context.Context, p *projectConfig) error {
+	roles, _, err := g.client.AtlasV2.CustomDatabaseRolesApi.ListCustomDatabaseRoles(ctx, p.projectID).Execute()
+	if err != nil {
+		return fmt.Errorf("error getting custom db roles: %s", err)
+	}
+
+	for i := range roles {
+		role := &roles[i]
+		actions := make([]any, len(role.Actions))
+		for j, action := range role.Actions {
+			resources := make([]any, len(action.Resources))
+			for k, r := range action.Resources {
+				if r.Cluster {
+					resources[k] = map[string]any{"cluster": true}
+					continue
+				}
+				resourceValues := map[string]any{"database_name": r.Db}
+				setIfNotEmpty(resourceValues, "collection_name", r.Collection)
+				resources[k] = resourceValues
+			}
+			actions[j] = map[string]any{"action": action.Action, "resources": resources}
+		}
+		inheritedRoles := make([]any, len(role.InheritedRoles))
+		for j, inheritedRole := range role.InheritedRoles {
+			inheritedRoles[j] = map[string]any{"role_name": inheritedRole.Role, "database_name": inheritedRole.Db}
+		}
+
+		values := map[string]any{
+			"project_id":      p.reference,
+			"role_name":       role.RoleName,
+			"actions":         actions,
+			"inherited_roles": inheritedRoles,
+		}
+		label := g.label(customDBRoleResourceType, role.RoleName)
+		p.appendResource(customDBRoleResourceType, label, newSDKConfigSchema(resourceMongoDBAtlasCustomDBRole().Schema), values,
+			fmt.Sprintf("%s-%s", p.projectID, role.RoleName))
+	}
+	return nil
+}
+
+func (g *configGenerator) generateAccessListEntries(ctx context.Context, p *projectConfig) error {
+	entries, err := listAllPages(func(pageNum int) ([]admin.NetworkPermissionEntry, *int, error) {
+		page, _, err := g.client.AtlasV2.ProjectIPAccessListApi.ListProjectIpAccessLists(ctx, p.projectID).
+			IncludeCount(true).ItemsPerPage(generateConfigPageSize).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.GetResults(), page.TotalCount, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error getting IP access list: %s", err)
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		values := map[string]any{"project_id": p.reference}
+		var value string
+		switch {
+		case entry.GetAwsSecurityGroup() != "":
+			value = entry.GetAwsSecurityGroup()
+			values["aws_security_group"] = value
+		case entry.GetIpAddress() != "":
+			value = entry.GetIpAddress()
+			values["ip_address"] = value
+		default:
+			value = entry.GetCidrBlock()
+			values["cidr_block"] = value
+		}
+		if entry.GetComment() != "" {
+			values["comment"] = entry.GetComment()
+		}
+
+		label := g.label(projectIPAccessListResourceType, value)
+		p.appendResource(projectIPAccessListResourceType, label, newFrameworkResourceConfigSchema(NewProjectIPAccessListRS()), values,
+			fmt.Sprintf("%s-%s", p.projectID, value))
+	}
+	return nil
+}
+
+func (g *configGenerator) generateAlertConfigurations(ctx context.Context, p *projectConfig) error {
+	alerts, err := listAllPages(func(pageNum int) ([]admin.GroupAlertsConfig, *int, error) {
+		page, _, err := g.client.AtlasV2.AlertConfigurationsApi.ListAlertConfigurations(ctx, p.projectID).
+			IncludeCount(true).ItemsPerPage(generateConfigPageSize).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.GetResults(), page.TotalCount, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error getting alert configurations: %s", err)
+	}
+
+	for i := range alerts {
+		alert := &alerts[i]
+		label := g.label(alertConfigurationResourceType, alert.GetEventTypeName())
+		values := map[string]any{
+			"project_id": p.reference,
+			"event_type": alert.GetEventTypeName(),
+			"enabled":    alert.GetEnabled(),
+		}
+
+		if len(alert.Matchers) > 0 {
+			matchers := make([]any, len(alert.Matchers))
+			for j, matcher := range alert.Matchers {
+				matchers[j] = map[string]any{
+					"field_name": cast.ToString(matcher["fieldName"]),
+					"operator":   cast.ToString(matcher["operator"]),
+					"value":      cast.ToString(matcher["value"]),
+				}
+			}
+			values["matcher"] = matchers
+		}
+		if threshold := alert.MetricThreshold; threshold != nil {
+			values["metric_threshold_config"] = []any{map[string]any{
+				"metric_name": threshold.MetricName,
+				"operator":    threshold.GetOperator(),
+				"threshold":   threshold.GetThreshold(),
+				"units":       threshold.GetUnits(),
+				"mode":        threshold.GetMode(),
+			}}
+		}
+		if threshold := alert.Threshold; threshold != nil {
+			values["threshold_config"] = []any{map[string]any{
+				"operator":  threshold.GetOperator(),
+				"threshold": float64(threshold.GetThreshold()),
+				"units":     threshold.GetUnits(),
+			}}
+		}
+
+		notifications := make([]any, len(alert.Notifications))
+		for j := range alert.Notifications {
+			notifications[j] = g.newAlertNotificationConfig(&alert.Notifications[j], fmt.Sprintf("%s_notification_%d", label, j))
+		}
+		values["notification"] = notifications
+
+		p.appendResource(alertConfigurationResourceType, label, newFrameworkResourceConfigSchema(NewAlertConfigurationRS()), values,
+			fmt.Sprintf("%s-%s", p.projectID, alert.GetId()))
+	}
+	return nil
+}
+
+func (g *configGenerator) newAlertNotificationConfig(notification *admin.AlertsNotificationRootForGroup, variablePrefix string) map[string]any {
+	values := map[string]any{
+		"type_name":    notification.GetTypeName(),
+		"interval_min": notification.GetIntervalMin(),
+		"delay_min":    notification.GetDelayMin(),
+	}
+	setIfNotEmpty(values, "email_address", notification.GetEmailAddress())
+	setIfNotEmpty(values, "mobile_number", notification.GetMobileNumber())
+	setIfNotEmpty(values, "channel_name", notification.GetChannelName())
+	setIfNotEmpty(values, "team_id", notification.GetTeamId())
+	setIfNotEmpty(values, "team_name", notification.GetTeamName())
+	setIfNotEmpty(values, "username", notification.GetUsername())
+	setIfNotEmpty(values, "datadog_region", notification.GetDatadogRegion())
+	setIfNotEmpty(values, "ops_genie_region", notification.GetOpsGenieRegion())
+	if notification.EmailEnabled != nil {
+		values["email_enabled"] = notification.GetEmailEnabled()
+	}
+	if notification.SmsEnabled != nil {
+		values["sms_enabled"] = notification.GetSmsEnabled()
+	}
+	if len(notification.Roles) > 0 {
+		values["roles"] = notification.Roles
+	}
+	// Atlas returns the credentials of the notifications redacted
+	secrets := map[string]string{
+		"api_token":                   notification.GetApiToken(),
+		"datadog_api_key":             notification.GetDatadogApiKey(),
+		"ops_genie_api_key":           notification.GetOpsGenieApiKey(),
+		"service_key":                 notification.GetServiceKey(),
+		"victor_ops_api_key":          notification.GetVictorOpsApiKey(),
+		"victor_ops_routing_key":      notification.GetVictorOpsRoutingKey(),
+		"microsoft_teams_webhook_url": notification.GetMicrosoftTeamsWebhookUrl(),
+		"webhook_secret":              notification.GetWebhookSecret(),
+		"webhook_url":                 notification.GetWebhookUrl(),
+	}
+	g.setSecrets(values, variablePrefix, secrets)
+	return values
+}
+
+func (g *configGenerator) generateThirdPartyIntegrations(ctx context.Context, p *projectConfig) error {
+	integrations, err := listAllPages(func(pageNum int) ([]admin.ThridPartyIntegration, *int, error) {
+		page, _, err := g.client.AtlasV2.ThirdPartyIntegrationsApi.ListThirdPartyIntegrations(ctx, p.projectID).
+			IncludeCount(true).ItemsPerPage(generateConfigPageSize).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, nil, err
+		}
+		return page.GetResults(), page.TotalCount, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error getting third-party integrations: %s", err)
+	}
+
+	for i := range integrations {
+		integration := &integrations[i]
+		label := g.label(thirdPartyIntegrationResourceType, integration.GetType())
+		values := map[string]any{
+			"project_id": p.reference,
+			"type":       integration.GetType(),
+		}
+		setIfNotEmpty(values, "region", integration.GetRegion())
+		setIfNotEmpty(values, "team_name", integration.GetTeamName())
+		setIfNotEmpty(values, "channel_name", integration.GetChannelName())
+		setIfNotEmpty(values, "scheme", integration.GetScheme())
+		setIfNotEmpty(values, "user_name", integration.GetUsername())
+		setIfNotEmpty(values, "service_discovery", integration.GetServiceDiscovery())
+		if integration.Enabled != nil {
+			values["enabled"] = integration.GetEnabled()
+		}
+		// Atlas returns the credentials of the integrations redacted
+		secrets := map[string]string{
+			"api_key":                     integration.GetApiKey(),
+			"service_key":                 integration.GetServiceKey(),
+			"routing_key":                 integration.GetRoutingKey(),
+			"url":                         integration.GetUrl(),
+			"secret":                      integration.GetSecret(),
+			"microsoft_teams_webhook_url": integration.GetMicrosoftTeamsWebhookUrl(),
+			"password":                    integration.GetPassword(),
+		}
+		g.setSecrets(values, label, secrets)
+
+		p.appendResource(thirdPartyIntegrationResourceType, label, newSDKConfigSchema(resourceMongoDBAtlasThirdPartyIntegration().Schema), values,
+			fmt.Sprintf("%s-%s", p.projectID, integration.GetType()))
+	}
+	return nil
+}
+
+// appendResource writes the resource block and the import block adopting it.
+func (p *projectConfig) appendResource(resourceType, label string, s *configSchema, values map[string]any, importID string) {
+	if len(p.resources.Body().Blocks()) > 0 {
+		p.resources.Body().AppendNewline()
+	}
+	appendSchemaValues(p.resources.Body().AppendNewBlock("resource", []string{resourceType, label}).Body(), s, values)
+
+	if len(p.imports.Body().Blocks()) > 0 {
+		p.imports.Body().AppendNewline()
+	}
+	importBlock := p.imports.Body().AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: label}})
+	importBlock.SetAttributeValue("id", cty.StringVal(importID))
+}
+
+// label returns a resource label derived from name that is unique for the resource type.
+func (g *configGenerator) label(resourceType, name string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "_" + label
+	}
+	if g.labels[resourceType] == nil {
+		g.labels[resourceType] = map[string]bool{}
+	}
+	unique := label
+	for i := 2; g.labels[resourceType][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	g.labels[resourceType][unique] = true
+	return unique
+}
+
+// variable declares a sensitive variable, for the values Atlas doesn't return, and returns the reference to it.
+func (g *configGenerator) variable(name string) hcl.Traversal {
+	body := g.variables.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	variable := body.AppendNewBlock("variable", []string{name}).Body()
+	variable.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	variable.SetAttributeValue("sensitive", cty.True)
+	return hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name}}
+}
+
+// setSecrets sets the arguments of the secrets Atlas returned, redacted, to variables named after them.
+func (g *configGenerator) setSecrets(values map[string]any, variablePrefix string, secrets map[string]string) {
+	keys := make([]string, 0, len(secrets))
+	for key, secret := range secrets {
+		if secret != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		values[key] = g.variable(variablePrefix + "_" + key)
+	}
+}
+
+func resourceTraversal(resourceType, label, attribute string) hcl.Traversal {
+	return hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: label}, hcl.TraverseAttr{Name: attribute}}
+}
+
+func newFrameworkResourceConfigSchema(r resource.Resource) *configSchema {
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	return newFrameworkConfigSchema(resp.Schema.Attributes, resp.Schema.Blocks)
+}
+
+func setIfNotEmpty(values map[string]any, key, value string) {
+	if value != "" {
+		values[key] = value
+	}
+}
@@ -0,0 +1,269 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	generateConfigFixtures  = "testdata/generate_config"
+	generateConfigOrgID     = "6523b29fc1b9e3a7a5d4f000"
+	generateConfigProjectID = "6523b2a0c1b9e3a7a5d4f001"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	client := testGenerateConfigClient(t)
+
+	testCases := []struct {
+		opts     *GenerateConfigOptions
+		name     string
+		expected string
+	}{
+		{
+			name:     "project",
+			opts:     &GenerateConfigOptions{ProjectID: generateConfigProjectID, SearchNamespaces: []string{"store.products"}},
+			expected: "project",
+		},
+		{
+			name:     "organization",
+			opts:     &GenerateConfigOptions{OrgID: generateConfigOrgID},
+			expected: "organization",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			files, err := GenerateConfig(context.Background(), client, tc.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expectedDir := filepath.Join(generateConfigFixtures, tc.expected)
+			entries, err := os.ReadDir(expectedDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectedNames := make([]string, len(entries))
+			for i, entry := range entries {
+				expectedNames[i] = entry.Name()
+			}
+			names := make([]string, 0, len(files))
+			for name := range files {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, expectedNames) {
+				t.Fatalf("expected files %v, got %v", expectedNames, names)
+			}
+
+			for name, content := range files {
+				expected, err := os.ReadFile(filepath.Join(expectedDir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(content) != string(expected) {
+					t.Errorf("unexpected content of %s, expected:\n%s\ngot:\n%s", name, expected, content)
+				}
+				testValidateGeneratedConfig(t, name, content)
+			}
+		})
+	}
+}
+
+func TestGenerateConfigOptions(t *testing.T) {
+	client := testGenerateConfigClient(t)
+
+	testCases := []struct {
+		opts        *GenerateConfigOptions
+		name        string
+		expectedErr string
+	}{
+		{
+			name:        "no project or organization",
+			opts:        &GenerateConfigOptions{},
+			expectedErr: "either an organization or a project must be set to generate its configuration",
+		},
+		{
+			name:        "project and organization",
+			opts:        &GenerateConfigOptions{OrgID: generateConfigOrgID, ProjectID: generateConfigProjectID},
+			expectedErr: "either an organization or a project must be set to generate its configuration",
+		},
+		{
+			name:        "invalid namespace",
+			opts:        &GenerateConfigOptions{ProjectID: generateConfigProjectID, SearchNamespaces: []string{"products"}},
+			expectedErr: `invalid search index namespace "products", use the format database.collection`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := GenerateConfig(context.Background(), client, tc.opts)
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestGenerateConfigOptionsApplyAtlasCLIProfile(t *testing.T) {
+	profile := &AtlasCLIProfile{OrgID: "profile-org", ProjectID: "profile-project"}
+	testCases := []struct {
+		opts     GenerateConfigOptions
+		expected GenerateConfigOptions
+		name     string
+	}{
+		{
+			name:     "organization of the profile",
+			expected: GenerateConfigOptions{OrgID: "profile-org"},
+		},
+		{
+			name:     "organization flag takes precedence",
+			opts:     GenerateConfigOptions{OrgID: "org"},
+			expected: GenerateConfigOptions{OrgID: "org"},
+		},
+		{
+			name:     "project flag takes precedence",
+			opts:     GenerateConfigOptions{ProjectID: "project"},
+			expected: GenerateConfigOptions{ProjectID: "project"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.opts.ApplyAtlasCLIProfile(profile)
+			if !reflect.DeepEqual(tc.opts, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, tc.opts)
+			}
+		})
+	}
+}
+
+func TestGenerateConfigAPIErrors(t *testing.T) {
+	const project = "/api/atlas/v2/groups/6523b2a0c1b9e3a7a5d4f002"
+	testCases := []struct {
+		name        string
+		forbidden   string
+		expectedErr string
+	}{
+		{name: "projects", forbidden: "/api/atlas/v2/orgs/" + generateConfigOrgID + "/groups", expectedErr: "error getting projects of organization"},
+		{name: "clusters", forbidden: project + "/clusters", expectedErr: "error getting clusters"},
+		{name: "database users", forbidden: project + "/databaseUsers", expectedErr: "error getting database users"},
+		{name: "access list", forbidden: project + "/accessList", expectedErr: "error getting IP access list"},
+		{name: "alert configurations", forbidden: project + "/alertConfigs", expectedErr: "error getting alert configurations"},
+		{name: "integrations", forbidden: project + "/integrations", expectedErr: "error getting third-party integrations"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := testGenerateConfigClient(t, tc.forbidden)
+			_, err := GenerateConfig(context.Background(), client, &GenerateConfigOptions{OrgID: generateConfigOrgID})
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) || !strings.Contains(err.Error(), "USER_CANNOT_ACCESS_GROUP") {
+				t.Errorf("expected error %q caused by the denied request, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	pages := [][]int{{1, 2}, {3, 4}, {5}}
+	totalCount := 5
+	var requested []int
+
+	results, err := listAllPages(func(pageNum int) ([]int, *int, error) {
+		requested = append(requested, pageNum)
+		return pages[pageNum-1], &totalCount, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(results, []int{1, 2, 3, 4, 5}) || !reflect.DeepEqual(requested, []int{1, 2, 3}) {
+		t.Errorf("unexpected results %v from pages %v", results, requested)
+	}
+}
+
+// testGenerateConfigClient returns a client for a server replying to GET requests with the fixtures in
+// testdata/generate_config/responses.json, indexed by path. Requests to the forbidden paths are denied.
+func testGenerateConfigClient(t *testing.T, forbidden ...string) *MongoDBClient {
+	t.Helper()
+	fixtures, err := os.ReadFile(filepath.Join(generateConfigFixtures, "responses.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var responses map[string]json.RawMessage
+	if err := json.Unmarshal(fixtures, &responses); err != nil {
+		t.Fatalf("invalid fixtures: %s", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if slices.Contains(forbidden, r.URL.Path) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": 403, "errorCode": "USER_CANNOT_ACCESS_GROUP", "detail": "Current user is not authorized."}`))
+			return
+		}
+		response, ok := responses[r.URL.Path]
+		if r.Method != http.MethodGet || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprintf(w, `{"error": 404, "errorCode": "RESOURCE_NOT_FOUND", "detail": "Cannot find resource %s."}`, r.URL.Path)
+			return
+		}
+		_, _ = w.Write(response)
+	}))
+	t.Cleanup(server.Close)
+
+	config := Config{
+		PublicKey:  "offline",
+		PrivateKey: "offline",
+		BaseURL:    server.URL + "/",
+	}
+	client, err := config.NewClient(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.(*MongoDBClient)
+}
+
+// testValidateGeneratedConfig checks the generated SDKv2 resources are valid for their schema.
+func testValidateGeneratedConfig(t *testing.T, name string, content []byte) {
+	t.Helper()
+	file, diags := hclsyntax.ParseConfig(content, name, hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("invalid HCL in %s: %s", name, diags)
+	}
+
+	sdkResources := map[string]*schema.Resource{
+		advancedClusterResourceType:       resourceMongoDBAtlasAdvancedCluster(),
+		customDBRoleResourceType:          resourceMongoDBAtlasCustomDBRole(),
+		cloudBackupScheduleResourceType:   resourceMongoDBAtlasCloudBackupSchedule(),
+		searchIndexResourceType:           resourceMongoDBAtlasSearchIndex(),
+		thirdPartyIntegrationResourceType: resourceMongoDBAtlasThirdPartyIntegration(),
+	}
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "resource" {
+			continue
+		}
+		r, ok := sdkResources[block.Labels[0]]
+		if !ok {
+			continue
+		}
+		values := testHCLBodyValues(t, block.Body)
+		if diags := r.Validate(terraform.NewResourceConfigRaw(values)); diags.HasError() {
+			t.Errorf("invalid %s: %v", strings.Join(block.Labels, "."), diags)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	schemaAdvicePath = "api/atlas/v2/groups/%s/clusters/%s/performanceAdvisor/schemaAdvice"
+	// the versioned SDK doesn't support the schema advisor yet
+	schemaAdviceMediaType = "application/vnd.atlas.2023-01-01+json"
+)
+
+// processHostRegex matches the first label of the user alias of the mongod processes of a cluster, e.g.
+// cluster0-shard-00-01, and captures the first label of the SRV host of the cluster, e.g. cluster0.
+var processHostRegex = regexp.MustCompile(`^(.+)-(?:shard|config)-\d+-\d+$`)
+
+// schemaAdvice is the response of the schema advisor of a cluster.
+type schemaAdvice struct {
+	Content struct {
+		Recommendations []schemaAdviceRecommendation `json:"recommendations"`
+	} `json:"content"`
+}
+
+type schemaAdviceRecommendation struct {
+	Recommendation     string                  `json:"recommendation"`
+	Description        string                  `json:"description"`
+	AffectedNamespaces []schemaAdviceNamespace `json:"affectedNamespaces"`
+}
+
+type schemaAdviceNamespace struct {
+	Namespace string                `json:"namespace"`
+	Triggers  []schemaAdviceTrigger `json:"triggers"`
+}
+
+type schemaAdviceTrigger struct {
+	TriggerType string `json:"triggerType"`
+	Description string `json:"description"`
+}
+
+func getSchemaAdvice(ctx context.Context, conn *matlas.Client, projectID, clusterName string) (*schemaAdvice, error) {
+	advice := new(schemaAdvice)
+	path := fmt.Sprintf(schemaAdvicePath, projectID, url.PathEscape(clusterName))
+	if err := doVersionedRequest(ctx, conn, schemaAdviceMediaType, http.MethodGet, path, nil, advice); err != nil {
+		return nil, err
+	}
+	return advice, nil
+}
+
+// listPerformanceAdvisorProcessIDs returns the processes to read the suggested indexes and slow queries of:
+// processID if set, otherwise the primaries of every shard of the cluster, where the Performance Advisor runs.
+func listPerformanceAdvisorProcessIDs(ctx context.Context, connV2 *admin.APIClient, projectID, processID, clusterName string) ([]string, error) {
+	if processID != "" {
+		return []string{processID}, nil
+	}
+
+	cluster, _, err := connV2.ClustersApi.GetCluster(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		return nil, err
+	}
+	srvHost := clusterSRVHost(cluster)
+	processes, err := listProcesses(ctx, connV2, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var processIDs []string
+	for i := range processes {
+		process := &processes[i]
+		typeName := process.GetTypeName()
+		if (typeName == "REPLICA_PRIMARY" || typeName == "SHARD_PRIMARY") && processSRVHost(process) == srvHost {
+			processIDs = append(processIDs, process.GetId())
+		}
+	}
+	if srvHost == "" || len(processIDs) == 0 {
+		return nil, fmt.Errorf("cluster %q has no primary, it may still be provisioning", clusterName)
+	}
+	return processIDs, nil
+}
+
+// getPerformanceAdvisorClusterName returns clusterName if set, otherwise the name of the cluster processID
+// belongs to.
+func getPerformanceAdvisorClusterName(ctx context.Context, connV2 *admin.APIClient, projectID, processID, clusterName string) (string, error) {
+	if clusterName != "" {
+		return clusterName, nil
+	}
+
+	processes, err := listProcesses(ctx, connV2, projectID)
+	if err != nil {
+		return "", err
+	}
+	srvHost := ""
+	for i := range processes {
+		if processes[i].GetId() == processID {
+			srvHost = processSRVHost(&processes[i])
+			break
+		}
+	}
+	if srvHost == "" {
+		return "", fmt.Errorf("process %q is not a mongod process of the project", processID)
+	}
+
+	clusters, err := listAllPages(func(pageNum int) ([]admin.AdvancedClusterDescription, *int, error) {
+		resp, _, err := connV2.ClustersApi.ListClusters(ctx, projectID).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Results, resp.TotalCount, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	for i := range clusters {
+		if clusterSRVHost(&clusters[i]) == srvHost {
+			return clusters[i].GetName(), nil
+		}
+	}
+	return "", fmt.Errorf("process %q doesn't belong to a cluster of the project", processID)
+}
+
+func listProcesses(ctx context.Context, connV2 *admin.APIClient, projectID string) ([]admin.ApiHostViewAtlas, error) {
+	return listAllPages(func(pageNum int) ([]admin.ApiHostViewAtlas, *int, error) {
+		resp, _, err := connV2.MonitoringAndLogsApi.ListAtlasProcesses(ctx, projectID).PageNum(pageNum).Execute()
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Results, resp.TotalCount, nil
+	})
+}
+
+// clusterSRVHost returns the host of the SRV connection string of a cluster, e.g. cluster0.ab123.mongodb.net for
+// mongodb+srv://cluster0.ab123.mongodb.net, or an empty string if the cluster doesn't have one yet.
+func clusterSRVHost(cluster *admin.AdvancedClusterDescription) string {
+	if cluster.ConnectionStrings == nil {
+		return ""
+	}
+	return strings.TrimPrefix(cluster.ConnectionStrings.GetStandardSrv(), "mongodb+srv://")
+}
+
+// processSRVHost returns the host of the SRV connection string of the cluster a mongod process belongs to, which
+// Atlas derives from the user alias of its processes, e.g. cluster0.ab123.mongodb.net for
+// cluster0-shard-00-01.ab123.mongodb.net, or an empty string for processes other than mongod.
+func processSRVHost(process *admin.ApiHostViewAtlas) string {
+	alias := process.GetUserAlias()
+	if alias == "" {
+		alias = process.GetHostname()
+	}
+	host, domain, _ := strings.Cut(alias, ".")
+	match := processHostRegex.FindStringSubmatch(host)
+	if match == nil || domain == "" {
+		return ""
+	}
+	return match[1] + "." + domain
+}
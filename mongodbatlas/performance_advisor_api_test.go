@@ -0,0 +1,140 @@
+package mongodbatlas
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestListPerformanceAdvisorProcessIDs(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+	connV2 := testOfflineClient(server).AtlasV2
+	ctx := context.Background()
+	projectID := server.CreateProject(offlineOrgID, "performance-advisor")
+
+	for _, cluster := range []*matlas.AdvancedCluster{
+		{Name: "Cluster0"},
+		{Name: "cluster0-analytics"},
+		{Name: "sharded", ClusterType: "SHARDED", ReplicationSpecs: []*matlas.AdvancedReplicationSpec{{NumShards: 2}}},
+	} {
+		if err := server.CreateCluster(projectID, cluster); err != nil {
+			t.Fatalf("unexpected error seeding cluster: %s", err)
+		}
+	}
+	processes, err := listProcesses(ctx, connV2, projectID)
+	if err != nil {
+		t.Fatalf("unexpected error listing processes: %s", err)
+	}
+	primaries := map[string][]string{}
+	for i := range processes {
+		if typeName := processes[i].GetTypeName(); typeName == "REPLICA_PRIMARY" || typeName == "SHARD_PRIMARY" {
+			srvHost := processSRVHost(&processes[i])
+			primaries[srvHost] = append(primaries[srvHost], processes[i].GetId())
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		processID   string
+		clusterName string
+		expected    []string
+		expectedErr bool
+	}{
+		{name: "process", processID: "host:27017", expected: []string{"host:27017"}},
+		{name: "replica set", clusterName: "Cluster0", expected: primaries["cluster0.mongodb.net"]},
+		{name: "cluster with a similar name", clusterName: "cluster0-analytics", expected: primaries["cluster0-analytics.mongodb.net"]},
+		{name: "sharded cluster", clusterName: "sharded", expected: primaries["sharded.mongodb.net"]},
+		{name: "missing cluster", clusterName: "missing", expectedErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			processIDs, err := listPerformanceAdvisorProcessIDs(ctx, connV2, projectID, tc.processID, tc.clusterName)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v", processIDs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(processIDs) == 0 || !reflect.DeepEqual(processIDs, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, processIDs)
+			}
+		})
+	}
+	if len(primaries["sharded.mongodb.net"]) != 2 {
+		t.Errorf("expected a primary per shard, got %v", primaries)
+	}
+
+	for srvHost, clusterName := range map[string]string{"cluster0.mongodb.net": "Cluster0", "sharded.mongodb.net": "sharded"} {
+		got, err := getPerformanceAdvisorClusterName(ctx, connV2, projectID, primaries[srvHost][0], "")
+		if err != nil || got != clusterName {
+			t.Errorf("expected cluster %s for process %s, got %q (%v)", clusterName, primaries[srvHost][0], got, err)
+		}
+	}
+	if _, err := getPerformanceAdvisorClusterName(ctx, connV2, projectID, "host:27017", ""); err == nil {
+		t.Error("expected an error for a process that isn't part of the project")
+	}
+}
+
+func TestGetSchemaAdvice(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+	conn := testOfflineClient(server).Atlas
+	projectID := server.CreateProject(offlineOrgID, "performance-advisor")
+	if err := server.CreateCluster(projectID, &matlas.AdvancedCluster{Name: "cluster"}); err != nil {
+		t.Fatalf("unexpected error seeding cluster: %s", err)
+	}
+	recommendation := atlastest.SchemaAdviceRecommendation{
+		Recommendation: "REDUCE_LOOKUP_OPS",
+		Description:    "Reduce $lookup operations",
+		AffectedNamespaces: []atlastest.SchemaAdviceNamespace{{
+			Namespace: "store.orders",
+			Triggers:  []atlastest.SchemaAdviceTrigger{{TriggerType: "PERCENT_QUERIES_USE_LOOKUP", Description: "50% of queries use $lookup"}},
+		}},
+	}
+	if err := server.SetPerformanceAdvice(projectID, "cluster", &atlastest.PerformanceAdvice{
+		SchemaAdvice: []atlastest.SchemaAdviceRecommendation{recommendation},
+	}); err != nil {
+		t.Fatalf("unexpected error seeding performance advice: %s", err)
+	}
+
+	advice, err := getSchemaAdvice(context.Background(), conn, projectID, "cluster")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []schemaAdviceRecommendation{{
+		Recommendation: "REDUCE_LOOKUP_OPS",
+		Description:    "Reduce $lookup operations",
+		AffectedNamespaces: []schemaAdviceNamespace{{
+			Namespace: "store.orders",
+			Triggers:  []schemaAdviceTrigger{{TriggerType: "PERCENT_QUERIES_USE_LOOKUP", Description: "50% of queries use $lookup"}},
+		}},
+	}}
+	if !reflect.DeepEqual(advice.Content.Recommendations, expected) {
+		t.Errorf("expected %+v, got %+v", expected, advice.Content.Recommendations)
+	}
+}
+
+func TestProcessSRVHost(t *testing.T) {
+	testCases := map[string]string{
+		"cluster0-shard-00-01.ab123.mongodb.net":     "cluster0.ab123.mongodb.net",
+		"my-cluster-shard-01-02.ab123.mongodb.net":   "my-cluster.ab123.mongodb.net",
+		"my-cluster-config-00-00.ab123.mongodb.net":  "my-cluster.ab123.mongodb.net",
+		"my-cluster-mongos-00-00.ab123.mongodb.net":  "",
+		"atlas-abc123-shard-00-00.ab123.mongodb.net": "atlas-abc123.ab123.mongodb.net",
+		"localhost": "",
+	}
+	for alias, expected := range testCases {
+		process := &admin.ApiHostViewAtlas{UserAlias: &alias}
+		if got := processSRVHost(process); got != expected {
+			t.Errorf("expected %q for %s, got %q", expected, alias, got)
+		}
+	}
+}
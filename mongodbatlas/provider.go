@@ -32,6 +32,9 @@ import (
 
 var (
 	ProviderEnableBeta, _ = strconv.ParseBool(os.Getenv("MONGODB_ATLAS_ENABLE_BETA"))
+	// ProviderSkipCatalogValidation disables the plan time validation of regions and instance sizes against the catalog,
+	// e.g. to use a region Atlas released after the catalog of the provider version in use.
+	ProviderSkipCatalogValidation, _ = strconv.ParseBool(os.Getenv("MONGODB_ATLAS_SKIP_CATALOG_VALIDATION"))
 )
 
 type SecretData struct {
@@ -54,6 +57,17 @@ func NewSdkV2Provider() *schema.Provider {
 				Description: "MongoDB Atlas Programmatic Private Key",
 				Sensitive:   true,
 			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MongoDB Atlas Service Account Client ID",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "MongoDB Atlas Service Account Client Secret",
+				Sensitive:   true,
+			},
 			"base_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -100,6 +114,52 @@ func NewSdkV2Provider() *schema.Provider {
 				Optional:    true,
 				Description: "AWS Security Token Service provided session token.",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of retries for idempotent requests rejected by MongoDB Atlas with HTTP 429 or 503. Set to 0 to disable retries.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the Atlas CLI profile to read credentials and settings from.",
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the Atlas CLI configuration file, defaults to the location used by the Atlas CLI.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests sent to MongoDB Atlas at the same time. Defaults to 0, no limit.",
+			},
+			"max_concurrent_requests_per_project": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests for the same project sent to MongoDB Atlas at the same time. Defaults to 0, no limit.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Reject every request to MongoDB Atlas other than GET, so the provider can't change any resource.",
+			},
+			"default_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project ID used by the resources that don't set project_id.",
+			},
+			"default_labels": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateDefaultLabels,
+				Description:      "Labels added to all the clusters and database users managed by the provider. Labels with the same key set in a resource take precedence.",
+			},
 		},
 		DataSourcesMap:       getDataSourcesMap(),
 		ResourcesMap:         getResourcesMap(),
@@ -227,10 +287,16 @@ func getResourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_search_index":                                                resourceMongoDBAtlasSearchIndex(),
 		"mongodbatlas_data_lake_pipeline":                                          resourceMongoDBAtlasDataLakePipeline(),
 		"mongodbatlas_event_trigger":                                               resourceMongoDBAtlasEventTriggers(),
+		"mongodbatlas_app_services_app":                                            resourceMongoDBAtlasAppServicesApp(),
+		"mongodbatlas_app_services_function":                                       resourceMongoDBAtlasAppServicesFunction(),
+		"mongodbatlas_app_services_value":                                          resourceMongoDBAtlasAppServicesValue(),
+		"mongodbatlas_app_services_secret":                                         resourceMongoDBAtlasAppServicesSecret(),
+		"mongodbatlas_app_services_cluster_link":                                   resourceMongoDBAtlasAppServicesClusterLink(),
 		"mongodbatlas_cloud_backup_schedule":                                       resourceMongoDBAtlasCloudBackupSchedule(),
 		"mongodbatlas_project_invitation":                                          resourceMongoDBAtlasProjectInvitation(),
 		"mongodbatlas_org_invitation":                                              resourceMongoDBAtlasOrgInvitation(),
 		"mongodbatlas_organization":                                                resourceMongoDBAtlasOrganization(),
+		"mongodbatlas_organization_settings":                                       resourceMongoDBAtlasOrganizationSettings(),
 		"mongodbatlas_cloud_backup_snapshot":                                       resourceMongoDBAtlasCloudBackupSnapshot(),
 		"mongodbatlas_backup_compliance_policy":                                    resourceMongoDBAtlasBackupCompliancePolicy(),
 		"mongodbatlas_cloud_backup_snapshot_restore_job":                           resourceMongoDBAtlasCloudBackupSnapshotRestoreJob(),
@@ -243,7 +309,10 @@ func getResourcesMap() map[string]*schema.Resource {
 		"mongodbatlas_federated_query_limit":                                       resourceMongoDBAtlasFederatedDatabaseQueryLimit(),
 		"mongodbatlas_serverless_instance":                                         resourceMongoDBAtlasServerlessInstance(),
 		"mongodbatlas_cluster_outage_simulation":                                   resourceMongoDBAtlasClusterOutageSimulation(),
+		"mongodbatlas_cluster_advanced_configuration":                              resourceMongoDBAtlasClusterAdvancedConfiguration(),
 	}
+	addDefaultProjectID(resourcesMap)
+	addResourceNameToContext(resourcesMap)
 	return resourcesMap
 }
 
@@ -262,10 +331,26 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.D
 	}
 
 	config := Config{
-		PublicKey:    d.Get("public_key").(string),
-		PrivateKey:   d.Get("private_key").(string),
-		BaseURL:      d.Get("base_url").(string),
-		RealmBaseURL: d.Get("realm_base_url").(string),
+		PublicKey:                       d.Get("public_key").(string),
+		PrivateKey:                      d.Get("private_key").(string),
+		ClientID:                        d.Get("client_id").(string),
+		ClientSecret:                    d.Get("client_secret").(string),
+		BaseURL:                         d.Get("base_url").(string),
+		RealmBaseURL:                    d.Get("realm_base_url").(string),
+		MaxRetries:                      d.Get("max_retries").(int),
+		ReadOnly:                        d.Get("read_only").(bool),
+		MaxConcurrentRequests:           d.Get("max_concurrent_requests").(int),
+		MaxConcurrentRequestsPerProject: d.Get("max_concurrent_requests_per_project").(int),
+		DefaultProjectID:                d.Get("default_project_id").(string),
+		DefaultLabels:                   expandStringMap(d.Get("default_labels").(map[string]any)),
+	}
+
+	if profile, configFile := d.Get("profile").(string), d.Get("config_file").(string); profile != "" || configFile != "" {
+		cliProfile, err := LoadAtlasCLIProfile(configFile, profile)
+		if err != nil {
+			return nil, append(diagnostics, diag.FromErr(err)...)
+		}
+		config.ApplyAtlasCLIProfile(cliProfile)
 	}
 
 	if awsRoleDefined {
@@ -307,13 +392,32 @@ func setDefaultsAndValidations(d *schema.ResourceData, awsRoleDefined bool) diag
 		return append(diagnostics, diag.FromErr(err)...)
 	}
 
+	if err := setValueFromConfigOrEnv(d, "client_id", []string{
+		"MONGODB_ATLAS_CLIENT_ID",
+	}); err != nil {
+		return append(diagnostics, diag.FromErr(err)...)
+	}
+
+	if err := setValueFromConfigOrEnv(d, "client_secret", []string{
+		"MONGODB_ATLAS_CLIENT_SECRET",
+	}); err != nil {
+		return append(diagnostics, diag.FromErr(err)...)
+	}
+
+	serviceAccountDefined := d.Get("client_id").(string) != "" && d.Get("client_secret").(string) != ""
+	if !serviceAccountDefined && (d.Get("client_id").(string) != "" || d.Get("client_secret").(string) != "") {
+		return append(diagnostics, diag.Diagnostic{Severity: diag.Error, Summary: MissingServiceAccountAttrError})
+	}
+	// keys are read from the Atlas CLI profile later on, once the ones in the provider and environment are known
+	profileDefined := d.Get("profile").(string) != "" || d.Get("config_file").(string) != ""
+
 	if err := setValueFromConfigOrEnv(d, "public_key", []string{
 		"MONGODB_ATLAS_PUBLIC_KEY",
 		"MCLI_PUBLIC_API_KEY",
 	}); err != nil {
 		return append(diagnostics, diag.FromErr(err)...)
 	}
-	if d.Get("public_key").(string) == "" && !awsRoleDefined {
+	if d.Get("public_key").(string) == "" && !awsRoleDefined && !serviceAccountDefined && !profileDefined {
 		diagnostics = append(diagnostics, diag.Diagnostic{Severity: diag.Warning, Summary: MissingAuthAttrError})
 	}
 
@@ -324,7 +428,7 @@ func setDefaultsAndValidations(d *schema.ResourceData, awsRoleDefined bool) diag
 		return append(diagnostics, diag.FromErr(err)...)
 	}
 
-	if d.Get("private_key").(string) == "" && !awsRoleDefined {
+	if d.Get("private_key").(string) == "" && !awsRoleDefined && !serviceAccountDefined && !profileDefined {
 		diagnostics = append(diagnostics, diag.Diagnostic{Severity: diag.Warning, Summary: MissingAuthAttrError})
 	}
 
@@ -779,6 +883,14 @@ func pointer[T any](x T) *T {
 	return &x
 }
 
+func expandStringMap(m map[string]any) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return result
+}
+
 func intPtr(v int) *int {
 	if v != 0 {
 		return &v
@@ -0,0 +1,83 @@
+package mongodbatlas
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/catalog"
+)
+
+// The catalog validations below read the raw configuration, so only the values written by the user are checked and
+// values that are unknown at plan time are left to Atlas. They are skipped if ProviderSkipCatalogValidation is set.
+
+// validateCatalogRegion returns an error at attr if region is not offered by the provider.
+func validateCatalogRegion(attr, providerName string, region cty.Value) error {
+	return validateCatalog(attr, region, func(value string) error {
+		return catalog.Default().ValidateRegion(providerName, value)
+	})
+}
+
+// validateCatalogInstanceSize returns an error at attr if instanceSize is not offered by the provider.
+func validateCatalogInstanceSize(attr, providerName string, instanceSize cty.Value) error {
+	return validateCatalog(attr, instanceSize, func(value string) error {
+		return catalog.Default().ValidateInstanceSize(providerName, value)
+	})
+}
+
+// validateCatalogAutoScalingInstanceSize returns an error at attr if instanceSize can't be a compute auto-scaling bound
+// for the provider.
+func validateCatalogAutoScalingInstanceSize(attr, providerName string, instanceSize cty.Value) error {
+	return validateCatalog(attr, instanceSize, func(value string) error {
+		return catalog.Default().ValidateAutoScalingInstanceSize(providerName, value)
+	})
+}
+
+func validateCatalog(attr string, v cty.Value, validate func(value string) error) error {
+	if ProviderSkipCatalogValidation {
+		return nil
+	}
+	if value := ctyString(v); value != "" {
+		if err := validate(value); err != nil {
+			return fmt.Errorf("%s: %w", attr, err)
+		}
+	}
+	return nil
+}
+
+// catalogRegionProvider returns the provider whose regions are used: shared tier clusters are deployed in the regions
+// of their backing provider.
+func catalogRegionProvider(providerName, backingProviderName string) string {
+	if providerName == catalog.ProviderTenant && backingProviderName != "" {
+		return backingProviderName
+	}
+	return providerName
+}
+
+// ctyAttr returns the attribute of a configuration block, or null if the block is not set or unknown.
+func ctyAttr(v cty.Value, name string) cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return v.GetAttr(name)
+}
+
+// ctyElements returns the elements of a list or set of the configuration, or none if it is not set or unknown.
+func ctyElements(v cty.Value) []cty.Value {
+	if v.IsNull() || !v.IsKnown() || !v.CanIterateElements() {
+		return nil
+	}
+	var elements []cty.Value
+	for it := v.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		elements = append(elements, element)
+	}
+	return elements
+}
+
+// ctyFirstAttr returns the attribute of the first block of a list of at most one block.
+func ctyFirstAttr(v cty.Value, name string) cty.Value {
+	if elements := ctyElements(v); len(elements) > 0 {
+		return ctyAttr(elements[0], name)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
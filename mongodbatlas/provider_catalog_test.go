@@ -0,0 +1,231 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCatalogCustomizeDiff(t *testing.T) {
+	advancedCluster := func(providerName, regionName, instanceSize string) map[string]any {
+		return map[string]any{
+			"project_id":   "test-id",
+			"name":         "test-cluster",
+			"cluster_type": "REPLICASET",
+			"replication_specs": []any{map[string]any{
+				"region_configs": []any{map[string]any{
+					"provider_name":   providerName,
+					"region_name":     regionName,
+					"priority":        7,
+					"electable_specs": []any{map[string]any{"instance_size": instanceSize, "node_count": 3}},
+				}},
+			}},
+		}
+	}
+
+	testCases := []struct {
+		resource              *schema.Resource
+		config                map[string]any
+		name                  string
+		expectedErr           string
+		skipCatalogValidation bool
+	}{
+		{
+			name:     "cluster",
+			resource: resourceMongoDBAtlasCluster(),
+			config: map[string]any{
+				"project_id":                  "test-id",
+				"name":                        "test-cluster",
+				"provider_name":               "AWS",
+				"provider_instance_size_name": "M10",
+				"provider_region_name":        "US_EAST_1",
+			},
+		},
+		{
+			name:     "cluster region typo",
+			resource: resourceMongoDBAtlasCluster(),
+			config: map[string]any{
+				"project_id":                  "test-id",
+				"name":                        "test-cluster",
+				"provider_name":               "AWS",
+				"provider_instance_size_name": "M10",
+				"provider_region_name":        "US_EST_1",
+			},
+			expectedErr: `provider_region_name: "US_EST_1" is not a valid region for provider AWS, did you mean "US_EAST_1" or "US_WEST_1"?`,
+		},
+		{
+			name:     "cluster tenant in the backing provider region",
+			resource: resourceMongoDBAtlasCluster(),
+			config: map[string]any{
+				"project_id":                  "test-id",
+				"name":                        "test-cluster",
+				"provider_name":               "TENANT",
+				"backing_provider_name":       "AZURE",
+				"provider_instance_size_name": "M2",
+				"provider_region_name":        "US_EAST_1",
+			},
+			expectedErr: `provider_region_name: "US_EAST_1" is not a valid region for provider AZURE, did you mean "US_EAST_2"?`,
+		},
+		{
+			name:     "cluster auto-scaling bound",
+			resource: resourceMongoDBAtlasCluster(),
+			config: map[string]any{
+				"project_id":                  "test-id",
+				"name":                        "test-cluster",
+				"provider_name":               "AWS",
+				"provider_instance_size_name": "M40_NVME",
+				"provider_auto_scaling_compute_max_instance_size": "M40_NVME",
+			},
+			expectedErr: `provider_auto_scaling_compute_max_instance_size: "M40_NVME" is not a valid compute auto-scaling instance size for provider AWS`,
+		},
+		{
+			name:     "cluster largest instance size",
+			resource: resourceMongoDBAtlasCluster(),
+			config: map[string]any{
+				"project_id":                  "test-id",
+				"name":                        "test-cluster",
+				"provider_name":               "AWS",
+				"provider_instance_size_name": "M700",
+				"provider_region_name":        "US_EAST_1",
+			},
+		},
+		{
+			name:     "cluster regions config typo",
+			resource: resourceMongoDBAtlasCluster(),
+			config: map[string]any{
+				"project_id":                  "test-id",
+				"name":                        "test-cluster",
+				"provider_name":               "GCP",
+				"provider_instance_size_name": "M30",
+				"cluster_type":                "REPLICASET",
+				"replication_specs": []any{map[string]any{
+					"num_shards": 1,
+					"regions_config": []any{
+						map[string]any{"region_name": "WESTERN_EUROP", "electable_nodes": 3, "priority": 7},
+					},
+				}},
+			},
+			expectedErr: `replication_specs.0.regions_config.0.region_name: "WESTERN_EUROP" is not a valid region for provider GCP, did you mean "WESTERN_EUROPE"?`,
+		},
+		{
+			name:     "cluster catalog validation skipped",
+			resource: resourceMongoDBAtlasCluster(),
+			config: map[string]any{
+				"project_id":                  "test-id",
+				"name":                        "test-cluster",
+				"provider_name":               "AWS",
+				"provider_instance_size_name": "M1000",
+				"provider_region_name":        "US_EAST_9",
+			},
+			skipCatalogValidation: true,
+		},
+		{
+			name:     "advanced cluster",
+			resource: resourceMongoDBAtlasAdvancedCluster(),
+			config:   advancedCluster("GCP", "CENTRAL_US", "M30"),
+		},
+		{
+			name:        "advanced cluster instance size typo",
+			resource:    resourceMongoDBAtlasAdvancedCluster(),
+			config:      advancedCluster("AWS", "US_EAST_1", "M35"),
+			expectedErr: `replication_specs.0.region_configs.0.electable_specs.0.instance_size: "M35" is not a valid instance size for provider AWS, did you mean "M30"?`,
+		},
+		{
+			name:     "serverless instance",
+			resource: resourceMongoDBAtlasServerlessInstance(),
+			config: map[string]any{
+				"project_id": "test-id",
+				"name":       "test-serverless",
+				"provider_settings_backing_provider_name": "AWS",
+				"provider_settings_provider_name":         "SERVERLESS",
+				"provider_settings_region_name":           "EU_CENTRAL_3",
+			},
+			expectedErr: `provider_settings_region_name: "EU_CENTRAL_3" is not a valid region for provider AWS, did you mean "EU_CENTRAL_1" or "EU_CENTRAL_2"?`,
+		},
+		{
+			name:     "network container defaults to AWS",
+			resource: resourceMongoDBAtlasNetworkContainer(),
+			config: map[string]any{
+				"project_id":       "test-id",
+				"atlas_cidr_block": "10.8.0.0/21",
+				"region_name":      "US_EAST_1",
+			},
+		},
+		{
+			name:     "network container GCP regions",
+			resource: resourceMongoDBAtlasNetworkContainer(),
+			config: map[string]any{
+				"project_id":       "test-id",
+				"atlas_cidr_block": "10.8.0.0/18",
+				"provider_name":    "GCP",
+				"regions":          []any{"US_EAST_4", "US_WEST_33"},
+			},
+			expectedErr: `regions.1: "US_WEST_33" is not a valid region for provider GCP, did you mean "US_WEST_3"?`,
+		},
+		{
+			name:     "privatelink endpoint cloud provider region name",
+			resource: resourceMongoDBAtlasPrivateLinkEndpoint(),
+			config: map[string]any{
+				"project_id":    "test-id",
+				"provider_name": "AZURE",
+				"region":        "eastus2",
+			},
+		},
+		{
+			name:     "privatelink endpoint region typo",
+			resource: resourceMongoDBAtlasPrivateLinkEndpoint(),
+			config: map[string]any{
+				"project_id":    "test-id",
+				"provider_name": "AWS",
+				"region":        "us-esat-1",
+			},
+			expectedErr: `region: "us-esat-1" is not a valid region for provider AWS, did you mean "US_EAST_1" or "US_WEST_1"?`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func(skip bool) { ProviderSkipCatalogValidation = skip }(ProviderSkipCatalogValidation)
+			ProviderSkipCatalogValidation = tc.skipCatalogValidation
+
+			config := terraform.NewResourceConfigRaw(tc.config)
+			if diags := tc.resource.Validate(config); diags.HasError() {
+				t.Fatalf("test precondition failed - invalid config: %v", diags)
+			}
+			// the raw configuration is sent along the prior state when planning, also for new resources
+			state := &terraform.InstanceState{RawConfig: testRawConfig(t, tc.resource, tc.config)}
+			_, err := tc.resource.Diff(context.Background(), state, config, nil)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q, got none", tc.expectedErr)
+			}
+			if err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, got %q", tc.expectedErr, err.Error())
+			}
+		})
+	}
+}
+
+// testRawConfig returns config as the raw configuration value Terraform sends to the provider.
+func testRawConfig(t *testing.T, r *schema.Resource, config map[string]any) cty.Value {
+	t.Helper()
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("invalid config: %s", err)
+	}
+	value, err := ctyjson.Unmarshal(configJSON, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("invalid config: %s", err)
+	}
+	return value
+}
@@ -0,0 +1,229 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	errorMissingProjectIDSummary = "Missing project_id"
+	errorMissingProjectID        = "project_id must be set in the resource or default_project_id in the provider"
+)
+
+// addDefaultProjectID makes the project_id of the SDKv2 resources optional, falling back to the default_project_id of
+// the provider when it's not set in the resource configuration.
+func addDefaultProjectID(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		projectID, ok := r.Schema["project_id"]
+		if !ok || !projectID.Required || projectID.Type != schema.TypeString {
+			continue
+		}
+		projectID.Required = false
+		projectID.Optional = true
+		projectID.Computed = true
+		if r.CustomizeDiff == nil {
+			r.CustomizeDiff = customizeDiffDefaultProjectID
+		} else {
+			r.CustomizeDiff = customdiff.Sequence(customizeDiffDefaultProjectID, r.CustomizeDiff)
+		}
+	}
+}
+
+func customizeDiffDefaultProjectID(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if configured := rawConfigAttr(d, "project_id"); !configured.IsNull() {
+		return nil
+	}
+	defaultProjectID := ""
+	if client, ok := meta.(*MongoDBClient); ok && client != nil {
+		defaultProjectID = client.Config.DefaultProjectID
+	}
+	if defaultProjectID == "" {
+		if d.Id() == "" {
+			return errors.New(errorMissingProjectID)
+		}
+		// the resource keeps the project it was created in
+		return nil
+	}
+	if d.Get("project_id").(string) == defaultProjectID {
+		return nil
+	}
+	return d.SetNew("project_id", defaultProjectID)
+}
+
+// modifyPlanDefaultProjectID sets the project_id of a framework resource to the default_project_id of the provider when
+// it's not set in the resource configuration, replacing the resource if it was created in another project.
+func modifyPlanDefaultProjectID(ctx context.Context, client *MongoDBClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	defaultProjectID := client.Config.DefaultProjectID
+	if defaultProjectID == "" {
+		if req.State.Raw.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("project_id"), errorMissingProjectIDSummary, errorMissingProjectID)
+		}
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_id"), defaultProjectID)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var current types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &current)...)
+	if current.ValueString() != defaultProjectID {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("project_id"))
+	}
+}
+
+// modifyPlanDefaultLabels plans the effective_labels of a framework resource as its labels merged with the default_labels
+// of the provider, so existing resources are updated when the defaults change. Labels set in the resource take
+// precedence over the default ones with the same key.
+func modifyPlanDefaultLabels(ctx context.Context, client *MongoDBClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+	var planned types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &planned)...)
+	if resp.Diagnostics.HasError() || planned.IsUnknown() {
+		return
+	}
+	var labels []*tfLabelModel
+	resp.Diagnostics.Append(planned.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, label := range labels {
+		if label.Key.IsUnknown() || label.Value.IsUnknown() {
+			return
+		}
+	}
+	effective := labelsMap(mergeDefaultLabels(newMongoDBAtlasLabels(labels), client.Config.DefaultLabels))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_labels"), effective)...)
+}
+
+// validateDefaultLabels rejects the key of the label the provider adds to every cluster, which can't be overridden.
+func validateDefaultLabels(v any, _ cty.Path) diag.Diagnostics {
+	if _, ok := v.(map[string]any)[defaultLabel.Key]; ok {
+		return diag.Errorf("the %q label is reserved for the provider and can't be a default label", defaultLabel.Key)
+	}
+	return nil
+}
+
+// customizeDiffDefaultLabels plans the effective_labels of a cluster as its labels merged with the default_labels of
+// the provider, so existing clusters are updated when the defaults change. Labels set in the resource take precedence
+// over the default ones with the same key.
+func customizeDiffDefaultLabels(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("labels") {
+		return d.SetNewComputed("effective_labels")
+	}
+	var defaults map[string]string
+	if client, ok := meta.(*MongoDBClient); ok && client != nil {
+		defaults = client.Config.DefaultLabels
+	}
+
+	effective := labelsMap(mergeDefaultLabels(expandLabelSet(d.Get("labels").(*schema.Set)), defaults))
+	if reflect.DeepEqual(effective, expandStringMap(d.Get("effective_labels").(map[string]any))) {
+		return nil
+	}
+	return d.SetNew("effective_labels", effective)
+}
+
+// clusterLabelsRequest returns the labels of a cluster sent to Atlas: its labels merged with the default_labels of the
+// provider, plus the label identifying the provider.
+func clusterLabelsRequest(d *schema.ResourceData, meta any) ([]matlas.Label, error) {
+	labels := expandLabelSliceFromSetSchema(d)
+	if containsLabelOrKey(labels, defaultLabel) {
+		return nil, fmt.Errorf("you should not set `Infrastructure Tool` label, it is used for internal purposes")
+	}
+	return append(mergeDefaultLabels(labels, meta.(*MongoDBClient).Config.DefaultLabels), defaultLabel), nil
+}
+
+// setClusterLabels sets the labels of a cluster read from Atlas without the default ones, and all of them as its
+// effective_labels. The label identifying the provider is left out of both.
+func setClusterLabels(d *schema.ResourceData, meta any, atlasLabels []matlas.Label) error {
+	atlasLabels = removeLabel(atlasLabels, defaultLabel)
+	labels := removeDefaultLabels(atlasLabels, expandLabelSliceFromSetSchema(d), meta.(*MongoDBClient).Config.DefaultLabels)
+	if err := d.Set("labels", flattenLabels(labels)); err != nil {
+		return err
+	}
+	return d.Set("effective_labels", labelsMap(atlasLabels))
+}
+
+// mergeDefaultLabels returns labels along with the defaults whose key is not in labels, sorted by key.
+func mergeDefaultLabels(labels []matlas.Label, defaults map[string]string) []matlas.Label {
+	merged := make([]matlas.Label, 0, len(labels)+len(defaults))
+	keys := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		merged = append(merged, label)
+		keys[label.Key] = true
+	}
+	for key, value := range defaults {
+		if !keys[key] {
+			merged = append(merged, matlas.Label{Key: key, Value: value})
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Key < merged[j].Key })
+	return merged
+}
+
+// labelsMap returns labels as a map from key to value.
+func labelsMap(labels []matlas.Label) map[string]string {
+	result := make(map[string]string, len(labels))
+	for _, label := range labels {
+		result[label.Key] = label.Value
+	}
+	return result
+}
+
+// removeDefaultLabels returns the labels read from Atlas without the ones added from the defaults, so they don't show as
+// a difference with the configuration. A label is kept if its key was already in the state.
+func removeDefaultLabels(labels, state []matlas.Label, defaults map[string]string) []matlas.Label {
+	if len(defaults) == 0 {
+		return labels
+	}
+	inState := make(map[string]bool, len(state))
+	for _, label := range state {
+		inState[label.Key] = true
+	}
+	result := make([]matlas.Label, 0, len(labels))
+	for _, label := range labels {
+		if value, ok := defaults[label.Key]; ok && value == label.Value && !inState[label.Key] {
+			continue
+		}
+		result = append(result, label)
+	}
+	return result
+}
+
+func rawConfigAttr(d *schema.ResourceDiff, name string) cty.Value {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return cty.UnknownVal(cty.DynamicPseudoType)
+	}
+	return config.GetAttr(name)
+}
+
+func ctyString(v cty.Value) string {
+	if v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+	return v.AsString()
+}
@@ -0,0 +1,234 @@
+package mongodbatlas
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAddDefaultProjectID(t *testing.T) {
+	resources := getResourcesMap()
+	for _, name := range []string{"mongodbatlas_cluster", "mongodbatlas_advanced_cluster", "mongodbatlas_search_index"} {
+		projectID := resources[name].Schema["project_id"]
+		if projectID.Required || !projectID.Optional || !projectID.Computed || !projectID.ForceNew {
+			t.Errorf("expected project_id of %s to be optional, computed and force new", name)
+		}
+		if resources[name].CustomizeDiff == nil {
+			t.Errorf("expected %s to fall back to the default project", name)
+		}
+	}
+	if orgID := resources["mongodbatlas_organization"].Schema["org_id"]; orgID.Optional {
+		t.Error("expected other attributes not to change")
+	}
+}
+
+func TestMergeDefaultLabels(t *testing.T) {
+	tests := []struct {
+		defaults map[string]string
+		name     string
+		labels   []matlas.Label
+		expected []matlas.Label
+	}{
+		{
+			name:     "without defaults",
+			labels:   []matlas.Label{{Key: "env", Value: "dev"}},
+			expected: []matlas.Label{{Key: "env", Value: "dev"}},
+		},
+		{
+			name:     "adds defaults",
+			labels:   []matlas.Label{{Key: "env", Value: "dev"}},
+			defaults: map[string]string{"team": "payments", "cost_center": "42"},
+			expected: []matlas.Label{{Key: "cost_center", Value: "42"}, {Key: "env", Value: "dev"}, {Key: "team", Value: "payments"}},
+		},
+		{
+			name:     "resource labels take precedence",
+			labels:   []matlas.Label{{Key: "team", Value: "search"}},
+			defaults: map[string]string{"team": "payments"},
+			expected: []matlas.Label{{Key: "team", Value: "search"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if merged := mergeDefaultLabels(tt.labels, tt.defaults); !reflect.DeepEqual(merged, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, merged)
+			}
+		})
+	}
+}
+
+func TestRemoveDefaultLabels(t *testing.T) {
+	defaults := map[string]string{"team": "payments", "env": "dev"}
+	remote := []matlas.Label{{Key: "team", Value: "payments"}, {Key: "env", Value: "prod"}, {Key: "app", Value: "api"}}
+
+	expected := []matlas.Label{{Key: "env", Value: "prod"}, {Key: "app", Value: "api"}}
+	if labels := removeDefaultLabels(remote, nil, defaults); !reflect.DeepEqual(labels, expected) {
+		t.Errorf("expected only labels different from the defaults, got %v", labels)
+	}
+
+	state := []matlas.Label{{Key: "team", Value: "payments"}}
+	if labels := removeDefaultLabels(remote, state, defaults); !reflect.DeepEqual(labels, remote) {
+		t.Errorf("expected labels configured in the resource to be kept, got %v", labels)
+	}
+}
+
+func TestModifyPlanDefaultLabels(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewDatabaseUserRS().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	labels, _ := types.SetValueFrom(ctx, LabelObjectType, []tfLabelModel{{Key: types.StringValue("team"), Value: types.StringValue("orders")}})
+	model := &tfDatabaseUserModel{
+		ProjectID:       types.StringValue("project"),
+		Username:        types.StringValue("user"),
+		Roles:           types.SetNull(RoleObjectType),
+		Labels:          labels,
+		EffectiveLabels: types.MapNull(types.StringType),
+		Scopes:          types.SetNull(ScopeObjectType),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unexpected error building the plan: %v", diags)
+	}
+
+	client := &MongoDBClient{Config: &Config{DefaultLabels: map[string]string{"team": "payments", "environment": "dev"}}}
+	req := resource.ModifyPlanRequest{Plan: plan}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	modifyPlanDefaultLabels(ctx, client, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var planned tfDatabaseUserModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &planned)...)
+	var effective map[string]string
+	resp.Diagnostics.Append(planned.EffectiveLabels.ElementsAs(ctx, &effective, false)...)
+	expected := map[string]string{"team": "orders", "environment": "dev"}
+	if resp.Diagnostics.HasError() || !reflect.DeepEqual(effective, expected) {
+		t.Errorf("expected effective labels %v, got %v (%v)", expected, effective, resp.Diagnostics)
+	}
+}
+
+func TestCustomizeDiffDefaultLabels(t *testing.T) {
+	tests := []struct {
+		state    map[string]string
+		config   map[string]any
+		defaults map[string]string
+		expected map[string]string
+		name     string
+	}{
+		{
+			name:     "new cluster",
+			config:   map[string]any{"labels": []any{map[string]any{"key": "team", "value": "orders"}}},
+			defaults: map[string]string{"team": "payments", "environment": "dev"},
+			expected: map[string]string{"effective_labels.%": "2", "effective_labels.team": "orders", "effective_labels.environment": "dev"},
+		},
+		{
+			name:     "unchanged defaults",
+			state:    map[string]string{"effective_labels.%": "1", "effective_labels.environment": "dev"},
+			defaults: map[string]string{"environment": "dev"},
+		},
+		{
+			name:     "changed defaults",
+			state:    map[string]string{"effective_labels.%": "1", "effective_labels.environment": "dev"},
+			defaults: map[string]string{"environment": "prod"},
+			expected: map[string]string{"effective_labels.environment": "prod"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resourceMongoDBAtlasAdvancedCluster()
+			r.CustomizeDiff = customizeDiffDefaultLabels
+			var state *terraform.InstanceState
+			if tt.state != nil {
+				state = &terraform.InstanceState{ID: "cluster", Attributes: tt.state}
+			}
+			meta := &MongoDBClient{Config: &Config{DefaultLabels: tt.defaults}}
+			instanceDiff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), meta)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			planned := map[string]string{}
+			if instanceDiff != nil {
+				for name, attrDiff := range instanceDiff.Attributes {
+					if strings.HasPrefix(name, "effective_labels.") {
+						planned[name] = attrDiff.New
+					}
+				}
+			}
+			if len(planned) != len(tt.expected) || (len(tt.expected) > 0 && !reflect.DeepEqual(planned, tt.expected)) {
+				t.Errorf("expected effective labels diff %v, got %v", tt.expected, planned)
+			}
+		})
+	}
+}
+
+func TestClusterLabels(t *testing.T) {
+	meta := &MongoDBClient{Config: &Config{DefaultLabels: map[string]string{"team": "payments", "environment": "dev"}}}
+	d := schema.TestResourceDataRaw(t, resourceMongoDBAtlasCluster().Schema, map[string]any{
+		"labels": []any{map[string]any{"key": "team", "value": "orders"}},
+	})
+
+	labels, err := clusterLabelsRequest(d, meta)
+	expected := []matlas.Label{{Key: "environment", Value: "dev"}, {Key: "team", Value: "orders"}, defaultLabel}
+	if err != nil || !reflect.DeepEqual(labels, expected) {
+		t.Errorf("expected request labels %v, got %v (%v)", expected, labels, err)
+	}
+
+	if err := setClusterLabels(d, meta, labels); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if configured := expandLabelSliceFromSetSchema(d); !reflect.DeepEqual(configured, []matlas.Label{{Key: "team", Value: "orders"}}) {
+		t.Errorf("expected only the configured labels, got %v", configured)
+	}
+	if effective := d.Get("effective_labels"); !reflect.DeepEqual(effective, map[string]any{"team": "orders", "environment": "dev"}) {
+		t.Errorf("expected the labels without the provider label as effective labels, got %v", effective)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceMongoDBAtlasCluster().Schema, map[string]any{
+		"labels": []any{map[string]any{"key": defaultLabel.Key, "value": "terraform"}},
+	})
+	if _, err := clusterLabelsRequest(d, meta); err == nil {
+		t.Error("expected an error for the reserved label")
+	}
+}
+
+func TestDefaultLabelsReservedKey(t *testing.T) {
+	ctx := context.Background()
+	reserved := map[string]any{defaultLabel.Key: "terraform"}
+
+	sdkProvider := NewSdkV2Provider()
+	if diags := sdkProvider.Validate(terraform.NewResourceConfigRaw(map[string]any{"default_labels": reserved})); !diags.HasError() {
+		t.Error("expected the SDKv2 provider to reject the reserved label")
+	}
+	if diags := sdkProvider.Validate(terraform.NewResourceConfigRaw(map[string]any{"default_labels": map[string]any{"team": "payments"}})); diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+
+	schemaResp := &provider.SchemaResponse{}
+	NewFrameworkProvider().Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	req := validator.MapRequest{
+		Path:        path.Root("default_labels"),
+		ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{defaultLabel.Key: types.StringValue("terraform")}),
+	}
+	resp := &validator.MapResponse{}
+	for _, v := range schemaResp.Schema.Attributes["default_labels"].(providerschema.MapAttribute).Validators {
+		v.ValidateMap(ctx, req, resp)
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected the framework provider to reject the reserved label")
+	}
+}
@@ -2,19 +2,31 @@ package mongodbatlas
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 const (
 	// Provider name for single configuration testing
 	ProviderNameMongoDBAtlas = "mongodbatlas"
+
+	// Organization used by offline tests, the atlastest server accepts any ID.
+	offlineOrgID = "5e2211c17a3e5a48f5497de3"
 )
 
 var testAccProviderV6Factories map[string]func() (tfprotov6.ProviderServer, error)
@@ -276,3 +288,123 @@ func testCheckPrivateEndpointServiceDataFederationOnlineArchiveRun(tb testing.TB
 		tb.Skip("`MONGODB_ATLAS_PRIVATE_ENDPOINT_ID` must be set for Private Endpoint Service Data Federation and Online Archive acceptance testing")
 	}
 }
+
+// testOfflinePreCheck is used by unit tests running the provider against the atlastest server. A Terraform
+// CLI can't be downloaded without network access, so one must be installed or set in `TF_ACC_TERRAFORM_PATH`.
+func testOfflinePreCheck(tb testing.TB) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		tb.Skip("a Terraform CLI must be installed or set in `TF_ACC_TERRAFORM_PATH` for offline testing")
+	}
+}
+
+// testOfflineProviderConfig points the provider at the atlastest server. Credentials are not validated.
+func testOfflineProviderConfig(server *atlastest.Server) string {
+	return fmt.Sprintf(`
+		provider "mongodbatlas" {
+			base_url    = %q
+			public_key  = "offline"
+			private_key = "offline"
+		}
+	`, server.URL())
+}
+
+// testOfflineClient returns a client for the atlastest server, used by the checks of offline tests.
+func testOfflineClient(server *atlastest.Server) *MongoDBClient {
+	config := Config{
+		PublicKey:  "offline",
+		PrivateKey: "offline",
+		BaseURL:    server.URL(),
+	}
+	client, _ := config.NewClient(context.Background())
+	return client.(*MongoDBClient)
+}
+
+// cassetteVariables are recorded along with the HTTP traffic of a test because they are part of the
+// recorded requests, so replaying needs the same values.
+var cassetteVariables = []string{"MONGODB_ATLAS_ORG_ID", "MONGODB_ATLAS_PROJECT_ID"}
+
+// testAccRand generates the random names of an acceptance test like acctest does, from its own source.
+type testAccRand struct {
+	*rand.Rand
+}
+
+func (r testAccRand) RandomWithPrefix(name string) string {
+	return fmt.Sprintf("%s-%d", name, r.Int())
+}
+
+func (r testAccRand) RandString(length int) string {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = acctest.CharSetAlphaNum[r.Intn(len(acctest.CharSetAlphaNum))]
+	}
+	return string(result)
+}
+
+// testAccCassette records the HTTP traffic of the test to testdata/cassettes/<test name>.json, or replays
+// it without reaching Atlas, when `MONGODB_ATLAS_CASSETTE_MODE` is set. Random names of the test must be
+// generated with the returned source, which is seeded from the test name when a cassette is in use so that
+// requests match their recording. Tests calling it must run through testAccParallelTest.
+func testAccCassette(t *testing.T) testAccRand {
+	t.Helper()
+	mode := os.Getenv(cassetteModeEnvVar)
+	if mode == "" {
+		return testAccRand{rand.New(rand.NewSource(time.Now().UnixNano()))}
+	}
+
+	path := filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	if mode == cassetteModeReplay {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			// a suite that was never recorded must not pass in CI by skipping all its tests
+			if os.Getenv("CI") != "" {
+				t.Fatalf("no cassette recorded in %s, record it with `make testacc-record`", path)
+			}
+			t.Skipf("no cassette recorded in %s", path)
+		}
+	}
+	t.Setenv(cassetteFileEnvVar, path)
+
+	c, err := openCassette(mode, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { closeCassette(path) })
+
+	if mode == cassetteModeRecord {
+		variables := map[string]string{}
+		for _, name := range cassetteVariables {
+			if value := os.Getenv(name); value != "" {
+				variables[name] = value
+			}
+		}
+		if err := c.setVariables(variables); err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		for name, value := range c.Variables {
+			t.Setenv(name, value)
+		}
+		for _, name := range []string{"MONGODB_ATLAS_PUBLIC_KEY", "MONGODB_ATLAS_PRIVATE_KEY"} {
+			if os.Getenv(name) == "" {
+				t.Setenv(name, redactedValue)
+			}
+		}
+	}
+
+	seed := fnv.New64a()
+	_, _ = seed.Write([]byte(t.Name()))
+	return testAccRand{rand.New(rand.NewSource(int64(seed.Sum64())))}
+}
+
+// testAccParallelTest runs an acceptance test in parallel, unless its traffic is recorded or replayed
+// by testAccCassette since the cassette in use is set in the environment of the process.
+func testAccParallelTest(t *testing.T, tc resource.TestCase) {
+	t.Helper()
+	if os.Getenv(cassetteModeEnvVar) != "" {
+		resource.Test(t, tc)
+		return
+	}
+	resource.ParallelTest(t, tc)
+}
@@ -0,0 +1,56 @@
+package mongodbatlas
+
+import (
+	"context"
+	"time"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	pushBasedLogExportStateUnconfigured       = "UNCONFIGURED"
+	pushBasedLogExportStateInitiating         = "INITIATING"
+	pushBasedLogExportStateBucketVerified     = "BUCKET_VERIFIED"
+	pushBasedLogExportStateAssumeRoleVerified = "ASSUME_ROLE_VERIFIED"
+	pushBasedLogExportStateActive             = "ACTIVE"
+
+	pushBasedLogExportDelay        = 10 * time.Second
+	pushBasedLogExportPollInterval = 10 * time.Second
+)
+
+// pushBasedLogExportRefreshFunc reports the state of the push-based log export of a project. Atlas reports a project
+// without log export as UNCONFIGURED, a missing configuration is reported the same way.
+func pushBasedLogExportRefreshFunc(ctx context.Context, connV2 *admin.APIClient, projectID string) func() (*admin.PushBasedLogExportProject, string, error) {
+	return func() (*admin.PushBasedLogExportProject, string, error) {
+		logExport, _, err := connV2.PushBasedLogExportApi.GetPushBasedLogConfiguration(ctx, projectID).Execute()
+		if err != nil {
+			if apierror.IsNotFound(err) {
+				return nil, pushBasedLogExportStateUnconfigured, nil
+			}
+			return nil, "", err
+		}
+		return logExport, logExport.GetState(), nil
+	}
+}
+
+var pushBasedLogExportLifecycle = retrystrategy.Lifecycle{
+	Pending: []string{
+		pushBasedLogExportStateInitiating,
+		pushBasedLogExportStateBucketVerified,
+		pushBasedLogExportStateAssumeRoleVerified,
+	},
+	Ready:        pushBasedLogExportStateActive,
+	Deleted:      pushBasedLogExportStateUnconfigured,
+	Delay:        pushBasedLogExportDelay,
+	PollInterval: pushBasedLogExportPollInterval,
+}
+
+// newPushBasedLogExportWaiter waits for the push-based log export of a project to be ACTIVE, while Atlas verifies the
+// bucket and the IAM role, or to be UNCONFIGURED if deleted is true.
+func newPushBasedLogExportWaiter(ctx context.Context, connV2 *admin.APIClient, projectID, operation string, deleted bool,
+	timeout time.Duration) *retrystrategy.Waiter[*admin.PushBasedLogExportProject] {
+	return retrystrategy.NewWaiter(operation+" of push-based log export of project "+projectID, pushBasedLogExportLifecycle,
+		pushBasedLogExportRefreshFunc(ctx, connV2, projectID), deleted, timeout)
+}
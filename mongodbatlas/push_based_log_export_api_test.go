@@ -0,0 +1,56 @@
+package mongodbatlas
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+)
+
+// the SDK validates the length of project IDs
+const pushBasedLogExportTestProjectID = "5d0f1f74cf09a29120e123cd"
+
+func TestPushBasedLogExportRefreshFunc(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		wantState string
+		wantErr   string
+	}{
+		{name: "active", response: `{"bucketName":"logs","iamRoleId":"65a1b2c3d4e5f6a7b8c9d0e1","prefixPath":"atlas","state":"ACTIVE",` +
+			`"createDate":"2024-01-02T03:04:05Z"}`, wantState: pushBasedLogExportStateActive},
+		{name: "unconfigured", response: `{"state":"UNCONFIGURED"}`, wantState: pushBasedLogExportStateUnconfigured},
+		{name: "not found", response: `{"error":404,"errorCode":"RESOURCE_NOT_FOUND"}`, wantState: pushBasedLogExportStateUnconfigured},
+		{name: "api error", response: `{"error":400,"errorCode":"INVALID_ATTRIBUTE"}`, wantErr: "INVALID_ATTRIBUTE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := atlastest.NewServer()
+			defer server.Close()
+			server.AddRoute(http.MethodGet, "/api/atlas/v2/groups/"+pushBasedLogExportTestProjectID+"/pushBasedLogExport", "",
+				atlastest.Responses(tt.response))
+			connV2 := testOfflineClient(server).AtlasV2
+
+			logExport, state, err := pushBasedLogExportRefreshFunc(context.Background(), connV2, pushBasedLogExportTestProjectID)()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil || state != tt.wantState {
+				t.Fatalf("expected state %s, got %s (error %v)", tt.wantState, state, err)
+			}
+			if state != pushBasedLogExportStateActive {
+				return
+			}
+			model := newTFPushBasedLogExportModel(pushBasedLogExportTestProjectID, logExport, timeouts.Value{})
+			if model.BucketName.ValueString() != "logs" || model.CreateDate.ValueString() != "2024-01-02T03:04:05Z" {
+				t.Errorf("unexpected push-based log export %+v", model)
+			}
+		})
+	}
+}
@@ -9,16 +9,18 @@ import (
 	"net/http"
 	"reflect"
 	"regexp"
-	"strings"
 	"time"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 	"golang.org/x/exp/slices"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mwielbut/pointy"
 	"github.com/spf13/cast"
 )
@@ -34,6 +36,7 @@ const (
 	errorAdvancedClusterAdvancedConfUpdate = "error updating Advanced Configuration Option form MongoDB Cluster (%s): %s"
 	errorAdvancedClusterAdvancedConfRead   = "error reading Advanced Configuration Option form MongoDB Cluster (%s): %s"
 	errorAdvancedClusterListStatus         = "error awaiting MongoDB ClusterAdvanced List IDLE: %s"
+	advancedClusterHighestPriority         = 7
 )
 
 var upgradeRequestCtxKey acCtxKey = "upgradeRequest"
@@ -135,6 +138,11 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 					},
 				},
 			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"tags": &tagsSchema,
 			"mongo_db_major_version": {
 				Type:      schema.TypeString,
@@ -311,6 +319,8 @@ func resourceMongoDBAtlasAdvancedCluster() *schema.Resource {
 			},
 			"advanced_configuration": clusterAdvancedConfigurationSchema(),
 		},
+		CustomizeDiff: customdiff.Sequence(resourceMongoDBAtlasAdvancedClusterCustomizeDiff, resourceMongoDBAtlasAdvancedClusterCatalogCustomizeDiff,
+			customizeDiffDefaultLabels),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Hour),
 			Update: schema.DefaultTimeout(3 * time.Hour),
@@ -372,6 +382,134 @@ func advancedClusterRegionConfigsSpecsSchema() *schema.Schema {
 	}
 }
 
+// resourceMongoDBAtlasAdvancedClusterCustomizeDiff validates the topology of the replication specs at plan time, so
+// mistakes are reported before a long apply fails in Atlas. Values only known at apply time are left to Atlas.
+func resourceMongoDBAtlasAdvancedClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("replication_specs") {
+		return nil
+	}
+
+	var errs []error
+	for i := range d.Get("replication_specs").([]any) {
+		errs = append(errs, validateAdvancedClusterReplicationSpec(d, fmt.Sprintf("replication_specs.%d", i))...)
+	}
+	return errors.Join(errs...)
+}
+
+// resourceMongoDBAtlasAdvancedClusterCatalogCustomizeDiff rejects the regions and instance sizes that the cloud provider
+// of each region config doesn't offer.
+func resourceMongoDBAtlasAdvancedClusterCatalogCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	var errs []error
+	for i, spec := range ctyElements(rawConfigAttr(d, "replication_specs")) {
+		for j, regionConfig := range ctyElements(ctyAttr(spec, "region_configs")) {
+			configPath := fmt.Sprintf("replication_specs.%d.region_configs.%d", i, j)
+			providerName := ctyString(ctyAttr(regionConfig, "provider_name"))
+			if providerName == "" {
+				continue
+			}
+
+			regionProvider := catalogRegionProvider(providerName, ctyString(ctyAttr(regionConfig, "backing_provider_name")))
+			errs = append(errs, validateCatalogRegion(configPath+".region_name", regionProvider, ctyAttr(regionConfig, "region_name")))
+			for _, specs := range []string{"electable_specs", "read_only_specs", "analytics_specs"} {
+				errs = append(errs, validateCatalogInstanceSize(configPath+"."+specs+".0.instance_size", providerName,
+					ctyFirstAttr(ctyAttr(regionConfig, specs), "instance_size")))
+			}
+			for _, autoScaling := range []string{"auto_scaling", "analytics_auto_scaling"} {
+				for _, bound := range []string{"compute_min_instance_size", "compute_max_instance_size"} {
+					errs = append(errs, validateCatalogAutoScalingInstanceSize(configPath+"."+autoScaling+".0."+bound, providerName,
+						ctyFirstAttr(ctyAttr(regionConfig, autoScaling), bound)))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateAdvancedClusterReplicationSpec checks the region configs of one replication spec: electable regions must have
+// unique priorities descending from 7 in the order they are listed, the total of electable nodes must be odd, and
+// analytics nodes and backing providers are only allowed on dedicated and shared tiers respectively.
+func validateAdvancedClusterReplicationSpec(d *schema.ResourceDiff, specPath string) []error {
+	var (
+		errs             []error
+		electablePaths   []string
+		electableNodes   int
+		hasDedicatedNode bool
+		nodeCountKnown   = true
+		priorityKnown    = true
+	)
+
+	for j := range d.Get(specPath + ".region_configs").([]any) {
+		configPath := fmt.Sprintf("%s.region_configs.%d", specPath, j)
+		if !d.NewValueKnown(configPath + ".provider_name") {
+			nodeCountKnown, priorityKnown = false, false
+			continue
+		}
+
+		providerName := d.Get(configPath + ".provider_name").(string)
+		if providerName != "TENANT" && d.Get(configPath+".backing_provider_name").(string) != "" {
+			errs = append(errs, fmt.Errorf("%s.backing_provider_name: can only be set when provider_name is TENANT, got %q", configPath, providerName))
+		}
+
+		sharedTier := providerName == "TENANT" || isSharedTier(d.Get(configPath+".electable_specs.0.instance_size").(string))
+		if sharedTier && len(d.Get(configPath+".analytics_specs").([]any)) > 0 {
+			errs = append(errs, fmt.Errorf("%s.analytics_specs: analytics nodes are not available on shared tier clusters", configPath))
+		}
+
+		nodeCountPath := configPath + ".electable_specs.0.node_count"
+		if !d.NewValueKnown(nodeCountPath) {
+			nodeCountKnown = false
+			electablePaths = append(electablePaths, configPath)
+			continue
+		}
+		if providerName == "TENANT" {
+			electablePaths = append(electablePaths, configPath)
+			continue
+		}
+
+		if nodeCount := d.Get(nodeCountPath).(int); nodeCount > 0 {
+			hasDedicatedNode = true
+			electableNodes += nodeCount
+			electablePaths = append(electablePaths, configPath)
+		}
+	}
+
+	for _, configPath := range electablePaths {
+		if !d.NewValueKnown(configPath + ".priority") {
+			priorityKnown = false
+		}
+	}
+	if priorityKnown {
+		errs = append(errs, validateAdvancedClusterPriorities(d, electablePaths)...)
+	}
+
+	if nodeCountKnown && hasDedicatedNode && electableNodes%2 == 0 {
+		errs = append(errs, fmt.Errorf("%s.region_configs: the total number of electable nodes must be odd, got %d", specPath, electableNodes))
+	}
+
+	return errs
+}
+
+// validateAdvancedClusterPriorities checks that the priorities of the electable region configs are unique and that the
+// first one is 7 and each next one is one less.
+func validateAdvancedClusterPriorities(d *schema.ResourceDiff, configPaths []string) []error {
+	var errs []error
+	usedBy := make(map[int]string, len(configPaths))
+	for k, configPath := range configPaths {
+		priority := d.Get(configPath + ".priority").(int)
+		if previous, ok := usedBy[priority]; ok {
+			errs = append(errs, fmt.Errorf("%s.priority: priority %d is already used by %s", configPath, priority, previous))
+			continue
+		}
+		usedBy[priority] = configPath
+
+		if expected := advancedClusterHighestPriority - k; priority != expected {
+			errs = append(errs, fmt.Errorf("%s.priority: electable regions must have priorities descending from %d, expected %d, got %d",
+				configPath, advancedClusterHighestPriority, expected, priority))
+		}
+	}
+	return errs
+}
+
 func resourceMongoDBAtlasAdvancedClusterCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -401,10 +539,11 @@ func resourceMongoDBAtlasAdvancedClusterCreate(ctx context.Context, d *schema.Re
 		request.EncryptionAtRestProvider = v.(string)
 	}
 
-	if _, ok := d.GetOk("labels"); ok && containsLabelOrKey(expandLabelSliceFromSetSchema(d), defaultLabel) {
-		return diag.FromErr(fmt.Errorf("you should not set `Infrastructure Tool` label, it is used for internal purposes"))
+	labels, err := clusterLabelsRequest(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	request.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
+	request.Labels = labels
 
 	if _, ok := d.GetOk("tags"); ok {
 		request.Tags = expandTagSliceFromSetSchema(d)
@@ -440,17 +579,18 @@ func resourceMongoDBAtlasAdvancedClusterCreate(ctx context.Context, d *schema.Re
 	}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterAdvancedRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
-		Timeout:    timeout,
-		MinTimeout: 1 * time.Minute,
-		Delay:      3 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of cluster %s", d.Get("name").(string)),
+		Pending:      []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:       []string{"IDLE"},
+		Refresh:      resourceClusterAdvancedRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+		Delay:        3 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedCreate, err))
 	}
@@ -499,9 +639,9 @@ func resourceMongoDBAtlasAdvancedClusterRead(ctx context.Context, d *schema.Reso
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
-	cluster, resp, err := conn.AdvancedClusters.Get(ctx, projectID, clusterName)
+	cluster, _, err := conn.AdvancedClusters.Get(ctx, projectID, clusterName)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -543,7 +683,7 @@ func resourceMongoDBAtlasAdvancedClusterRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "encryption_at_rest_provider", clusterName, err))
 	}
 
-	if err := d.Set("labels", flattenLabels(removeLabel(cluster.Labels, defaultLabel))); err != nil {
+	if err := setClusterLabels(d, meta, cluster.Labels); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedSetting, "labels", clusterName, err))
 	}
 
@@ -677,12 +817,12 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 		cluster.EncryptionAtRestProvider = d.Get("encryption_at_rest_provider").(string)
 	}
 
-	if d.HasChange("labels") {
-		if containsLabelOrKey(expandLabelSliceFromSetSchema(d), defaultLabel) {
-			return diag.FromErr(fmt.Errorf("you should not set `Infrastructure Tool` label, it is used for internal purposes"))
+	if d.HasChanges("labels", "effective_labels") {
+		labels, err := clusterLabelsRequest(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-
-		cluster.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
+		cluster.Labels = labels
 	}
 
 	if d.HasChange("tags") {
@@ -737,8 +877,7 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 			_, _, err := updateAdvancedCluster(ctx, conn, cluster, projectID, clusterName, timeout)
 			if err != nil {
-				var target *matlas.ErrorResponse
-				if errors.As(err, &target) && target.ErrorCode == "CANNOT_UPDATE_PAUSED_CLUSTER" {
+				if apierror.IsClusterPaused(err) {
 					clusterRequest := &matlas.AdvancedCluster{
 						Paused: pointy.Bool(false),
 					}
@@ -747,7 +886,7 @@ func resourceMongoDBAtlasAdvancedClusterUpdate(ctx context.Context, d *schema.Re
 						return retry.NonRetryableError(fmt.Errorf(errorClusterAdvancedUpdate, clusterName, err))
 					}
 				}
-				if errors.As(err, &target) && target.HTTPCode == 400 {
+				if apierror.HasStatus(err, http.StatusBadRequest) {
 					return retry.NonRetryableError(fmt.Errorf(errorClusterAdvancedUpdate, clusterName, err))
 				}
 			}
@@ -793,17 +932,18 @@ func resourceMongoDBAtlasAdvancedClusterDelete(ctx context.Context, d *schema.Re
 
 	log.Println("[INFO] Waiting for MongoDB ClusterAdvanced to be destroyed")
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
-		Target:     []string{"DELETED"},
-		Refresh:    resourceClusterAdvancedRefreshFunc(ctx, clusterName, projectID, conn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute, // Wait 30 secs before starting
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of cluster %s", clusterName),
+		Pending:      []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      resourceClusterAdvancedRefreshFunc(ctx, clusterName, projectID, conn),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute, // Wait 30 secs before starting
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterAdvancedDelete, clusterName, err))
 	}
@@ -846,7 +986,12 @@ func splitSClusterAdvancedImportID(id string) (projectID, clusterName *string, e
 	parts := re.FindStringSubmatch(id)
 
 	if len(parts) != 3 {
-		err = errors.New("import format error: to import a advanced cluster, use the format {project_id}-{name}")
+		// the ID of a mongodbatlas_cluster in the state is also accepted to migrate it to an advanced cluster
+		if ids := decodeStateID(id); ids["project_id"] != "" && ids["cluster_name"] != "" {
+			projectID, clusterName = pointy.String(ids["project_id"]), pointy.String(ids["cluster_name"])
+			return
+		}
+		err = errors.New("import format error: to import a advanced cluster, use the format {project_id}-{name} or the ID of a mongodbatlas_cluster")
 		return
 	}
 
@@ -1258,7 +1403,7 @@ func resourceClusterAdvancedRefreshFunc(ctx context.Context, name, projectID str
 	return func() (any, string, error) {
 		c, resp, err := client.AdvancedClusters.Get(ctx, projectID, name)
 
-		if err != nil && strings.Contains(err.Error(), "reset by peer") {
+		if apierror.IsTransientNetworkError(err) {
 			return nil, "REPEATING", nil
 		}
 
@@ -1267,10 +1412,10 @@ func resourceClusterAdvancedRefreshFunc(ctx context.Context, name, projectID str
 		}
 
 		if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
@@ -1288,7 +1433,7 @@ func resourceClusterListAdvancedRefreshFunc(ctx context.Context, projectID strin
 	return func() (any, string, error) {
 		clusters, resp, err := client.AdvancedClusters.List(ctx, projectID, nil)
 
-		if err != nil && strings.Contains(err.Error(), "reset by peer") {
+		if apierror.IsTransientNetworkError(err) {
 			return nil, "REPEATING", nil
 		}
 
@@ -1297,10 +1442,10 @@ func resourceClusterListAdvancedRefreshFunc(ctx context.Context, projectID strin
 		}
 
 		if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
@@ -1367,17 +1512,18 @@ func updateAdvancedCluster(
 		return nil, nil, err
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterAdvancedRefreshFunc(ctx, name, projectID, conn),
-		Timeout:    timeout,
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("update of cluster %s", name),
+		Pending:      []string{"CREATING", "UPDATING", "REPAIRING"},
+		Target:       []string{"IDLE"},
+		Refresh:      resourceClusterAdvancedRefreshFunc(ctx, name, projectID, conn),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1430,7 +1576,10 @@ func flattenTags(l *[]*matlas.Tag) []map[string]any {
 }
 
 func expandLabelSliceFromSetSchema(d *schema.ResourceData) []matlas.Label {
-	list := d.Get("labels").(*schema.Set)
+	return expandLabelSet(d.Get("labels").(*schema.Set))
+}
+
+func expandLabelSet(list *schema.Set) []matlas.Label {
 	res := make([]matlas.Label, list.Len())
 
 	for i, val := range list.List() {
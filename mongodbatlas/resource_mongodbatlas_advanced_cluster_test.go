@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
 	"github.com/mwielbut/pointy"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -673,6 +675,39 @@ var tagsMap3 = map[string]string{
 	"value": "value 3",
 }
 
+func TestClusterAdvancedCluster_offline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping offline advanced cluster test in short mode: the create and delete waiters have fixed delays")
+	}
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	var (
+		resourceName   = "mongodbatlas_advanced_cluster.test"
+		dataSourceName = "data.mongodbatlas_advanced_cluster.test"
+		name           = "offline-cluster"
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroyOffline(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasAdvancedClusterConfigSingleProvider(offlineOrgID, "offline-project", name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "state_name", "IDLE"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specs.0.region_configs.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "connection_strings.0.standard_srv"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMongoDBAtlasAdvancedClusterExists(resourceName string, cluster *matlas.AdvancedCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
@@ -748,6 +783,24 @@ func testAccCheckMongoDBAtlasAdvancedClusterDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckMongoDBAtlasAdvancedClusterDestroyOffline(server *atlastest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testOfflineClient(server).Atlas
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "mongodbatlas_advanced_cluster" {
+				continue
+			}
+
+			if _, _, err := conn.AdvancedClusters.Get(context.Background(), rs.Primary.Attributes["project_id"], rs.Primary.Attributes["name"]); !apierror.IsNotFound(err) {
+				return fmt.Errorf("cluster (%s:%s) still exists", rs.Primary.Attributes["name"], rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccMongoDBAtlasAdvancedClusterConfigTenant(orgID, projectName, name string) string {
 	return fmt.Sprintf(`
 resource "mongodbatlas_project" "cluster_project" {
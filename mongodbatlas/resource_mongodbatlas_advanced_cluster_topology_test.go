@@ -0,0 +1,152 @@
+package mongodbatlas
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testUnknownValue is how a value only known at apply time is represented in a raw resource config.
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestAdvancedClusterCustomizeDiff(t *testing.T) {
+	electable := func(instanceSize string, nodeCount any) []any {
+		return []any{map[string]any{"instance_size": instanceSize, "node_count": nodeCount}}
+	}
+	regionConfig := func(providerName string, priority any, electableSpecs []any) map[string]any {
+		return map[string]any{
+			"provider_name":   providerName,
+			"region_name":     "US_EAST_1",
+			"priority":        priority,
+			"electable_specs": electableSpecs,
+		}
+	}
+	with := func(config map[string]any, key string, value any) map[string]any {
+		config[key] = value
+		return config
+	}
+
+	testCases := []struct {
+		name          string
+		regionConfigs []any
+		expectedErrs  []string
+	}{
+		{
+			name:          "single region",
+			regionConfigs: []any{regionConfig("AWS", 7, electable("M10", 3))},
+		},
+		{
+			name: "multi region",
+			regionConfigs: []any{
+				regionConfig("AWS", 7, electable("M10", 3)),
+				regionConfig("GCP", 6, electable("M10", 2)),
+				regionConfig("AZURE", 0, nil),
+			},
+		},
+		{
+			name: "tenant",
+			regionConfigs: []any{
+				with(regionConfig("TENANT", 7, electable("M5", nil)), "backing_provider_name", "AWS"),
+			},
+		},
+		{
+			name: "duplicate priorities",
+			regionConfigs: []any{
+				regionConfig("AWS", 7, electable("M10", 3)),
+				regionConfig("GCP", 7, electable("M10", 2)),
+			},
+			expectedErrs: []string{"replication_specs.0.region_configs.1.priority: priority 7 is already used by replication_specs.0.region_configs.0"},
+		},
+		{
+			name: "priorities not descending from 7",
+			regionConfigs: []any{
+				regionConfig("AWS", 6, electable("M10", 3)),
+				regionConfig("GCP", 4, electable("M10", 2)),
+			},
+			expectedErrs: []string{
+				"replication_specs.0.region_configs.0.priority: electable regions must have priorities descending from 7, expected 7, got 6",
+				"replication_specs.0.region_configs.1.priority: electable regions must have priorities descending from 7, expected 6, got 4",
+			},
+		},
+		{
+			name: "even number of electable nodes",
+			regionConfigs: []any{
+				regionConfig("AWS", 7, electable("M10", 2)),
+				regionConfig("GCP", 6, electable("M10", 2)),
+			},
+			expectedErrs: []string{"replication_specs.0.region_configs: the total number of electable nodes must be odd, got 4"},
+		},
+		{
+			name: "analytics specs on tenant",
+			regionConfigs: []any{
+				with(with(regionConfig("TENANT", 7, electable("M0", nil)), "backing_provider_name", "AWS"), "analytics_specs", electable("M0", 1)),
+			},
+			expectedErrs: []string{"replication_specs.0.region_configs.0.analytics_specs: analytics nodes are not available on shared tier clusters"},
+		},
+		{
+			name: "backing provider on dedicated tier",
+			regionConfigs: []any{
+				with(regionConfig("AWS", 7, electable("M10", 3)), "backing_provider_name", "AWS"),
+			},
+			expectedErrs: []string{`replication_specs.0.region_configs.0.backing_provider_name: can only be set when provider_name is TENANT, got "AWS"`},
+		},
+		{
+			name: "unknown node count",
+			regionConfigs: []any{
+				regionConfig("AWS", 7, electable("M10", testUnknownValue)),
+				regionConfig("GCP", 6, electable("M10", 1)),
+			},
+		},
+		{
+			name: "unknown priority",
+			regionConfigs: []any{
+				regionConfig("AWS", testUnknownValue, electable("M10", 3)),
+				regionConfig("GCP", 7, electable("M10", 2)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := advancedClusterTopologyDiff(t, tc.regionConfigs)
+			if len(tc.expectedErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors %q, got none", tc.expectedErrs)
+			}
+			if expected := strings.Join(tc.expectedErrs, "\n"); err.Error() != expected {
+				t.Errorf("expected errors %q, got %q", expected, err.Error())
+			}
+		})
+	}
+}
+
+// advancedClusterTopologyDiff plans a new advanced cluster with the given region configs in its only replication spec
+// and returns the error of the topology validation.
+func advancedClusterTopologyDiff(t *testing.T, regionConfigs []any) error {
+	t.Helper()
+	r := &schema.Resource{
+		Schema:        resourceMongoDBAtlasAdvancedCluster().Schema,
+		CustomizeDiff: resourceMongoDBAtlasAdvancedClusterCustomizeDiff,
+	}
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"project_id":   "test-id",
+		"name":         "test-cluster",
+		"cluster_type": "REPLICASET",
+		"replication_specs": []any{
+			map[string]any{"region_configs": regionConfigs},
+		},
+	})
+	if diags := r.Validate(config); diags.HasError() {
+		t.Fatalf("test precondition failed - invalid advanced cluster config: %v", diags)
+	}
+	_, err := r.Diff(context.Background(), nil, config, nil)
+	return err
+}
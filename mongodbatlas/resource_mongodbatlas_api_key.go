@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -64,9 +65,9 @@ func resourceMongoDBAtlasAPIKeyCreate(ctx context.Context, d *schema.ResourceDat
 
 	createRequest.Roles = expandStringList(d.Get("role_names").(*schema.Set).List())
 
-	apiKey, resp, err := conn.APIKeys.Create(ctx, orgID, createRequest)
+	apiKey, _, err := conn.APIKeys.Create(ctx, orgID, createRequest)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -93,9 +94,9 @@ func resourceMongoDBAtlasAPIKeyRead(ctx context.Context, d *schema.ResourceData,
 	orgID := ids["org_id"]
 	apiKeyID := ids["api_key_id"]
 
-	apiKey, resp, err := conn.APIKeys.Get(ctx, orgID, apiKeyID)
+	apiKey, _, err := conn.APIKeys.Get(ctx, orgID, apiKeyID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+		if apierror.IsNotFound(err) || apierror.HasStatus(err, http.StatusBadRequest) {
 			log.Printf("warning API key deleted will recreate: %s \n", err.Error())
 			d.SetId("")
 			return nil
@@ -0,0 +1,217 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	errorAppServicesAppCreate  = "error creating App Services app (%s): %s"
+	errorAppServicesAppRead    = "error reading App Services app (%s): %s"
+	errorAppServicesAppUpdate  = "error updating App Services app (%s): %s"
+	errorAppServicesAppDelete  = "error deleting App Services app (%s): %s"
+	errorAppServicesAppSetting = "error setting `%s` for App Services app (%s): %s"
+)
+
+func resourceMongoDBAtlasAppServicesApp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasAppServicesAppCreate,
+		ReadContext:   resourceMongoDBAtlasAppServicesAppRead,
+		UpdateContext: resourceMongoDBAtlasAppServicesAppUpdate,
+		DeleteContext: resourceMongoDBAtlasAppServicesAppDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMongoDBAtlasAppServicesAppImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"deployment_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"GLOBAL", "LOCAL"}, false),
+			},
+			"environment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"development", "testing", "qa", "production"}, false),
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasAppServicesAppCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	app, err := createAppServicesApp(ctx, conn, projectID, &appServicesApp{
+		Name:            d.Get("name").(string),
+		Location:        d.Get("location").(string),
+		DeploymentModel: d.Get("deployment_model").(string),
+		Environment:     d.Get("environment").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesAppCreate, d.Get("name").(string), err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"app_id":     app.ID,
+	}))
+
+	return resourceMongoDBAtlasAppServicesAppRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesAppRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	appID := ids["app_id"]
+
+	app, err := getAppServicesApp(ctx, conn, projectID, appID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorAppServicesAppRead, appID, err))
+	}
+
+	values := map[string]any{
+		"project_id":       projectID,
+		"app_id":           app.ID,
+		"name":             app.Name,
+		"location":         app.Location,
+		"deployment_model": app.DeploymentModel,
+		"environment":      app.Environment,
+		"client_app_id":    app.ClientAppID,
+		"domain_id":        app.DomainID,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAppServicesAppSetting, key, appID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAppServicesAppUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	if d.HasChange("environment") {
+		if err := setAppServicesAppEnvironment(ctx, conn, ids["project_id"], ids["app_id"], d.Get("environment").(string)); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAppServicesAppUpdate, ids["app_id"], err))
+		}
+	}
+
+	return resourceMongoDBAtlasAppServicesAppRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesAppDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	if err := deleteAppServicesApp(ctx, conn, ids["project_id"], ids["app_id"]); err != nil && !apierror.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf(errorAppServicesAppDelete, ids["app_id"], err))
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAppServicesAppImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "--")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.New("import format error: to import an App Services app, use the format {project_id}--{app_id}")
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": parts[0],
+		"app_id":     parts[1],
+	}))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// appServicesImportState imports a resource that belongs to an App Services app, like functions or values, whose import
+// ID has the format {project_id}--{app_id}--{<idKey>}.
+func appServicesImportState(idKey, kind string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "--")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("import format error: to import an App Services %s, use the format {project_id}--{app_id}--{%s}", kind, idKey)
+		}
+
+		d.SetId(encodeStateID(map[string]string{
+			"project_id": parts[0],
+			"app_id":     parts[1],
+			idKey:        parts[2],
+		}))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// appServicesJSONDiff suppresses differences between equivalent JSON documents, the API doesn't keep the formatting of
+// the documents it receives.
+func appServicesJSONDiff(k, old, newStr string, d *schema.ResourceData) bool {
+	var oldValue, newValue any
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(newStr), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
@@ -0,0 +1,170 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	errorAppServicesClusterLinkCreate  = "error creating App Services cluster link (%s): %s"
+	errorAppServicesClusterLinkRead    = "error reading App Services cluster link (%s): %s"
+	errorAppServicesClusterLinkUpdate  = "error updating App Services cluster link (%s): %s"
+	errorAppServicesClusterLinkDelete  = "error deleting App Services cluster link (%s): %s"
+	errorAppServicesClusterLinkSetting = "error setting `%s` for App Services cluster link (%s): %s"
+)
+
+// resourceMongoDBAtlasAppServicesClusterLink links an Atlas cluster to an App Services app as a data source, the
+// service_id of the link is the one database triggers use.
+func resourceMongoDBAtlasAppServicesClusterLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasAppServicesClusterLinkCreate,
+		ReadContext:   resourceMongoDBAtlasAppServicesClusterLinkRead,
+		UpdateContext: resourceMongoDBAtlasAppServicesClusterLinkUpdate,
+		DeleteContext: resourceMongoDBAtlasAppServicesClusterLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: appServicesImportState("service_id", "cluster link"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"read_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"primary", "primaryPreferred", "secondary", "secondaryPreferred", "nearest"}, false),
+			},
+			"wire_protocol_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasAppServicesClusterLinkCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	appID := d.Get("app_id").(string)
+	service, err := createAppServicesService(ctx, conn, projectID, appID, &appServicesService{
+		Name:   d.Get("name").(string),
+		Type:   appServicesClusterServiceType,
+		Config: newAppServicesClusterConfig(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesClusterLinkCreate, d.Get("name").(string), err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"app_id":     appID,
+		"service_id": service.ID,
+	}))
+
+	return resourceMongoDBAtlasAppServicesClusterLinkRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesClusterLinkRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	serviceID := ids["service_id"]
+
+	service, err := getAppServicesService(ctx, conn, ids["project_id"], ids["app_id"], serviceID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorAppServicesClusterLinkRead, serviceID, err))
+	}
+	if service.Type != appServicesClusterServiceType {
+		return diag.FromErr(fmt.Errorf(errorAppServicesClusterLinkRead, serviceID, fmt.Sprintf("service of type %q is not an Atlas cluster", service.Type)))
+	}
+
+	values := map[string]any{
+		"project_id":            ids["project_id"],
+		"app_id":                ids["app_id"],
+		"service_id":            service.ID,
+		"name":                  service.Name,
+		"cluster_name":          service.Config.ClusterName,
+		"read_preference":       service.Config.ReadPreference,
+		"wire_protocol_enabled": service.Config.WireProtocolEnabled,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAppServicesClusterLinkSetting, key, serviceID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAppServicesClusterLinkUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	if err := updateAppServicesServiceConfig(ctx, conn, ids["project_id"], ids["app_id"], ids["service_id"], newAppServicesClusterConfig(d)); err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesClusterLinkUpdate, ids["service_id"], err))
+	}
+
+	return resourceMongoDBAtlasAppServicesClusterLinkRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesClusterLinkDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	if err := deleteAppServicesService(ctx, conn, ids["project_id"], ids["app_id"], ids["service_id"]); err != nil && !apierror.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf(errorAppServicesClusterLinkDelete, ids["service_id"], err))
+	}
+
+	return nil
+}
+
+func newAppServicesClusterConfig(d *schema.ResourceData) *appServicesClusterConfig {
+	return &appServicesClusterConfig{
+		ClusterName:         d.Get("cluster_name").(string),
+		ReadPreference:      d.Get("read_preference").(string),
+		WireProtocolEnabled: d.Get("wire_protocol_enabled").(bool),
+	}
+}
@@ -0,0 +1,192 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	errorAppServicesFunctionCreate  = "error creating App Services function (%s): %s"
+	errorAppServicesFunctionRead    = "error reading App Services function (%s): %s"
+	errorAppServicesFunctionUpdate  = "error updating App Services function (%s): %s"
+	errorAppServicesFunctionDelete  = "error deleting App Services function (%s): %s"
+	errorAppServicesFunctionSetting = "error setting `%s` for App Services function (%s): %s"
+)
+
+func resourceMongoDBAtlasAppServicesFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasAppServicesFunctionCreate,
+		ReadContext:   resourceMongoDBAtlasAppServicesFunctionRead,
+		UpdateContext: resourceMongoDBAtlasAppServicesFunctionUpdate,
+		DeleteContext: resourceMongoDBAtlasAppServicesFunctionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: appServicesImportState("function_id", "function"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"private": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"can_evaluate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: appServicesJSONDiff,
+			},
+			"run_as_system": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"run_as_user_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"run_as_system"},
+			},
+			"disable_arg_logs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"function_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasAppServicesFunctionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	appID := d.Get("app_id").(string)
+	function, err := createAppServicesFunction(ctx, conn, projectID, appID, newAppServicesFunction(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesFunctionCreate, d.Get("name").(string), err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":  projectID,
+		"app_id":      appID,
+		"function_id": function.ID,
+	}))
+
+	return resourceMongoDBAtlasAppServicesFunctionRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesFunctionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	functionID := ids["function_id"]
+
+	function, err := getAppServicesFunction(ctx, conn, ids["project_id"], ids["app_id"], functionID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorAppServicesFunctionRead, functionID, err))
+	}
+
+	canEvaluate := ""
+	if len(function.CanEvaluate) > 0 && string(function.CanEvaluate) != "{}" && string(function.CanEvaluate) != "null" {
+		canEvaluate = string(function.CanEvaluate)
+	}
+	values := map[string]any{
+		"project_id":       ids["project_id"],
+		"app_id":           ids["app_id"],
+		"function_id":      function.ID,
+		"name":             function.Name,
+		"source":           function.Source,
+		"private":          function.Private,
+		"can_evaluate":     canEvaluate,
+		"run_as_system":    function.RunAsSystem,
+		"run_as_user_id":   function.RunAsUserID,
+		"disable_arg_logs": function.DisableArgLogs,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAppServicesFunctionSetting, key, functionID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAppServicesFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	function := newAppServicesFunction(d)
+	function.ID = ids["function_id"]
+	if err := updateAppServicesFunction(ctx, conn, ids["project_id"], ids["app_id"], ids["function_id"], function); err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesFunctionUpdate, ids["function_id"], err))
+	}
+
+	return resourceMongoDBAtlasAppServicesFunctionRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesFunctionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	if err := deleteAppServicesFunction(ctx, conn, ids["project_id"], ids["app_id"], ids["function_id"]); err != nil && !apierror.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf(errorAppServicesFunctionDelete, ids["function_id"], err))
+	}
+
+	return nil
+}
+
+func newAppServicesFunction(d *schema.ResourceData) *appServicesFunction {
+	function := &appServicesFunction{
+		Name:           d.Get("name").(string),
+		Source:         d.Get("source").(string),
+		Private:        d.Get("private").(bool),
+		RunAsSystem:    d.Get("run_as_system").(bool),
+		RunAsUserID:    d.Get("run_as_user_id").(string),
+		DisableArgLogs: d.Get("disable_arg_logs").(bool),
+	}
+	if v, ok := d.GetOk("can_evaluate"); ok {
+		function.CanEvaluate = json.RawMessage(v.(string))
+	}
+	return function
+}
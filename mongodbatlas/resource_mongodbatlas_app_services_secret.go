@@ -0,0 +1,148 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	errorAppServicesSecretCreate  = "error creating App Services secret (%s): %s"
+	errorAppServicesSecretRead    = "error reading App Services secret (%s): %s"
+	errorAppServicesSecretUpdate  = "error updating App Services secret (%s): %s"
+	errorAppServicesSecretDelete  = "error deleting App Services secret (%s): %s"
+	errorAppServicesSecretSetting = "error setting `%s` for App Services secret (%s): %s"
+)
+
+// resourceMongoDBAtlasAppServicesSecret manages an App Services secret. Atlas never returns the value of a secret so
+// the value in the state is the last one applied, changes made outside of Terraform can't be detected.
+func resourceMongoDBAtlasAppServicesSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasAppServicesSecretCreate,
+		ReadContext:   resourceMongoDBAtlasAppServicesSecretRead,
+		UpdateContext: resourceMongoDBAtlasAppServicesSecretUpdate,
+		DeleteContext: resourceMongoDBAtlasAppServicesSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: appServicesImportState("secret_id", "secret"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasAppServicesSecretCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	appID := d.Get("app_id").(string)
+	secret, err := createAppServicesSecret(ctx, conn, projectID, appID, &appServicesSecret{
+		Name:  d.Get("name").(string),
+		Value: d.Get("value").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesSecretCreate, d.Get("name").(string), err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"app_id":     appID,
+		"secret_id":  secret.ID,
+	}))
+
+	return resourceMongoDBAtlasAppServicesSecretRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesSecretRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	secretID := ids["secret_id"]
+
+	secret, err := getAppServicesSecret(ctx, conn, ids["project_id"], ids["app_id"], secretID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorAppServicesSecretRead, secretID, err))
+	}
+
+	values := map[string]any{
+		"project_id": ids["project_id"],
+		"app_id":     ids["app_id"],
+		"secret_id":  secret.ID,
+		"name":       secret.Name,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAppServicesSecretSetting, key, secretID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAppServicesSecretUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	err = updateAppServicesSecret(ctx, conn, ids["project_id"], ids["app_id"], ids["secret_id"], &appServicesSecret{
+		ID:    ids["secret_id"],
+		Name:  d.Get("name").(string),
+		Value: d.Get("value").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesSecretUpdate, ids["secret_id"], err))
+	}
+
+	return resourceMongoDBAtlasAppServicesSecretRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesSecretDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	if err := deleteAppServicesSecret(ctx, conn, ids["project_id"], ids["app_id"], ids["secret_id"]); err != nil && !apierror.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf(errorAppServicesSecretDelete, ids["secret_id"], err))
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigRSAppServices_basic(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acctest.RandomWithPrefix("test-acc-app-services")
+		clusterName = acctest.RandomWithPrefix("test-acc-app-services")
+		appName     = acctest.RandomWithPrefix("test-acc-app")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAppServicesAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasAppServicesConfig(orgID, projectName, clusterName, appName, "development", "primary", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAppServicesAppExists("mongodbatlas_app_services_app.test"),
+					resource.TestCheckResourceAttr("mongodbatlas_app_services_app.test", "name", appName),
+					resource.TestCheckResourceAttr("mongodbatlas_app_services_app.test", "environment", "development"),
+					resource.TestCheckResourceAttrSet("mongodbatlas_app_services_app.test", "client_app_id"),
+					resource.TestCheckResourceAttr("mongodbatlas_app_services_cluster_link.test", "cluster_name", clusterName),
+					resource.TestCheckResourceAttrSet("mongodbatlas_app_services_cluster_link.test", "service_id"),
+					resource.TestCheckResourceAttrSet("mongodbatlas_app_services_function.test", "function_id"),
+					resource.TestCheckResourceAttr("mongodbatlas_app_services_value.test", "value", `{"threshold":10}`),
+					resource.TestCheckResourceAttrPair("mongodbatlas_event_trigger.test", "function_id", "mongodbatlas_app_services_function.test", "function_id"),
+					resource.TestCheckResourceAttrPair("mongodbatlas_event_trigger.test", "config_service_id", "mongodbatlas_app_services_cluster_link.test", "service_id"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasAppServicesConfig(orgID, projectName, clusterName, appName, "production", "secondaryPreferred", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasAppServicesAppExists("mongodbatlas_app_services_app.test"),
+					resource.TestCheckResourceAttr("mongodbatlas_app_services_app.test", "environment", "production"),
+					resource.TestCheckResourceAttr("mongodbatlas_app_services_cluster_link.test", "read_preference", "secondaryPreferred"),
+					resource.TestCheckResourceAttr("mongodbatlas_app_services_value.test", "value", `{"threshold":20}`),
+				),
+			},
+			{
+				ResourceName:      "mongodbatlas_app_services_app.test",
+				ImportStateIdFunc: testAccCheckMongoDBAtlasAppServicesImportStateIDFunc("mongodbatlas_app_services_app.test", ""),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "mongodbatlas_app_services_function.test",
+				ImportStateIdFunc: testAccCheckMongoDBAtlasAppServicesImportStateIDFunc("mongodbatlas_app_services_function.test", "function_id"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "mongodbatlas_app_services_cluster_link.test",
+				ImportStateIdFunc: testAccCheckMongoDBAtlasAppServicesImportStateIDFunc("mongodbatlas_app_services_cluster_link.test", "service_id"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "mongodbatlas_app_services_secret.test",
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasAppServicesImportStateIDFunc("mongodbatlas_app_services_secret.test", "secret_id"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasAppServicesAppExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		ctx := context.Background()
+		conn, err := testAccProviderSdkV2.Meta().(*MongoDBClient).GetRealmClient(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := getAppServicesApp(ctx, conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["app_id"]); err != nil {
+			return fmt.Errorf("App Services app (%s) does not exist: %s", rs.Primary.Attributes["app_id"], err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasAppServicesAppDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	conn, err := testAccProviderSdkV2.Meta().(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_app_services_app" {
+			continue
+		}
+		if _, err := getAppServicesApp(ctx, conn, rs.Primary.Attributes["project_id"], rs.Primary.Attributes["app_id"]); err == nil {
+			return fmt.Errorf("App Services app (%s) still exists", rs.Primary.Attributes["app_id"])
+		}
+	}
+	return nil
+}
+
+func testAccCheckMongoDBAtlasAppServicesImportStateIDFunc(resourceName, idKey string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		id := fmt.Sprintf("%s--%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["app_id"])
+		if idKey != "" {
+			id = fmt.Sprintf("%s--%s", id, rs.Primary.Attributes[idKey])
+		}
+		return id, nil
+	}
+}
+
+func testAccMongoDBAtlasAppServicesConfig(orgID, projectName, clusterName, appName, environment, readPreference string, threshold int) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_project" "test" {
+		name   = %[2]q
+		org_id = %[1]q
+	}
+
+	resource "mongodbatlas_advanced_cluster" "test" {
+		project_id   = mongodbatlas_project.test.id
+		name         = %[3]q
+		cluster_type = "REPLICASET"
+
+		replication_specs {
+			region_configs {
+				electable_specs {
+					instance_size = "M10"
+					node_count    = 3
+				}
+				provider_name = "AWS"
+				priority      = 7
+				region_name   = "US_EAST_1"
+			}
+		}
+	}
+
+	resource "mongodbatlas_app_services_app" "test" {
+		project_id       = mongodbatlas_project.test.id
+		name             = %[4]q
+		location         = "US-VA"
+		deployment_model = "LOCAL"
+		environment      = %[5]q
+	}
+
+	resource "mongodbatlas_app_services_cluster_link" "test" {
+		project_id      = mongodbatlas_app_services_app.test.project_id
+		app_id          = mongodbatlas_app_services_app.test.app_id
+		name            = "mongodb-atlas"
+		cluster_name    = mongodbatlas_advanced_cluster.test.name
+		read_preference = %[6]q
+	}
+
+	resource "mongodbatlas_app_services_secret" "test" {
+		project_id = mongodbatlas_app_services_app.test.project_id
+		app_id     = mongodbatlas_app_services_app.test.app_id
+		name       = "apiKey"
+		value      = "s3cr3t"
+	}
+
+	resource "mongodbatlas_app_services_value" "test" {
+		project_id = mongodbatlas_app_services_app.test.project_id
+		app_id     = mongodbatlas_app_services_app.test.app_id
+		name       = "settings"
+		value      = jsonencode({ threshold = %[7]d })
+	}
+
+	resource "mongodbatlas_app_services_value" "api_key" {
+		project_id  = mongodbatlas_app_services_app.test.project_id
+		app_id      = mongodbatlas_app_services_app.test.app_id
+		name        = "apiKeyValue"
+		value       = jsonencode(mongodbatlas_app_services_secret.test.name)
+		from_secret = true
+	}
+
+	resource "mongodbatlas_app_services_function" "test" {
+		project_id = mongodbatlas_app_services_app.test.project_id
+		app_id     = mongodbatlas_app_services_app.test.app_id
+		name       = "onInsert"
+		source     = "exports = function(changeEvent) { return context.values.get(\"settings\").threshold; };"
+	}
+
+	resource "mongodbatlas_event_trigger" "test" {
+		project_id             = mongodbatlas_app_services_app.test.project_id
+		app_id                 = mongodbatlas_app_services_app.test.app_id
+		name                   = "onInsert"
+		type                   = "DATABASE"
+		function_id            = mongodbatlas_app_services_function.test.function_id
+		config_operation_types = ["INSERT"]
+		config_database        = "sample_airbnb"
+		config_collection      = "listingsAndReviews"
+		config_service_id      = mongodbatlas_app_services_cluster_link.test.service_id
+	}
+	`, orgID, projectName, clusterName, appName, environment, readPreference, threshold)
+}
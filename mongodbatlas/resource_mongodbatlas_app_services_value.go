@@ -0,0 +1,165 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+)
+
+const (
+	errorAppServicesValueCreate  = "error creating App Services value (%s): %s"
+	errorAppServicesValueRead    = "error reading App Services value (%s): %s"
+	errorAppServicesValueUpdate  = "error updating App Services value (%s): %s"
+	errorAppServicesValueDelete  = "error deleting App Services value (%s): %s"
+	errorAppServicesValueSetting = "error setting `%s` for App Services value (%s): %s"
+)
+
+func resourceMongoDBAtlasAppServicesValue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasAppServicesValueCreate,
+		ReadContext:   resourceMongoDBAtlasAppServicesValueRead,
+		UpdateContext: resourceMongoDBAtlasAppServicesValueUpdate,
+		DeleteContext: resourceMongoDBAtlasAppServicesValueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: appServicesImportState("value_id", "value"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: appServicesJSONDiff,
+			},
+			"private": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"from_secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"value_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasAppServicesValueCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	appID := d.Get("app_id").(string)
+	value, err := createAppServicesValue(ctx, conn, projectID, appID, newAppServicesValue(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesValueCreate, d.Get("name").(string), err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id": projectID,
+		"app_id":     appID,
+		"value_id":   value.ID,
+	}))
+
+	return resourceMongoDBAtlasAppServicesValueRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesValueRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	valueID := ids["value_id"]
+
+	value, err := getAppServicesValue(ctx, conn, ids["project_id"], ids["app_id"], valueID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorAppServicesValueRead, valueID, err))
+	}
+
+	values := map[string]any{
+		"project_id":  ids["project_id"],
+		"app_id":      ids["app_id"],
+		"value_id":    value.ID,
+		"name":        value.Name,
+		"value":       string(value.Value),
+		"private":     value.Private,
+		"from_secret": value.FromSecret,
+	}
+	for key, v := range values {
+		if err := d.Set(key, v); err != nil {
+			return diag.FromErr(fmt.Errorf(errorAppServicesValueSetting, key, valueID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasAppServicesValueUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	value := newAppServicesValue(d)
+	value.ID = ids["value_id"]
+	if err := updateAppServicesValue(ctx, conn, ids["project_id"], ids["app_id"], ids["value_id"], value); err != nil {
+		return diag.FromErr(fmt.Errorf(errorAppServicesValueUpdate, ids["value_id"], err))
+	}
+
+	return resourceMongoDBAtlasAppServicesValueRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasAppServicesValueDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn, err := meta.(*MongoDBClient).GetRealmClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := decodeStateID(d.Id())
+	if err := deleteAppServicesValue(ctx, conn, ids["project_id"], ids["app_id"], ids["value_id"]); err != nil && !apierror.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf(errorAppServicesValueDelete, ids["value_id"], err))
+	}
+
+	return nil
+}
+
+func newAppServicesValue(d *schema.ResourceData) *appServicesValue {
+	return &appServicesValue{
+		Name:       d.Get("name").(string),
+		Value:      json.RawMessage(d.Get("value").(string)),
+		Private:    d.Get("private").(bool),
+		FromSecret: d.Get("from_secret").(bool),
+	}
+}
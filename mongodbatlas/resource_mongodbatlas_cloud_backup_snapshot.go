@@ -5,15 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/spf13/cast"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -134,9 +134,9 @@ func resourceMongoDBAtlasCloudBackupSnapshotRead(ctx context.Context, d *schema.
 		ClusterName: ids["cluster_name"],
 	}
 
-	snapshot, resp, err := conn.CloudProviderSnapshots.GetOneCloudProviderSnapshot(context.Background(), requestParameters)
+	snapshot, _, err := conn.CloudProviderSnapshots.GetOneCloudProviderSnapshot(context.Background(), requestParameters)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -213,17 +213,18 @@ func resourceMongoDBAtlasCloudBackupSnapshotCreate(ctx context.Context, d *schem
 		RetentionInDays: d.Get("retention_in_days").(int),
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterRefreshFunc(ctx, d.Get("cluster_name").(string), d.Get("project_id").(string), conn),
-		Timeout:    10 * time.Minute,
-		MinTimeout: 10 * time.Second,
-		Delay:      3 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("pending changes of cluster %s", d.Get("cluster_name").(string)),
+		Pending:      []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING"},
+		Target:       []string{"IDLE"},
+		Refresh:      resourceClusterRefreshFunc(ctx, d.Get("cluster_name").(string), d.Get("project_id").(string), conn),
+		Timeout:      10 * time.Minute,
+		PollInterval: 10 * time.Second,
+		Delay:        3 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -235,17 +236,18 @@ func resourceMongoDBAtlasCloudBackupSnapshotCreate(ctx context.Context, d *schem
 
 	requestParameters.SnapshotID = snapshot.ID
 
-	stateConf = &retry.StateChangeConf{
-		Pending:    []string{"queued", "inProgress"},
-		Target:     []string{"completed", "failed"},
-		Refresh:    resourceCloudBackupSnapshotRefreshFunc(ctx, requestParameters, conn),
-		Timeout:    1 * time.Hour,
-		MinTimeout: 60 * time.Second,
-		Delay:      1 * time.Minute,
+	waiter = &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("snapshot of cluster %s", d.Get("cluster_name").(string)),
+		Pending:      []string{"queued", "inProgress"},
+		Target:       []string{"completed", "failed"},
+		Refresh:      resourceCloudBackupSnapshotRefreshFunc(ctx, requestParameters, conn),
+		Timeout:      1 * time.Hour,
+		PollInterval: 60 * time.Second,
+		Delay:        1 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -280,13 +282,13 @@ func resourceMongoDBAtlasCloudBackupSnapshotDelete(ctx context.Context, d *schem
 
 func resourceCloudBackupSnapshotRefreshFunc(ctx context.Context, requestParameters *matlas.SnapshotReqPathParameters, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		c, resp, err := client.CloudProviderSnapshots.GetOneCloudProviderSnapshot(ctx, requestParameters)
+		c, _, err := client.CloudProviderSnapshots.GetOneCloudProviderSnapshot(ctx, requestParameters)
 
 		switch {
+		case apierror.IsNotFound(err):
+			return "", "DELETED", nil
 		case err != nil:
 			return nil, "failed", err
-		case resp.StatusCode == http.StatusNotFound:
-			return "", "DELETED", nil
 		case c.Status == "failed":
 			return nil, c.Status, fmt.Errorf("error creating MongoDB snapshot(%s) status was: %s", requestParameters.SnapshotID, c.Status)
 		}
@@ -416,12 +418,9 @@ func flattenPolicyItems(items []matlas.PolicyItem) []map[string]any {
 }
 
 func flattenCloudProviderSnapshotBackupPolicy(ctx context.Context, d *schema.ResourceData, conn *matlas.Client, projectID, clusterName string) ([]map[string]any, error) {
-	backupPolicy, res, err := conn.CloudProviderSnapshotBackupPolicies.Get(ctx, projectID, clusterName)
+	backupPolicy, _, err := conn.CloudProviderSnapshotBackupPolicies.Get(ctx, projectID, clusterName)
 	if err != nil {
-		if res.StatusCode == http.StatusNotFound ||
-			strings.Contains(err.Error(), "BACKUP_CONFIG_NOT_FOUND") ||
-			strings.Contains(err.Error(), "Not Found") ||
-			strings.Contains(err.Error(), "404") {
+		if apierror.IsNotFound(err) {
 			return []map[string]any{}, nil
 		}
 
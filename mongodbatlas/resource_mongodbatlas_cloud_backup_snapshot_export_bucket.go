@@ -6,12 +6,13 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -100,7 +101,7 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportBucketRead(ctx context.Context
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
+		reset := apierror.IsNotFound(err) && !d.IsNewResource()
 
 		if reset {
 			d.SetId("")
@@ -136,16 +137,17 @@ func resourceMongoDBAtlasCloudBackupSnapshotExportBucketDelete(ctx context.Conte
 	projectID := ids["project_id"]
 	bucketID := ids["id"]
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"PENDING", "REPEATING"},
-		Target:     []string{"DELETED"},
-		Refresh:    resourceCloudBackupSnapshotExportBucketRefreshFunc(ctx, conn, projectID, bucketID),
-		Timeout:    1 * time.Hour,
-		MinTimeout: 5 * time.Second,
-		Delay:      3 * time.Second,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of snapshot export bucket %s", bucketID),
+		Pending:      []string{"PENDING", "REPEATING"},
+		Target:       []string{"DELETED"},
+		Refresh:      resourceCloudBackupSnapshotExportBucketRefreshFunc(ctx, conn, projectID, bucketID),
+		Timeout:      1 * time.Hour,
+		PollInterval: 5 * time.Second,
+		Delay:        3 * time.Second,
 	}
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("error deleting snapshot export bucket %s %s", projectID, err)
 	}
@@ -197,10 +199,10 @@ func splitCloudBackupSnapshotExportBucketImportID(id string) (projectID, bucketI
 
 func resourceCloudBackupSnapshotExportBucketRefreshFunc(ctx context.Context, client *matlas.Client, projectID, exportBucketID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		clusters, resp, err := client.Clusters.List(ctx, projectID, nil)
+		clusters, _, err := client.Clusters.List(ctx, projectID, nil)
 		if err != nil {
 			// For our purposes, no clusters is equivalent to all changes having been APPLIED
-			if resp != nil && resp.StatusCode == http.StatusNotFound {
+			if apierror.IsNotFound(err) {
 				return "", "APPLIED", nil
 			}
 			return nil, "REPEATING", err
@@ -221,22 +223,17 @@ func resourceCloudBackupSnapshotExportBucketRefreshFunc(ctx context.Context, cli
 						return clusters, "PENDING", nil
 					}
 
-					s, resp, err := client.Clusters.Status(ctx, projectID, clusters[i].Name)
+					s, _, err := client.Clusters.Status(ctx, projectID, clusters[i].Name)
 
-					if err != nil && strings.Contains(err.Error(), "reset by peer") {
+					if apierror.IsTransientNetworkError(err) {
 						return nil, "REPEATING", nil
 					}
 
 					if err != nil {
-						if resp != nil && resp.StatusCode == http.StatusNotFound {
+						if apierror.IsNotFound(err) {
 							return "", "DELETED", nil
 						}
-
-						if resp.StatusCode == 404 {
-							// The cluster no longer exists, consider this equivalent to status APPLIED
-							continue
-						}
-						if resp.StatusCode == 503 {
+						if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 							return "", "PENDING", nil
 						}
 						return nil, "REPEATING", err
@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -97,7 +97,7 @@ func resourceMongoDBAtlasCloudProviderAccessAuthorizationRead(ctx context.Contex
 
 	targetRole, err := FindRole(ctx, conn, projectID, roleID)
 	if err != nil {
-		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
+		reset := apierror.IsNotFound(err) && !d.IsNewResource()
 		if reset {
 			d.SetId("")
 			return nil
@@ -283,7 +283,7 @@ func authorizeRole(ctx context.Context, client *matlas.Client, d *schema.Resourc
 
 	for i := 0; i < 3; i++ {
 		role, _, err = client.CloudProviderAccess.AuthorizeRole(ctx, projectID, roleID, req)
-		if err != nil && strings.Contains(err.Error(), "CANNOT_ASSUME_ROLE") { // aws takes time to update , in case of single path
+		if apierror.HasErrorCode(err, apierror.CodeCannotAssumeRole) { // aws takes time to update , in case of single path
 			log.Printf("warning issue performing authorize: %s \n", err.Error())
 			log.Println("retrying")
 			time.Sleep(10 * time.Second)
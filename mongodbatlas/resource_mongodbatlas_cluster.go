@@ -12,12 +12,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mwielbut/pointy"
 	"github.com/spf13/cast"
 )
@@ -325,6 +328,11 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 					},
 				},
 			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"tags":                   &tagsSchema,
 			"snapshot_backup_policy": computedCloudProviderSnapshotBackupPolicySchema(),
 			"termination_protection_enabled": {
@@ -343,7 +351,7 @@ func resourceMongoDBAtlasCluster() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"LTS", "CONTINUOUS"}, false),
 			},
 		},
-		CustomizeDiff: resourceClusterCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(resourceClusterCustomizeDiff, resourceClusterCatalogCustomizeDiff, customizeDiffDefaultLabels),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Hour),
 			Update: schema.DefaultTimeout(3 * time.Hour),
@@ -479,11 +487,11 @@ func resourceMongoDBAtlasClusterCreate(ctx context.Context, d *schema.ResourceDa
 		clusterRequest.BiConnector = biConnector
 	}
 
-	if containsLabelOrKey(expandLabelSliceFromSetSchema(d), defaultLabel) {
-		return diag.FromErr(fmt.Errorf("you should not set `Infrastructure Tool` label, it is used for internal purposes"))
+	labels, err := clusterLabelsRequest(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-
-	clusterRequest.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
+	clusterRequest.Labels = labels
 
 	if _, ok := d.GetOk("tags"); ok {
 		tagsSlice := expandTagSliceFromSetSchema(d)
@@ -522,17 +530,18 @@ func resourceMongoDBAtlasClusterCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
-		Timeout:    timeout,
-		MinTimeout: 1 * time.Minute,
-		Delay:      3 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of cluster %s", d.Get("name").(string)),
+		Pending:      []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:       []string{"IDLE"},
+		Refresh:      resourceClusterRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+		Delay:        3 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterCreate, err))
 	}
@@ -583,9 +592,9 @@ func resourceMongoDBAtlasClusterRead(ctx context.Context, d *schema.ResourceData
 	clusterName := ids["cluster_name"]
 	providerName := ids["provider_name"]
 
-	cluster, resp, err := conn.Clusters.Get(ctx, projectID, clusterName)
+	cluster, _, err := conn.Clusters.Get(ctx, projectID, clusterName)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -704,7 +713,7 @@ func resourceMongoDBAtlasClusterRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(fmt.Errorf(errorClusterSetting, "replication_factor", clusterName, err))
 	}
 
-	if err := d.Set("labels", flattenLabels(removeLabel(cluster.Labels, defaultLabel))); err != nil {
+	if err := setClusterLabels(d, meta, cluster.Labels); err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterSetting, "labels", clusterName, err))
 	}
 
@@ -860,12 +869,12 @@ func resourceMongoDBAtlasClusterUpdate(ctx context.Context, d *schema.ResourceDa
 		cluster.TerminationProtectionEnabled = pointy.Bool(d.Get("termination_protection_enabled").(bool))
 	}
 
-	if d.HasChange("labels") {
-		if containsLabelOrKey(expandLabelSliceFromSetSchema(d), defaultLabel) {
-			return diag.FromErr(fmt.Errorf("you should not set `Infrastructure Tool` label, it is used for internal purposes"))
+	if d.HasChanges("labels", "effective_labels") {
+		labels, err := clusterLabelsRequest(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
 		}
-
-		cluster.Labels = append(expandLabelSliceFromSetSchema(d), defaultLabel)
+		cluster.Labels = labels
 	}
 
 	if d.HasChange("tags") {
@@ -919,7 +928,7 @@ func resourceMongoDBAtlasClusterUpdate(ctx context.Context, d *schema.ResourceDa
 		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 			_, _, err := updateCluster(ctx, conn, cluster, projectID, clusterName, timeout)
 
-			if didErrOnPausedCluster(err) {
+			if apierror.IsClusterPaused(err) {
 				clusterRequest := &matlas.Cluster{
 					Paused: pointy.Bool(false),
 				}
@@ -953,16 +962,6 @@ func resourceMongoDBAtlasClusterUpdate(ctx context.Context, d *schema.ResourceDa
 	return resourceMongoDBAtlasClusterRead(ctx, d, meta)
 }
 
-func didErrOnPausedCluster(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	var target *matlas.ErrorResponse
-
-	return errors.As(err, &target) && target.ErrorCode == "CANNOT_UPDATE_PAUSED_CLUSTER"
-}
-
 func resourceMongoDBAtlasClusterDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -984,17 +983,18 @@ func resourceMongoDBAtlasClusterDelete(ctx context.Context, d *schema.ResourceDa
 
 	log.Println("[INFO] Waiting for MongoDB Cluster to be destroyed")
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
-		Target:     []string{"DELETED"},
-		Refresh:    resourceClusterRefreshFunc(ctx, clusterName, projectID, conn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute, // Wait 30 secs before starting
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of cluster %s", clusterName),
+		Pending:      []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      resourceClusterRefreshFunc(ctx, clusterName, projectID, conn),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute, // Wait 30 secs before starting
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterDelete, clusterName, err))
 	}
@@ -1325,41 +1325,47 @@ func flattenRegionsConfig(regionsConfig map[string]matlas.RegionsConfig) []map[s
 }
 
 func expandProcessArgs(d *schema.ResourceData, p map[string]any) *matlas.ProcessArgs {
+	return expandProcessArgsWithPrefix(d, "advanced_configuration.0.", p)
+}
+
+// expandProcessArgsWithPrefix returns the process arguments in p that are set in d under the given prefix, so both
+// the advanced_configuration block of the cluster resources and mongodbatlas_cluster_advanced_configuration use it.
+func expandProcessArgsWithPrefix(d *schema.ResourceData, prefix string, p map[string]any) *matlas.ProcessArgs {
 	res := &matlas.ProcessArgs{}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.default_read_concern"); ok {
+	if _, ok := d.GetOkExists(prefix + "default_read_concern"); ok {
 		res.DefaultReadConcern = cast.ToString(p["default_read_concern"])
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.default_write_concern"); ok {
+	if _, ok := d.GetOkExists(prefix + "default_write_concern"); ok {
 		res.DefaultWriteConcern = cast.ToString(p["default_write_concern"])
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.fail_index_key_too_long"); ok {
+	if _, ok := d.GetOkExists(prefix + "fail_index_key_too_long"); ok {
 		res.FailIndexKeyTooLong = pointy.Bool(cast.ToBool(p["fail_index_key_too_long"]))
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.javascript_enabled"); ok {
+	if _, ok := d.GetOkExists(prefix + "javascript_enabled"); ok {
 		res.JavascriptEnabled = pointy.Bool(cast.ToBool(p["javascript_enabled"]))
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.minimum_enabled_tls_protocol"); ok {
+	if _, ok := d.GetOkExists(prefix + "minimum_enabled_tls_protocol"); ok {
 		res.MinimumEnabledTLSProtocol = cast.ToString(p["minimum_enabled_tls_protocol"])
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.no_table_scan"); ok {
+	if _, ok := d.GetOkExists(prefix + "no_table_scan"); ok {
 		res.NoTableScan = pointy.Bool(cast.ToBool(p["no_table_scan"]))
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.sample_size_bi_connector"); ok {
+	if _, ok := d.GetOkExists(prefix + "sample_size_bi_connector"); ok {
 		res.SampleSizeBIConnector = pointy.Int64(cast.ToInt64(p["sample_size_bi_connector"]))
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.sample_refresh_interval_bi_connector"); ok {
+	if _, ok := d.GetOkExists(prefix + "sample_refresh_interval_bi_connector"); ok {
 		res.SampleRefreshIntervalBIConnector = pointy.Int64(cast.ToInt64(p["sample_refresh_interval_bi_connector"]))
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.oplog_size_mb"); ok {
+	if _, ok := d.GetOkExists(prefix + "oplog_size_mb"); ok {
 		if sizeMB := cast.ToInt64(p["oplog_size_mb"]); sizeMB != 0 {
 			res.OplogSizeMB = pointy.Int64(cast.ToInt64(p["oplog_size_mb"]))
 		} else {
@@ -1367,7 +1373,7 @@ func expandProcessArgs(d *schema.ResourceData, p map[string]any) *matlas.Process
 		}
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.oplog_min_retention_hours"); ok {
+	if _, ok := d.GetOkExists(prefix + "oplog_min_retention_hours"); ok {
 		if minRetentionHours := cast.ToFloat64(p["oplog_min_retention_hours"]); minRetentionHours >= 0 {
 			res.OplogMinRetentionHours = pointy.Float64(cast.ToFloat64(p["oplog_min_retention_hours"]))
 		} else {
@@ -1375,7 +1381,7 @@ func expandProcessArgs(d *schema.ResourceData, p map[string]any) *matlas.Process
 		}
 	}
 
-	if _, ok := d.GetOkExists("advanced_configuration.0.transaction_lifetime_limit_seconds"); ok {
+	if _, ok := d.GetOkExists(prefix + "transaction_lifetime_limit_seconds"); ok {
 		if transactionLifetimeLimitSeconds := cast.ToInt64(p["transaction_lifetime_limit_seconds"]); transactionLifetimeLimitSeconds > 0 {
 			res.TransactionLifetimeLimitSeconds = pointy.Int64(cast.ToInt64(p["transaction_lifetime_limit_seconds"]))
 		} else {
@@ -1408,17 +1414,17 @@ func resourceClusterRefreshFunc(ctx context.Context, name, projectID string, cli
 	return func() (any, string, error) {
 		c, resp, err := client.Clusters.Get(ctx, projectID, name)
 
-		if err != nil && strings.Contains(err.Error(), "reset by peer") {
+		if apierror.IsTransientNetworkError(err) {
 			return nil, "REPEATING", nil
 		}
 
 		if err != nil && c == nil && resp == nil {
 			return nil, "", err
 		} else if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
@@ -1448,6 +1454,30 @@ func resourceClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m
 	return err
 }
 
+// resourceClusterCatalogCustomizeDiff rejects the regions and instance sizes that the cloud provider doesn't offer.
+func resourceClusterCatalogCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	providerName := ctyString(rawConfigAttr(d, "provider_name"))
+	if providerName == "" {
+		return nil
+	}
+	regionProvider := catalogRegionProvider(providerName, ctyString(rawConfigAttr(d, "backing_provider_name")))
+
+	errs := []error{
+		validateCatalogInstanceSize("provider_instance_size_name", providerName, rawConfigAttr(d, "provider_instance_size_name")),
+		validateCatalogRegion("provider_region_name", regionProvider, rawConfigAttr(d, "provider_region_name")),
+	}
+	for _, attr := range []string{"provider_auto_scaling_compute_min_instance_size", "provider_auto_scaling_compute_max_instance_size"} {
+		errs = append(errs, validateCatalogAutoScalingInstanceSize(attr, providerName, rawConfigAttr(d, attr)))
+	}
+	for i, spec := range ctyElements(rawConfigAttr(d, "replication_specs")) {
+		for j, regionConfig := range ctyElements(ctyAttr(spec, "regions_config")) {
+			attr := fmt.Sprintf("replication_specs.%d.regions_config.%d.region_name", i, j)
+			errs = append(errs, validateCatalogRegion(attr, regionProvider, ctyAttr(regionConfig, "region_name")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func formatMongoDBMajorVersion(val any) string {
 	if strings.Contains(val.(string), ".") {
 		return val.(string)
@@ -1684,17 +1714,18 @@ func updateCluster(ctx context.Context, conn *matlas.Client, request *matlas.Clu
 		return nil, nil, err
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterRefreshFunc(ctx, name, projectID, conn),
-		Timeout:    timeout,
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("update of cluster %s", name),
+		Pending:      []string{"CREATING", "UPDATING", "REPAIRING"},
+		Target:       []string{"IDLE"},
+		Refresh:      resourceClusterRefreshFunc(ctx, name, projectID, conn),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1710,17 +1741,18 @@ func upgradeCluster(ctx context.Context, conn *matlas.Client, request *matlas.Cl
 		return nil, nil, err
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceClusterRefreshFunc(ctx, name, projectID, conn),
-		Timeout:    timeout,
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("upgrade of cluster %s", name),
+		Pending:      []string{"CREATING", "UPDATING", "REPAIRING"},
+		Target:       []string{"IDLE"},
+		Refresh:      resourceClusterRefreshFunc(ctx, name, projectID, conn),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,136 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	errorClusterAdvancedConfigurationSetting = "error setting `%s` for advanced configuration of cluster (%s): %s"
+)
+
+// resourceMongoDBAtlasClusterAdvancedConfiguration manages the process arguments of an existing cluster, so they can be
+// owned separately from the cluster topology. Process arguments can't be removed, so destroying the resource only
+// removes it from the state.
+func resourceMongoDBAtlasClusterAdvancedConfiguration() *schema.Resource {
+	processArgsSchema := clusterAdvancedConfigurationSchema().Elem.(*schema.Resource).Schema
+	processArgsSchema["oplog_size_mb"].ValidateFunc = validation.IntAtLeast(1)
+	processArgsSchema["project_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	processArgsSchema["cluster_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasClusterAdvancedConfigurationCreate,
+		ReadContext:   resourceMongoDBAtlasClusterAdvancedConfigurationRead,
+		UpdateContext: resourceMongoDBAtlasClusterAdvancedConfigurationUpdate,
+		DeleteContext: resourceMongoDBAtlasClusterAdvancedConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMongoDBAtlasClusterAdvancedConfigurationImportState,
+		},
+		Schema: processArgsSchema,
+	}
+}
+
+func resourceMongoDBAtlasClusterAdvancedConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	projectID := d.Get("project_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	if err := updateClusterAdvancedConfiguration(ctx, meta, projectID, clusterName, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+	}))
+
+	return resourceMongoDBAtlasClusterAdvancedConfigurationRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasClusterAdvancedConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	projectID := ids["project_id"]
+	clusterName := ids["cluster_name"]
+
+	processArgs, _, err := conn.Clusters.GetProcessArgs(ctx, projectID, clusterName)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorAdvancedConfRead, clusterName, err))
+	}
+
+	values := flattenProcessArgs(processArgs)[0].(map[string]any)
+	values["project_id"] = projectID
+	values["cluster_name"] = clusterName
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorClusterAdvancedConfigurationSetting, key, clusterName, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasClusterAdvancedConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	ids := decodeStateID(d.Id())
+	if err := updateClusterAdvancedConfiguration(ctx, meta, ids["project_id"], ids["cluster_name"], d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMongoDBAtlasClusterAdvancedConfigurationRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasClusterAdvancedConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[INFO] advanced configuration of cluster (%s) removed from the state, its values are left unchanged in Atlas", decodeStateID(d.Id())["cluster_name"])
+	d.SetId("")
+	return nil
+}
+
+func resourceMongoDBAtlasClusterAdvancedConfigurationImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	projectID, clusterName, err := splitSClusterImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"project_id":   *projectID,
+		"cluster_name": *clusterName,
+	}))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateClusterAdvancedConfiguration(ctx context.Context, meta any, projectID, clusterName string, d *schema.ResourceData) error {
+	conn := meta.(*MongoDBClient).Atlas
+	if _, _, err := conn.Clusters.UpdateProcessArgs(ctx, projectID, clusterName, newClusterAdvancedConfiguration(d)); err != nil {
+		return fmt.Errorf(errorAdvancedConfUpdate, clusterName, err)
+	}
+	return nil
+}
+
+// newClusterAdvancedConfiguration returns the configured process arguments, arguments that aren't set are left out
+// of the request so they keep the value they have in Atlas.
+func newClusterAdvancedConfiguration(d *schema.ResourceData) *matlas.ProcessArgs {
+	values := make(map[string]any)
+	for key := range clusterAdvancedConfigurationSchema().Elem.(*schema.Resource).Schema {
+		values[key] = d.Get(key)
+	}
+	return expandProcessArgsWithPrefix(d, "", values)
+}
@@ -0,0 +1,155 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mwielbut/pointy"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestAccClusterRSClusterAdvancedConfiguration_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_cluster_advanced_configuration.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName  = acctest.RandomWithPrefix("test-acc-process-args")
+		clusterName  = acctest.RandomWithPrefix("test-acc-process-args")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasAdvancedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasClusterAdvancedConfigurationConfig(orgID, projectName, clusterName, "TLS1_1", true, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasClusterAdvancedConfigurationAttributes(resourceName, "TLS1_1", 1000),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", clusterName),
+					resource.TestCheckResourceAttr(resourceName, "minimum_enabled_tls_protocol", "TLS1_1"),
+					resource.TestCheckResourceAttr(resourceName, "javascript_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "oplog_size_mb", "1000"),
+					resource.TestCheckResourceAttr("mongodbatlas_advanced_cluster.test", "advanced_configuration.0.oplog_size_mb", "1000"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasClusterAdvancedConfigurationConfig(orgID, projectName, clusterName, "TLS1_2", false, 2000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasClusterAdvancedConfigurationAttributes(resourceName, "TLS1_2", 2000),
+					resource.TestCheckResourceAttr(resourceName, "minimum_enabled_tls_protocol", "TLS1_2"),
+					resource.TestCheckResourceAttr(resourceName, "javascript_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "oplog_size_mb", "2000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccCheckMongoDBAtlasClusterAdvancedConfigurationImportStateIDFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestClusterAdvancedConfigurationRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceMongoDBAtlasClusterAdvancedConfiguration().Schema, map[string]any{
+		"project_id":                   "5d0f1f74cf09a29120e123cd",
+		"cluster_name":                 "Cluster0",
+		"minimum_enabled_tls_protocol": "TLS1_2",
+		"javascript_enabled":           false,
+		"oplog_size_mb":                2000,
+	})
+
+	processArgs := newClusterAdvancedConfiguration(d)
+	if processArgs.MinimumEnabledTLSProtocol != "TLS1_2" || processArgs.JavascriptEnabled == nil || *processArgs.JavascriptEnabled ||
+		processArgs.OplogSizeMB == nil || *processArgs.OplogSizeMB != 2000 {
+		t.Errorf("unexpected process arguments %+v", processArgs)
+	}
+	if processArgs.DefaultReadConcern != "" || processArgs.NoTableScan != nil || processArgs.TransactionLifetimeLimitSeconds != nil {
+		t.Errorf("expected the arguments that aren't configured to be left out, got %+v", processArgs)
+	}
+
+	values := flattenProcessArgs(&matlas.ProcessArgs{OplogSizeMB: pointy.Int64(4000), OplogMinRetentionHours: pointy.Float64(24)})[0].(map[string]any)
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			t.Errorf("unexpected error setting %s: %s", key, err)
+		}
+	}
+	if d.Get("oplog_size_mb").(int) != 4000 || d.Get("oplog_min_retention_hours").(int) != 24 {
+		t.Errorf("unexpected state %v", d.State())
+	}
+}
+
+func testAccCheckMongoDBAtlasClusterAdvancedConfigurationAttributes(resourceName, tlsProtocol string, oplogSizeMB int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+		processArgs, _, err := conn.Clusters.GetProcessArgs(context.Background(), rs.Primary.Attributes["project_id"], rs.Primary.Attributes["cluster_name"])
+		if err != nil {
+			return fmt.Errorf(errorAdvancedConfRead, rs.Primary.Attributes["cluster_name"], err)
+		}
+		if processArgs.MinimumEnabledTLSProtocol != tlsProtocol || processArgs.OplogSizeMB == nil || *processArgs.OplogSizeMB != oplogSizeMB {
+			return fmt.Errorf("unexpected process arguments %+v", processArgs)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMongoDBAtlasClusterAdvancedConfigurationImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["cluster_name"]), nil
+	}
+}
+
+func testAccMongoDBAtlasClusterAdvancedConfigurationConfig(orgID, projectName, clusterName, tlsProtocol string, javascriptEnabled bool, oplogSizeMB int) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_project" "test" {
+		name   = %[2]q
+		org_id = %[1]q
+	}
+
+	resource "mongodbatlas_advanced_cluster" "test" {
+		project_id   = mongodbatlas_project.test.id
+		name         = %[3]q
+		cluster_type = "REPLICASET"
+
+		replication_specs {
+			region_configs {
+				electable_specs {
+					instance_size = "M10"
+					node_count    = 3
+				}
+				provider_name = "AWS"
+				priority      = 7
+				region_name   = "US_EAST_1"
+			}
+		}
+
+		lifecycle {
+			ignore_changes = [advanced_configuration]
+		}
+	}
+
+	resource "mongodbatlas_cluster_advanced_configuration" "test" {
+		project_id                   = mongodbatlas_advanced_cluster.test.project_id
+		cluster_name                 = mongodbatlas_advanced_cluster.test.name
+		minimum_enabled_tls_protocol = %[4]q
+		javascript_enabled           = %[5]t
+		oplog_size_mb                = %[6]d
+	}
+	`, orgID, projectName, clusterName, tlsProtocol, javascriptEnabled, oplogSizeMB)
+}
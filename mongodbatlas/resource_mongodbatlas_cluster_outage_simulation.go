@@ -4,12 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mwielbut/pointy"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -93,16 +94,17 @@ func resourceMongoDBClusterOutageSimulationCreate(ctx context.Context, d *schema
 	}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"START_REQUESTED", "STARTING"},
-		Target:     []string{"SIMULATING"},
-		Refresh:    resourceClusterOutageSimulationRefreshFunc(ctx, clusterName, projectID, conn),
-		Timeout:    timeout,
-		MinTimeout: 1 * time.Minute,
-		Delay:      3 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("start of the outage simulation of cluster %s", clusterName),
+		Pending:      []string{"START_REQUESTED", "STARTING"},
+		Target:       []string{"SIMULATING"},
+		Refresh:      resourceClusterOutageSimulationRefreshFunc(ctx, clusterName, projectID, conn),
+		Timeout:      timeout,
+		PollInterval: 1 * time.Minute,
+		Delay:        3 * time.Minute,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterOutageSimulationCreate, projectID, clusterName, err))
 	}
@@ -136,10 +138,10 @@ func resourceMongoDBAClusterOutageSimulationRead(ctx context.Context, d *schema.
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
-	outageSimulation, resp, err := conn.ClusterOutageSimulation.GetOutageSimulation(ctx, projectID, clusterName)
+	outageSimulation, _, err := conn.ClusterOutageSimulation.GetOutageSimulation(ctx, projectID, clusterName)
 
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -172,16 +174,17 @@ func resourceMongoDBAtlasClusterOutageSimulationDelete(ctx context.Context, d *s
 
 	log.Println("[INFO] Waiting for MongoDB Cluster Outage Simulation to end")
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"RECOVERY_REQUESTED", "RECOVERING", "COMPLETE"},
-		Target:     []string{"DELETED"},
-		Refresh:    resourceClusterOutageSimulationRefreshFunc(ctx, clusterName, projectID, conn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("end of the outage simulation of cluster %s", clusterName),
+		Pending:      []string{"RECOVERY_REQUESTED", "RECOVERING", "COMPLETE"},
+		Target:       []string{"DELETED"},
+		Refresh:      resourceClusterOutageSimulationRefreshFunc(ctx, clusterName, projectID, conn),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorClusterOutageSimulationDelete, projectID, clusterName, err))
 	}
@@ -195,10 +198,10 @@ func resourceMongoDBClusterOutageSimulationUpdate(ctx context.Context, d *schema
 
 func resourceClusterOutageSimulationRefreshFunc(ctx context.Context, clusterName, projectID string, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		outageSimulation, resp, err := client.ClusterOutageSimulation.GetOutageSimulation(ctx, projectID, clusterName)
+		outageSimulation, _, err := client.ClusterOutageSimulation.GetOutageSimulation(ctx, projectID, clusterName)
 
 		if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
 			return nil, "", err
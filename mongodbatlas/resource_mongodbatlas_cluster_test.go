@@ -8,7 +8,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/mwielbut/pointy"
@@ -16,15 +15,16 @@ import (
 )
 
 func TestAccClusterRSCluster_basicAWS_simple(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -67,15 +67,16 @@ func TestAccClusterRSCluster_basicAWS_simple(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicAWS_instanceScale(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -107,17 +108,18 @@ func TestAccClusterRSCluster_basicAWS_instanceScale(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basic_Partial_AdvancedConf(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster                matlas.Cluster
 		resourceName           = "mongodbatlas_cluster.advance_conf"
 		dataSourceName         = "data.mongodbatlas_cluster.test"
 		dataSourceClustersName = "data.mongodbatlas_clusters.test"
 		orgID                  = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName            = acctest.RandomWithPrefix("test-acc")
-		name                   = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName            = rnd.RandomWithPrefix("test-acc")
+		name                   = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -180,15 +182,16 @@ func TestAccClusterRSCluster_basic_Partial_AdvancedConf(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basic_DefaultWriteRead_AdvancedConf(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.advance_conf"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -241,14 +244,15 @@ func TestAccClusterRSCluster_basic_DefaultWriteRead_AdvancedConf(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_emptyAdvancedConf(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_cluster.advance_conf"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -285,15 +289,16 @@ func TestAccClusterRSCluster_emptyAdvancedConf(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicAdvancedConf(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.advance_conf"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -351,15 +356,16 @@ func TestAccClusterRSCluster_basicAdvancedConf(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicAzure(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.basic_azure"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -393,14 +399,15 @@ func TestAccClusterRSCluster_basicAzure(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_AzureUpdateToNVME(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.basic_azure"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -436,15 +443,16 @@ func TestAccClusterRSCluster_AzureUpdateToNVME(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicGCP(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.basic_gcp"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -480,15 +488,16 @@ func TestAccClusterRSCluster_basicGCP(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_WithBiConnectorGCP(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.basic_gcp"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -526,12 +535,13 @@ func TestAccClusterRSCluster_WithBiConnectorGCP(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_MultiRegion(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.multi_region"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-multi-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-multi-%s", rnd.RandString(10))
 	)
 
 	createRegionsConfig := `regions_config {
@@ -560,7 +570,7 @@ func TestAccClusterRSCluster_MultiRegion(t *testing.T) {
 					read_only_nodes = 0
 				}`
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -602,16 +612,17 @@ func TestAccClusterRSCluster_MultiRegion(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_Global(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster        matlas.Cluster
 		resourceSuffix = "global_cluster"
 		resourceName   = fmt.Sprintf("mongodbatlas_cluster.%s", resourceSuffix)
 		orgID          = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName    = acctest.RandomWithPrefix("test-acc")
-		name           = fmt.Sprintf("test-acc-global-%s", acctest.RandString(10))
+		projectName    = rnd.RandomWithPrefix("test-acc")
+		name           = fmt.Sprintf("test-acc-global-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -639,15 +650,16 @@ func TestAccClusterRSCluster_Global(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_AWSWithLabels(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.aws_with_labels"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("testAcc-%s-%s-%s", "AWS", "M10", acctest.RandString(1))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("testAcc-%s-%s-%s", "AWS", "M10", rnd.RandString(1))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -719,17 +731,18 @@ func TestAccClusterRSCluster_AWSWithLabels(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_WithTags(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster                matlas.Cluster
 		resourceName           = "mongodbatlas_cluster.test"
 		dataSourceName         = "data.mongodbatlas_cluster.test"
 		dataSourceClustersName = "data.mongodbatlas_clusters.test"
 		orgID                  = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName            = acctest.RandomWithPrefix("test-acc")
-		name                   = fmt.Sprintf("testAcc-%s-%s-%s", "AWS", "M10", acctest.RandString(1))
+		projectName            = rnd.RandomWithPrefix("test-acc")
+		name                   = fmt.Sprintf("testAcc-%s-%s-%s", "AWS", "M10", rnd.RandString(1))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -806,6 +819,7 @@ func TestAccClusterRSCluster_WithTags(t *testing.T) {
 
 func TestAccClusterRSCluster_withPrivateEndpointLink(t *testing.T) {
 	SkipTestExtCred(t)
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.with_endpoint_link"
@@ -820,7 +834,7 @@ func TestAccClusterRSCluster_withPrivateEndpointLink(t *testing.T) {
 		vpcID           = os.Getenv("AWS_VPC_ID")
 		subnetID        = os.Getenv("AWS_SUBNET_ID")
 		securityGroupID = os.Getenv("AWS_SECURITY_GROUP_ID")
-		clusterName     = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		clusterName     = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
 	resource.Test(t, resource.TestCase{
@@ -842,6 +856,7 @@ func TestAccClusterRSCluster_withPrivateEndpointLink(t *testing.T) {
 
 func TestAccClusterRSCluster_withAzureNetworkPeering(t *testing.T) {
 	SkipTestExtCred(t)
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.with_azure_peering"
@@ -855,7 +870,7 @@ func TestAccClusterRSCluster_withAzureNetworkPeering(t *testing.T) {
 		region            = os.Getenv("AZURE_REGION")
 
 		atlasCidrBlock = "192.168.208.0/21"
-		clusterName    = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		clusterName    = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
 	resource.Test(t, resource.TestCase{
@@ -877,6 +892,7 @@ func TestAccClusterRSCluster_withAzureNetworkPeering(t *testing.T) {
 
 func TestAccClusterRSCluster_withGCPNetworkPeering(t *testing.T) {
 	SkipTestExtCred(t)
+	rnd := testAccCassette(t)
 	var (
 		cluster          matlas.Cluster
 		resourceName     = "mongodbatlas_cluster.test"
@@ -884,12 +900,12 @@ func TestAccClusterRSCluster_withGCPNetworkPeering(t *testing.T) {
 		gcpRegion        = os.Getenv("GCP_REGION_NAME")
 		gcpProjectID     = os.Getenv("GCP_PROJECT_ID")
 		providerName     = "GCP"
-		gcpPeeringName   = fmt.Sprintf("test-acc-%s", acctest.RandString(3))
-		clusterName      = fmt.Sprintf("test-acc-%s", acctest.RandString(3))
+		gcpPeeringName   = fmt.Sprintf("test-acc-%s", rnd.RandString(3))
+		clusterName      = fmt.Sprintf("test-acc-%s", rnd.RandString(3))
 		gcpClusterRegion = os.Getenv("GCP_CLUSTER_REGION_NAME")
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t); testCheckPeeringEnvGCP(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -913,10 +929,11 @@ func TestAccClusterRSCluster_withGCPNetworkPeering(t *testing.T) {
 
 func TestAccClusterRSCluster_withAzureAndContainerID(t *testing.T) {
 	SkipTestExtCred(t)
+	rnd := testAccCassette(t)
 	var (
 		resourceName      = "mongodbatlas_cluster.test"
 		projectID         = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
-		clusterName       = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		clusterName       = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 		providerName      = "AZURE"
 		region            = os.Getenv("AZURE_REGION")
 		directoryID       = os.Getenv("AZURE_DIRECTORY_ID")
@@ -944,6 +961,7 @@ func TestAccClusterRSCluster_withAzureAndContainerID(t *testing.T) {
 
 func TestAccClusterRSCluster_withAWSAndContainerID(t *testing.T) {
 	SkipTestExtCred(t)
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_cluster.test"
 
@@ -951,7 +969,7 @@ func TestAccClusterRSCluster_withAWSAndContainerID(t *testing.T) {
 		awsSecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
 
 		projectID    = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
-		clusterName  = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		clusterName  = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 		providerName = "AWS"
 		awsRegion    = os.Getenv("AWS_REGION")
 		vpcCIDRBlock = os.Getenv("AWS_VPC_CIDR_BLOCK")
@@ -977,18 +995,19 @@ func TestAccClusterRSCluster_withAWSAndContainerID(t *testing.T) {
 
 func TestAccClusterRSCluster_withGCPAndContainerID(t *testing.T) {
 	SkipTestExtCred(t)
+	rnd := testAccCassette(t)
 	var (
 		resourceName     = "mongodbatlas_cluster.test"
 		gcpProjectID     = os.Getenv("GCP_PROJECT_ID")
 		gcpRegion        = os.Getenv("GCP_REGION_NAME")
 		projectID        = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
-		clusterName      = fmt.Sprintf("test-acc-%s", acctest.RandString(3))
+		clusterName      = fmt.Sprintf("test-acc-%s", rnd.RandString(3))
 		providerName     = "GCP"
 		gcpClusterRegion = os.Getenv("GCP_CLUSTER_REGION_NAME")
-		gcpPeeringName   = fmt.Sprintf("test-acc-%s", acctest.RandString(3))
+		gcpPeeringName   = fmt.Sprintf("test-acc-%s", rnd.RandString(3))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t); testCheckPeeringEnvGCP(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1009,14 +1028,15 @@ func TestAccClusterRSCluster_withGCPAndContainerID(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_withAutoScalingAWS(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster                matlas.Cluster
 		resourceName           = "mongodbatlas_cluster.test"
 		dataSourceName         = "data.mongodbatlas_cluster.test"
 		dataSourceClustersName = "data.mongodbatlas_clusters.test"
 		orgID                  = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName            = acctest.RandomWithPrefix("test-acc")
-		name                   = acctest.RandomWithPrefix("test-acc")
+		projectName            = rnd.RandomWithPrefix("test-acc")
+		name                   = rnd.RandomWithPrefix("test-acc")
 
 		instanceSize = "M30"
 		minSize      = ""
@@ -1027,7 +1047,7 @@ func TestAccClusterRSCluster_withAutoScalingAWS(t *testing.T) {
 		maxSizeUpdated      = "M80"
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1075,14 +1095,15 @@ func TestAccClusterRSCluster_withAutoScalingAWS(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_importBasic(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		clusterName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		clusterName  = rnd.RandomWithPrefix("test-acc")
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1102,17 +1123,18 @@ func TestAccClusterRSCluster_importBasic(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_tenant(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.tenant"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = rnd.RandomWithPrefix("test-acc")
 	)
 
 	dbMajorVersion := testAccGetMongoDBAtlasMajorVersion()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1144,15 +1166,16 @@ func TestAccClusterRSCluster_tenant(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_tenant_m5(t *testing.T) {
+	rnd := testAccCassette(t)
 	var cluster matlas.Cluster
 
 	resourceName := "mongodbatlas_cluster.tenant"
 	orgID := os.Getenv("MONGODB_ATLAS_ORG_ID")
-	projectName := acctest.RandomWithPrefix("test-acc")
-	name := fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+	projectName := rnd.RandomWithPrefix("test-acc")
+	name := fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	dbMajorVersion := testAccGetMongoDBAtlasMajorVersion()
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1173,15 +1196,16 @@ func TestAccClusterRSCluster_tenant_m5(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicGCPRegionNameWesternUS(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		clusterName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		clusterName  = rnd.RandomWithPrefix("test-acc")
 		regionName   = "WESTERN_US"
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1199,15 +1223,16 @@ func TestAccClusterRSCluster_basicGCPRegionNameWesternUS(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicGCPRegionNameUSWest2(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		clusterName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		clusterName  = rnd.RandomWithPrefix("test-acc")
 		regionName   = "US_WEST_2"
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1226,11 +1251,12 @@ func TestAccClusterRSCluster_basicGCPRegionNameUSWest2(t *testing.T) {
 
 func TestAccClusterRSCluster_RegionsConfig(t *testing.T) {
 	SkipTest(t)
+	rnd := testAccCassette(t)
 	var (
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		clusterName  = acctest.RandomWithPrefix("test-acc")
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		clusterName  = rnd.RandomWithPrefix("test-acc")
 	)
 
 	replications := `replication_specs {
@@ -1286,7 +1312,7 @@ func TestAccClusterRSCluster_RegionsConfig(t *testing.T) {
 		}
 	}`
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1312,15 +1338,16 @@ func TestAccClusterRSCluster_RegionsConfig(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicAWS_UnpauseToPaused(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
@@ -1365,15 +1392,16 @@ func TestAccClusterRSCluster_basicAWS_UnpauseToPaused(t *testing.T) {
 }
 
 func TestAccClusterRSCluster_basicAWS_PausedToUnpaused(t *testing.T) {
+	rnd := testAccCassette(t)
 	var (
 		cluster      matlas.Cluster
 		resourceName = "mongodbatlas_cluster.test"
 		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
-		projectName  = acctest.RandomWithPrefix("test-acc")
-		name         = fmt.Sprintf("test-acc-%s", acctest.RandString(10))
+		projectName  = rnd.RandomWithPrefix("test-acc")
+		name         = fmt.Sprintf("test-acc-%s", rnd.RandString(10))
 	)
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckBasic(t) },
 		ProtoV6ProviderFactories: testAccProviderV6Factories,
 		CheckDestroy:             testAccCheckMongoDBAtlasClusterDestroy,
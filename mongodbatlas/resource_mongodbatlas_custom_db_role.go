@@ -5,16 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"regexp"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mwielbut/pointy"
 	"github.com/spf13/cast"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
@@ -118,17 +118,14 @@ func resourceMongoDBAtlasCustomDBRoleCreate(ctx context.Context, d *schema.Resou
 		InheritedRoles: expandInheritedRoles(d),
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{"pending"},
-		Target:  []string{"created", "failed"},
+	waiter := &retrystrategy.Waiter[any]{
+		Operation: fmt.Sprintf("creation of custom db role %s", customDBRoleReq.RoleName),
+		Pending:   []string{"pending"},
+		Target:    []string{"created", "failed"},
 		Refresh: func() (any, string, error) {
 			customDBRoleRes, _, err := conn.CustomDBRoles.Create(ctx, projectID, customDBRoleReq)
 			if err != nil {
-				if strings.Contains(err.Error(), "Unexpected error") ||
-					strings.Contains(err.Error(), "UNEXPECTED_ERROR") ||
-					strings.Contains(err.Error(), "500") ||
-					strings.Contains(err.Error(), "404") ||
-					strings.Contains(err.Error(), "ATLAS_CUSTOM_ROLE_NOT_FOUND") {
+				if apierror.IsRetryable(err) || apierror.IsNotFound(err) {
 					return nil, "pending", nil
 				}
 				return nil, "failed", err
@@ -136,13 +133,13 @@ func resourceMongoDBAtlasCustomDBRoleCreate(ctx context.Context, d *schema.Resou
 
 			return customDBRoleRes, "created", nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      3 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:      10 * time.Minute,
+		Delay:        3 * time.Second,
+		PollInterval: 3 * time.Second,
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating custom db role: %s", err))
 	}
@@ -161,9 +158,9 @@ func resourceMongoDBAtlasCustomDBRoleRead(ctx context.Context, d *schema.Resourc
 	projectID := ids["project_id"]
 	roleName := ids["role_name"]
 
-	customDBRole, resp, err := conn.CustomDBRoles.Get(context.Background(), projectID, roleName)
+	customDBRole, _, err := conn.CustomDBRoles.Get(context.Background(), projectID, roleName)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -224,14 +221,14 @@ func resourceMongoDBAtlasCustomDBRoleDelete(ctx context.Context, d *schema.Resou
 	projectID := ids["project_id"]
 	roleName := ids["role_name"]
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{"deleting"},
-		Target:  []string{"deleted", "failed"},
+	waiter := &retrystrategy.Waiter[any]{
+		Operation: fmt.Sprintf("deletion of custom db role %s", roleName),
+		Pending:   []string{"deleting"},
+		Target:    []string{"deleted", "failed"},
 		Refresh: func() (any, string, error) {
 			_, _, err := conn.CustomDBRoles.Get(ctx, projectID, roleName)
 			if err != nil {
-				if strings.Contains(err.Error(), "404") ||
-					strings.Contains(err.Error(), "ATLAS_CUSTOM_ROLE_NOT_FOUND") {
+				if apierror.IsNotFound(err) {
 					return "", "deleted", nil
 				}
 				return nil, "failed", err
@@ -244,13 +241,13 @@ func resourceMongoDBAtlasCustomDBRoleDelete(ctx context.Context, d *schema.Resou
 
 			return nil, "deleting", nil
 		},
-		Timeout:    10 * time.Minute,
-		Delay:      3 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout:      10 * time.Minute,
+		Delay:        3 * time.Second,
+		PollInterval: 3 * time.Second,
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
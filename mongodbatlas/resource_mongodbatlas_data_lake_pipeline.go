@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -285,8 +285,8 @@ func resourceMongoDBAtlasDataLakePipelineRead(ctx context.Context, d *schema.Res
 	projectID := ids["project_id"]
 	name := ids["name"]
 
-	dataLakePipeline, resp, err := conn.DataLakePipeline.Get(ctx, projectID, name)
-	if resp != nil && resp.StatusCode == http.StatusNotFound {
+	dataLakePipeline, _, err := conn.DataLakePipeline.Get(ctx, projectID, name)
+	if apierror.IsNotFound(err) {
 		d.SetId("")
 		return nil
 	}
@@ -6,13 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"strings"
 
 	"github.com/go-test/deep"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"github.com/mwielbut/pointy"
 	"github.com/spf13/cast"
 	"go.mongodb.org/realm/realm"
@@ -323,9 +323,9 @@ func resourceMongoDBAtlasEventTriggersRead(ctx context.Context, d *schema.Resour
 	appID := ids["app_id"]
 	triggerID := ids["trigger_id"]
 
-	resp, recodes, err := conn.EventTriggers.Get(ctx, projectID, appID, triggerID)
+	resp, _, err := conn.EventTriggers.Get(ctx, projectID, appID, triggerID)
 	if err != nil {
-		if recodes != nil && recodes.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -364,9 +364,9 @@ func resourceMongoDBAFederatedDatabaseInstanceRead(ctx context.Context, d *schem
 	projectID := ids["project_id"]
 	name := ids["name"]
 
-	dataFederationInstance, resp, err := connV2.DataFederationApi.GetFederatedDatabase(ctx, projectID, name).Execute()
+	dataFederationInstance, _, err := connV2.DataFederationApi.GetFederatedDatabase(ctx, projectID, name).Execute()
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
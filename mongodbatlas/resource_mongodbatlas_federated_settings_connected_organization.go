@@ -4,17 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"github.com/spf13/cast"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 func resourceMongoDBAtlasFederatedSettingsOrganizationConfig() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead,
+		CreateContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigCreate,
 		ReadContext:   resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead,
 		UpdateContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigUpdate,
 		DeleteContext: resourceMongoDBAtlasFederatedSettingsOrganizationConfigDelete,
@@ -56,6 +57,34 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfig() *schema.Resource
 	}
 }
 
+func resourceMongoDBAtlasFederatedSettingsOrganizationConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	federationSettingsID := d.Get("federation_settings_id").(string)
+	orgID := d.Get("org_id").(string)
+	domainRestrictionEnabled := d.Get("domain_restriction_enabled").(bool)
+
+	// connecting an organization to the federation settings is an update of its configuration
+	connectedOrganization := &matlas.FederatedSettingsConnectedOrganization{
+		IdentityProviderID:       d.Get("identity_provider_id").(string),
+		DomainRestrictionEnabled: &domainRestrictionEnabled,
+		DomainAllowList:          cast.ToStringSlice(d.Get("domain_allow_list")),
+		PostAuthRoleGrants:       cast.ToStringSlice(d.Get("post_auth_role_grants")),
+	}
+
+	_, _, err := conn.FederatedSettings.UpdateConnectedOrg(ctx, federationSettingsID, orgID, connectedOrganization)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error connecting organization (%s) to federation settings (%s): %s", orgID, federationSettingsID, err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"federation_settings_id": federationSettingsID,
+		"org_id":                 orgID,
+	}))
+
+	return resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx, d, meta)
+}
+
 func resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -68,11 +97,11 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx context.Con
 	federationSettingsID := ids["federation_settings_id"]
 	orgID := ids["org_id"]
 
-	federatedSettingsConnectedOrganization, resp, err := conn.FederatedSettings.GetConnectedOrg(context.Background(), federationSettingsID, orgID)
+	federatedSettingsConnectedOrganization, _, err := conn.FederatedSettings.GetConnectedOrg(context.Background(), federationSettingsID, orgID)
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -88,6 +117,10 @@ func resourceMongoDBAtlasFederatedSettingsOrganizationConfigRead(ctx context.Con
 		return diag.FromErr(fmt.Errorf("error setting domain allow list (%s): %s", d.Id(), err))
 	}
 
+	if err := d.Set("identity_provider_id", federatedSettingsConnectedOrganization.IdentityProviderID); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting identity provider id (%s): %s", d.Id(), err))
+	}
+
 	if err := d.Set("post_auth_role_grants", federatedSettingsConnectedOrganization.PostAuthRoleGrants); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting post_auth_role_grants (%s): %s", d.Id(), err))
 	}
@@ -4,23 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"github.com/spf13/cast"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 func resourceMongoDBAtlasFederatedSettingsIdentityProvider() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceMongoDBAtlasFederatedSettingsIdentityProviderRead,
+		CreateContext: resourceMongoDBAtlasFederatedSettingsIdentityProviderCreate,
 		ReadContext:   resourceMongoDBAtlasFederatedSettingsIdentityProviderRead,
 		UpdateContext: resourceMongoDBAtlasFederatedSettingsIdentityProviderUpdate,
 		DeleteContext: resourceMongoDBAtlasFederatedSettingsIdentityProviderDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasFederatedSettingsIdentityProviderImportState,
 		},
+		CustomizeDiff: resourceMongoDBAtlasFederatedSettingsIdentityProviderCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"federation_settings_id": {
 				Type:     schema.TypeString,
@@ -30,17 +33,35 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProvider() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{identityProviderProtocolSAML, identityProviderProtocolOIDC}, false),
+			},
+			"idp_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{identityProviderTypeWorkforce, identityProviderTypeWorkload}, false),
+			},
 			"issuer_uri": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"request_binding": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"response_signature_algorithm": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"associated_domains": {
 				Type:     schema.TypeList,
@@ -51,24 +72,135 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProvider() *schema.Resource {
 			},
 			"sso_debug_enabled": {
 				Type:     schema.TypeBool,
-				Required: true,
+				Optional: true,
 			},
 			"sso_url": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"status": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"pem_file_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"certificates": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"authorization_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"groups_claim": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_claim": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"requested_scopes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"okta_idp_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"idp_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// resourceMongoDBAtlasFederatedSettingsIdentityProviderCustomizeDiff checks the arguments required by the protocol
+// of the identity provider, as the schema can't express them.
+func resourceMongoDBAtlasFederatedSettingsIdentityProviderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("protocol") || !d.NewValueKnown("idp_type") || !d.NewValueKnown("authorization_type") {
+		return nil
+	}
+	protocol := d.Get("protocol").(string)
+	if protocol == "" {
+		protocol = identityProviderProtocolSAML
+	}
+
+	var required []string
+	switch protocol {
+	case identityProviderProtocolSAML:
+		required = []string{"sso_url", "request_binding", "response_signature_algorithm", "status"}
+	case identityProviderProtocolOIDC:
+		required = []string{"idp_type", "audience", "user_claim"}
+		if d.Get("idp_type").(string) == identityProviderTypeWorkforce {
+			required = append(required, "client_id")
+		}
+		if d.Get("authorization_type").(string) == identityProviderAuthTypeGroup {
+			required = append(required, "groups_claim")
+		}
+	}
+	for _, attr := range required {
+		if _, ok := d.GetOk(attr); !ok && d.NewValueKnown(attr) {
+			return fmt.Errorf("%s is required for %s identity providers", attr, describeIdentityProvider(protocol, d.Get("idp_type").(string)))
+		}
+	}
+	return nil
+}
+
+func describeIdentityProvider(protocol, idpType string) string {
+	if protocol == identityProviderProtocolOIDC && idpType != "" {
+		return fmt.Sprintf("%s %s", protocol, idpType)
+	}
+	return protocol
+}
+
+func resourceMongoDBAtlasFederatedSettingsIdentityProviderCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	federationSettingsID := d.Get("federation_settings_id").(string)
+
+	identityProvider, err := createFederatedIdentityProvider(ctx, conn, federationSettingsID, expandFederatedIdentityProvider(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating federation settings identity provider (%s): %s", federationSettingsID, err))
+	}
+
+	d.SetId(encodeStateID(map[string]string{
+		"federation_settings_id": federationSettingsID,
+		"okta_idp_id":            identityProvider.OktaIdpID,
+		"idp_id":                 identityProvider.ID,
+	}))
+
+	return resourceMongoDBAtlasFederatedSettingsIdentityProviderRead(ctx, d, meta)
+}
+
 func resourceMongoDBAtlasFederatedSettingsIdentityProviderRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -82,11 +214,16 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProviderRead(ctx context.Conte
 	federationSettingsID := ids["federation_settings_id"]
 	oktaIdpID := ids["okta_idp_id"]
 
-	federatedSettingsIdentityProvider, resp, err := conn.FederatedSettings.GetIdentityProvider(context.Background(), federationSettingsID, oktaIdpID)
+	if idpID := ids["idp_id"]; idpID != "" {
+		return resourceMongoDBAtlasFederatedSettingsIdentityProviderReadByID(ctx, d, conn, federationSettingsID, idpID)
+	}
+
+	// identity providers imported with their Okta ID are read with the API version that only supports SAML
+	federatedSettingsIdentityProvider, _, err := conn.FederatedSettings.GetIdentityProvider(context.Background(), federationSettingsID, oktaIdpID)
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -94,6 +231,10 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProviderRead(ctx context.Conte
 		return diag.FromErr(fmt.Errorf("error getting federated settings identity provider: %s", err))
 	}
 
+	if err := d.Set("protocol", identityProviderProtocolSAML); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting protocol (%s): %s", d.Id(), err))
+	}
+
 	if err := d.Set("sso_debug_enabled", federatedSettingsIdentityProvider.SsoDebugEnabled); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting sso debug enabled (%s): %s", d.Id(), err))
 	}
@@ -134,6 +275,49 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProviderRead(ctx context.Conte
 	return nil
 }
 
+func resourceMongoDBAtlasFederatedSettingsIdentityProviderReadByID(ctx context.Context, d *schema.ResourceData, conn *matlas.Client,
+	federationSettingsID, idpID string) diag.Diagnostics {
+	identityProvider, err := getFederatedIdentityProvider(ctx, conn, federationSettingsID, idpID)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("error getting federated settings identity provider: %s", err))
+	}
+
+	attributes := map[string]any{
+		"name":                         identityProvider.DisplayName,
+		"description":                  identityProvider.Description,
+		"protocol":                     identityProvider.Protocol,
+		"idp_type":                     identityProvider.IdpType,
+		"issuer_uri":                   identityProvider.IssuerURI,
+		"associated_domains":           identityProvider.AssociatedDomains,
+		"status":                       identityProvider.Status,
+		"sso_debug_enabled":            identityProvider.SsoDebugEnabled,
+		"sso_url":                      identityProvider.SsoURL,
+		"request_binding":              identityProvider.RequestBinding,
+		"response_signature_algorithm": identityProvider.ResponseSignatureAlgorithm,
+		"audience":                     identityProvider.Audience,
+		"client_id":                    identityProvider.ClientID,
+		"authorization_type":           identityProvider.AuthorizationType,
+		"groups_claim":                 identityProvider.GroupsClaim,
+		"user_claim":                   identityProvider.UserClaim,
+		"requested_scopes":             identityProvider.RequestedScopes,
+		"okta_idp_id":                  identityProvider.OktaIdpID,
+		"idp_id":                       identityProvider.ID,
+		"pem_file_info":                flattenFederatedIdentityProviderPemFileInfo(identityProvider.PemFileInfo),
+	}
+	for name, value := range attributes {
+		if err := d.Set(name, value); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting %s (%s): %s", name, d.Id(), err))
+		}
+	}
+
+	return nil
+}
+
 func resourceMongoDBAtlasFederatedSettingsIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -141,6 +325,17 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProviderUpdate(ctx context.Con
 	federationSettingsID := ids["federation_settings_id"]
 	oktaIdpID := ids["okta_idp_id"]
 
+	if idpID := ids["idp_id"]; idpID != "" {
+		update := expandFederatedIdentityProvider(d)
+		// the protocol and type of an identity provider can't be updated
+		update.Protocol = ""
+		update.IdpType = ""
+		if _, err := updateFederatedIdentityProvider(ctx, conn, federationSettingsID, idpID, update); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating federation settings identity provider (%s): %s", federationSettingsID, err))
+		}
+		return resourceMongoDBAtlasFederatedSettingsIdentityProviderRead(ctx, d, meta)
+	}
+
 	federatedSettingsIdentityProviderUpdate, _, err := conn.FederatedSettings.GetIdentityProvider(context.Background(), federationSettingsID, oktaIdpID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error retreiving federation settings identity provider (%s): %s", federationSettingsID, err))
@@ -197,10 +392,82 @@ func resourceMongoDBAtlasFederatedSettingsIdentityProviderUpdate(ctx context.Con
 }
 
 func resourceMongoDBAtlasFederatedSettingsIdentityProviderDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	d.SetId("")
+	// Get client connection.
+	conn := meta.(*MongoDBClient).Atlas
+	ids := decodeStateID(d.Id())
+	federationSettingsID := ids["federation_settings_id"]
+
+	// identity providers imported with their Okta ID are only removed from the state, as they were before they could be
+	// created by the provider
+	idpID := ids["idp_id"]
+	if idpID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	if err := deleteFederatedIdentityProvider(ctx, conn, federationSettingsID, idpID); err != nil && !apierror.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting federation settings identity provider (%s): %s", federationSettingsID, err))
+	}
+
 	return nil
 }
 
+func expandFederatedIdentityProvider(d *schema.ResourceData) *federatedIdentityProvider {
+	identityProvider := &federatedIdentityProvider{
+		DisplayName:       d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Protocol:          d.Get("protocol").(string),
+		IdpType:           d.Get("idp_type").(string),
+		IssuerURI:         d.Get("issuer_uri").(string),
+		AssociatedDomains: cast.ToStringSlice(d.Get("associated_domains")),
+		AuthorizationType: d.Get("authorization_type").(string),
+	}
+	if identityProvider.Protocol == "" {
+		identityProvider.Protocol = identityProviderProtocolSAML
+	}
+
+	if identityProvider.Protocol == identityProviderProtocolSAML {
+		ssoDebugEnabled := d.Get("sso_debug_enabled").(bool)
+		identityProvider.SsoDebugEnabled = &ssoDebugEnabled
+		identityProvider.SsoURL = d.Get("sso_url").(string)
+		identityProvider.Status = d.Get("status").(string)
+		identityProvider.RequestBinding = d.Get("request_binding").(string)
+		identityProvider.ResponseSignatureAlgorithm = d.Get("response_signature_algorithm").(string)
+		if pemFileInfo, ok := d.Get("pem_file_info").([]any); ok && len(pemFileInfo) > 0 && pemFileInfo[0] != nil {
+			info := pemFileInfo[0].(map[string]any)
+			identityProvider.PemFileInfo = &federatedIdentityProviderPemFileInfo{
+				FileName: info["file_name"].(string),
+			}
+			for _, content := range cast.ToStringSlice(info["certificates"]) {
+				identityProvider.PemFileInfo.Certificates = append(identityProvider.PemFileInfo.Certificates, federatedIdentityProviderCertificate{Content: content})
+			}
+		}
+		return identityProvider
+	}
+
+	identityProvider.Audience = d.Get("audience").(string)
+	identityProvider.ClientID = d.Get("client_id").(string)
+	identityProvider.GroupsClaim = d.Get("groups_claim").(string)
+	identityProvider.UserClaim = d.Get("user_claim").(string)
+	identityProvider.RequestedScopes = cast.ToStringSlice(d.Get("requested_scopes"))
+	return identityProvider
+}
+
+func flattenFederatedIdentityProviderPemFileInfo(pemFileInfo *federatedIdentityProviderPemFileInfo) []map[string]any {
+	if pemFileInfo == nil || len(pemFileInfo.Certificates) == 0 {
+		return nil
+	}
+
+	certificates := make([]string, 0, len(pemFileInfo.Certificates))
+	for _, certificate := range pemFileInfo.Certificates {
+		certificates = append(certificates, certificate.Content)
+	}
+	return []map[string]any{{
+		"file_name":    pemFileInfo.FileName,
+		"certificates": certificates,
+	}}
+}
+
 func resourceMongoDBAtlasFederatedSettingsIdentityProviderImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	conn := meta.(*MongoDBClient).Atlas
 	federationSettingsID, oktaIdpID, err := splitFederatedSettingsIdentityProviderImportID(d.Id())
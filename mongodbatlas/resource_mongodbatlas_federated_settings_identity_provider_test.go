@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
@@ -74,6 +75,58 @@ func TestAccFedRSFederatedSettingsIdentityProvider_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccFedRSFederatedSettingsIdentityProvider_createOIDC(t *testing.T) {
+	SkipTestExtCred(t)
+	var (
+		resourceName         = "mongodbatlas_federated_settings_identity_provider.test"
+		federationSettingsID = os.Getenv("MONGODB_ATLAS_FEDERATION_SETTINGS_ID")
+		name                 = acctest.RandomWithPrefix("test-acc-oidc")
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testCheckFederatedSettings(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasFederatedSettingsIdentityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasFederatedSettingsIdentityProviderConfigOIDC(federationSettingsID, name, "audience"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "idp_id"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "OIDC"),
+					resource.TestCheckResourceAttr(resourceName, "idp_type", "WORKLOAD"),
+					resource.TestCheckResourceAttr(resourceName, "audience", "audience"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasFederatedSettingsIdentityProviderConfigOIDC(federationSettingsID, name, "audience-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "audience", "audience-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasFederatedSettingsIdentityProviderDestroy(s *terraform.State) error {
+	conn := testAccProviderSdkV2.Meta().(*MongoDBClient).Atlas
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_federated_settings_identity_provider" {
+			continue
+		}
+
+		ids := decodeStateID(rs.Primary.ID)
+		if ids["idp_id"] == "" {
+			continue
+		}
+		if _, err := getFederatedIdentityProvider(context.Background(), conn, ids["federation_settings_id"], ids["idp_id"]); err == nil {
+			return fmt.Errorf("identity provider (%s) still exists", ids["idp_id"])
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckMongoDBAtlasFederatedSettingsIdentityProviderExists(resourceName string,
 	federatedSettingsIdentityProvider *matlas.FederatedSettingsIdentityProvider, idpID string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -126,3 +179,17 @@ func testAccMongoDBAtlasFederatedSettingsIdentityProviderConfig(federationSettin
         response_signature_algorithm = "SHA-256"
 	  }`, federationSettingsID, ssoURL, issuerURI)
 }
+
+func testAccMongoDBAtlasFederatedSettingsIdentityProviderConfigOIDC(federationSettingsID, name, audience string) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_federated_settings_identity_provider" "test" {
+		federation_settings_id = "%[1]s"
+		name                   = "%[2]s"
+		protocol               = "OIDC"
+		idp_type               = "WORKLOAD"
+		issuer_uri             = "https://token.actions.githubusercontent.com"
+		audience               = "%[3]s"
+		authorization_type     = "USER"
+		user_claim             = "sub"
+	  }`, federationSettingsID, name, audience)
+}
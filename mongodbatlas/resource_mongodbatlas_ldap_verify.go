@@ -4,13 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mwielbut/pointy"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -141,17 +142,18 @@ func resourceMongoDBAtlasLDAPVerifyCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf(errorLDAPVerifyCreate, projectID, err))
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"PENDING"},
-		Target:     []string{"SUCCESS", "FAILED"},
-		Refresh:    resourceLDAPGetStatusRefreshFunc(ctx, projectID, ldap.RequestID, conn),
-		Timeout:    3 * time.Hour,
-		MinTimeout: 1 * time.Minute,
-		Delay:      3 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("LDAP configuration verification %s", ldap.RequestID),
+		Pending:      []string{"PENDING"},
+		Target:       []string{"SUCCESS", "FAILED"},
+		Refresh:      resourceLDAPGetStatusRefreshFunc(ctx, projectID, ldap.RequestID, conn),
+		Timeout:      3 * time.Hour,
+		PollInterval: 1 * time.Minute,
+		Delay:        3 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorLDAPVerifyCreate, projectID, err))
 	}
@@ -171,9 +173,9 @@ func resourceMongoDBAtlasLDAPVerifyRead(ctx context.Context, d *schema.ResourceD
 	projectID := ids["project_id"]
 	requestID := ids["request_id"]
 
-	ldapResp, resp, err := conn.LDAPConfigurations.GetStatus(context.Background(), projectID, requestID)
+	ldapResp, _, err := conn.LDAPConfigurations.GetStatus(context.Background(), projectID, requestID)
 	if err != nil || ldapResp == nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -270,9 +272,9 @@ func resourceMongoDBAtlasLDAPVerifyImportState(ctx context.Context, d *schema.Re
 
 func resourceLDAPGetStatusRefreshFunc(ctx context.Context, projectID, requestID string, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		p, resp, err := client.LDAPConfigurations.GetStatus(ctx, projectID, requestID)
+		p, _, err := client.LDAPConfigurations.GetStatus(ctx, projectID, requestID)
 		if err != nil {
-			if resp.Response.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
 
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"reflect"
 	"strings"
 	"time"
@@ -14,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/spf13/cast"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -96,9 +97,35 @@ func resourceMongoDBAtlasNetworkContainer() *schema.Resource {
 				},
 			},
 		},
+		CustomizeDiff: resourceMongoDBAtlasNetworkContainerCustomizeDiff,
 	}
 }
 
+// resourceMongoDBAtlasNetworkContainerCustomizeDiff rejects the regions that the cloud provider doesn't offer, each
+// provider sets them in a different attribute.
+func resourceMongoDBAtlasNetworkContainerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	providerName := "AWS"
+	if configured := rawConfigAttr(d, "provider_name"); !configured.IsNull() {
+		if providerName = ctyString(configured); providerName == "" {
+			return nil
+		}
+	}
+
+	switch providerName {
+	case "AWS":
+		return validateCatalogRegion("region_name", providerName, rawConfigAttr(d, "region_name"))
+	case "AZURE":
+		return validateCatalogRegion("region", providerName, rawConfigAttr(d, "region"))
+	case "GCP":
+		var errs []error
+		for i, region := range ctyElements(rawConfigAttr(d, "regions")) {
+			errs = append(errs, validateCatalogRegion(fmt.Sprintf("regions.%d", i), providerName, region))
+		}
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
 func resourceMongoDBAtlasNetworkContainerCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -155,9 +182,9 @@ func resourceMongoDBAtlasNetworkContainerRead(ctx context.Context, d *schema.Res
 	projectID := ids["project_id"]
 	containerID := ids["container_id"]
 
-	container, resp, err := conn.Containers.Get(ctx, projectID, containerID)
+	container, _, err := conn.Containers.Get(ctx, projectID, containerID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -255,17 +282,18 @@ func resourceMongoDBAtlasNetworkContainerDelete(ctx context.Context, d *schema.R
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"provisioned_container"},
-		Target:     []string{"deleted"},
-		Refresh:    resourceNetworkContainerRefreshFunc(ctx, d, conn),
-		Timeout:    1 * time.Hour,
-		MinTimeout: 10 * time.Second,
-		Delay:      2 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of network container %s", decodeStateID(d.Id())["container_id"]),
+		Pending:      []string{"provisioned_container"},
+		Target:       []string{"deleted"},
+		Refresh:      resourceNetworkContainerRefreshFunc(ctx, d, conn),
+		Timeout:      1 * time.Hour,
+		PollInterval: 10 * time.Second,
+		Delay:        2 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorContainerDelete, decodeStateID(d.Id())["container_id"], err))
 	}
@@ -320,9 +348,9 @@ func resourceNetworkContainerRefreshFunc(ctx context.Context, d *schema.Resource
 		containerID := ids["container_id"]
 
 		var err error
-		container, res, err := client.Containers.Get(ctx, projectID, containerID)
+		container, _, err := client.Containers.Get(ctx, projectID, containerID)
 		if err != nil {
-			if res.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "deleted", nil
 			}
 
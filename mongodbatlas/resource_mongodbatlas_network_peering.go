@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"reflect"
 	"strings"
 	"time"
@@ -14,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -239,17 +240,18 @@ func resourceMongoDBAtlasNetworkPeeringCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(fmt.Errorf(errorPeersCreate, err))
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"INITIATING", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER"},
-		Target:     []string{"AVAILABLE", "PENDING_ACCEPTANCE"},
-		Refresh:    resourceNetworkPeeringRefreshFunc(ctx, peer.ID, projectID, peerRequest.ContainerID, conn),
-		Timeout:    1 * time.Hour,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of network peering %s", peer.ID),
+		Pending:      []string{"INITIATING", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER"},
+		Target:       []string{"AVAILABLE", "PENDING_ACCEPTANCE"},
+		Refresh:      resourceNetworkPeeringRefreshFunc(ctx, peer.ID, projectID, peerRequest.ContainerID, conn),
+		Timeout:      1 * time.Hour,
+		PollInterval: 10 * time.Second,
+		Delay:        30 * time.Second,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPeersCreate, err))
 	}
@@ -271,9 +273,9 @@ func resourceMongoDBAtlasNetworkPeeringRead(ctx context.Context, d *schema.Resou
 	peerID := ids["peer_id"]
 	providerName := ids["provider_name"]
 
-	peer, resp, err := conn.Peers.Get(ctx, projectID, peerID)
+	peer, _, err := conn.Peers.Get(ctx, projectID, peerID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -440,17 +442,18 @@ func resourceMongoDBAtlasNetworkPeeringUpdate(ctx context.Context, d *schema.Res
 		}
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"INITIATING", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER"},
-		Target:     []string{"AVAILABLE", "PENDING_ACCEPTANCE"},
-		Refresh:    resourceNetworkPeeringRefreshFunc(ctx, peerID, projectID, "", conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("update of network peering %s", peerID),
+		Pending:      []string{"INITIATING", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER"},
+		Target:       []string{"AVAILABLE", "PENDING_ACCEPTANCE"},
+		Refresh:      resourceNetworkPeeringRefreshFunc(ctx, peerID, projectID, "", conn),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPeersCreate, err))
 	}
@@ -472,17 +475,18 @@ func resourceMongoDBAtlasNetworkPeeringDelete(ctx context.Context, d *schema.Res
 
 	log.Println("[INFO] Waiting for MongoDB Network Peering Connection to be destroyed")
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"AVAILABLE", "INITIATING", "PENDING_ACCEPTANCE", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER", "TERMINATING", "DELETING"},
-		Target:     []string{"DELETED"},
-		Refresh:    resourceNetworkPeeringRefreshFunc(ctx, peerID, projectID, "", conn),
-		Timeout:    1 * time.Hour,
-		MinTimeout: 30 * time.Second,
-		Delay:      10 * time.Second, // Wait 10 secs before starting
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of network peering %s", peerID),
+		Pending:      []string{"AVAILABLE", "INITIATING", "PENDING_ACCEPTANCE", "FINALIZING", "ADDING_PEER", "WAITING_FOR_USER", "TERMINATING", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      resourceNetworkPeeringRefreshFunc(ctx, peerID, projectID, "", conn),
+		Timeout:      1 * time.Hour,
+		PollInterval: 30 * time.Second,
+		Delay:        10 * time.Second, // Wait 10 secs before starting
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPeersDelete, peerID, err))
 	}
@@ -530,9 +534,9 @@ func resourceMongoDBAtlasNetworkPeeringImportState(ctx context.Context, d *schem
 
 func resourceNetworkPeeringRefreshFunc(ctx context.Context, peerID, projectID, containerID string, client *matlas.Client) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		c, resp, err := client.Peers.Get(ctx, projectID, peerID)
+		c, _, err := client.Peers.Get(ctx, projectID, peerID)
 		if err != nil {
-			if resp != nil && resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
 
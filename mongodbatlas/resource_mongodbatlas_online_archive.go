@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/util"
 	"github.com/mwielbut/pointy"
 	"go.mongodb.org/atlas-sdk/v20231001001/admin"
@@ -216,17 +218,18 @@ func resourceMongoDBAtlasOnlineArchiveCreate(ctx context.Context, d *schema.Reso
 	}))
 
 	if d.Get("sync_creation").(bool) {
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"PENDING", "ARCHIVING", "PAUSING", "PAUSED", "ORPHANED", "REPEATING"},
-			Target:     []string{"IDLE", "ACTIVE"},
-			Refresh:    resourceOnlineRefreshFunc(ctx, projectID, clusterName, archiveID, connV2),
-			Timeout:    3 * time.Hour,
-			MinTimeout: 1 * time.Minute,
-			Delay:      3 * time.Minute,
+		waiter := &retrystrategy.Waiter[any]{
+			Operation:    fmt.Sprintf("creation of online archive %s", archiveID),
+			Pending:      []string{"PENDING", "ARCHIVING", "PAUSING", "PAUSED", "ORPHANED", "REPEATING"},
+			Target:       []string{"IDLE", "ACTIVE"},
+			Refresh:      resourceOnlineRefreshFunc(ctx, projectID, clusterName, archiveID, connV2),
+			Timeout:      3 * time.Hour,
+			PollInterval: 1 * time.Minute,
+			Delay:        3 * time.Minute,
 		}
 
 		// Wait, catching any errors
-		_, err := stateConf.WaitForStateContext(ctx)
+		_, err := waiter.Wait(ctx)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error updating the online archive status %s for cluster %s", clusterName, archiveID))
 		}
@@ -239,17 +242,17 @@ func resourceOnlineRefreshFunc(ctx context.Context, projectID, clusterName, arch
 	return func() (any, string, error) {
 		c, resp, err := client.OnlineArchiveApi.GetOnlineArchive(ctx, projectID, archiveID, clusterName).Execute()
 
-		if err != nil && strings.Contains(err.Error(), "reset by peer") {
+		if apierror.IsTransientNetworkError(err) {
 			return nil, "REPEATING", nil
 		}
 
 		if err != nil && c == nil && resp == nil {
 			return nil, "", err
 		} else if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
@@ -271,9 +274,9 @@ func resourceMongoDBAtlasOnlineArchiveRead(ctx context.Context, d *schema.Resour
 	projectID := ids["project_id"]
 	clusterName := ids["cluster_name"]
 
-	onlineArchive, resp, err := connV2.OnlineArchiveApi.GetOnlineArchive(context.Background(), projectID, archiveID, clusterName).Execute()
+	onlineArchive, _, err := connV2.OnlineArchiveApi.GetOnlineArchive(context.Background(), projectID, archiveID, clusterName).Execute()
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -300,7 +303,7 @@ func resourceMongoDBAtlasOnlineArchiveDelete(ctx context.Context, d *schema.Reso
 	_, err := conn.OnlineArchives.Delete(ctx, projectID, clusterName, atlasID)
 
 	if err != nil {
-		alreadyDeleted := strings.Contains(err.Error(), "404") && !d.IsNewResource()
+		alreadyDeleted := apierror.IsNotFound(err) && !d.IsNewResource()
 		if alreadyDeleted {
 			return nil
 		}
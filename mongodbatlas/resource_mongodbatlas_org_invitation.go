@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -78,7 +78,7 @@ func resourceMongoDBAtlasOrgInvitationRead(ctx context.Context, d *schema.Resour
 			// case 404
 			// deleted in the backend case
 
-			if strings.Contains(err.Error(), "404") {
+			if apierror.IsNotFound(err) {
 				accepted, _ := validateOrgInvitationAlreadyAccepted(ctx, meta.(*MongoDBClient), username, orgID)
 				if accepted {
 					d.SetId("")
@@ -176,7 +176,7 @@ func resourceMongoDBAtlasOrgInvitationDelete(ctx context.Context, d *schema.Reso
 		// case 404
 		// deleted in the backend case
 
-		if strings.Contains(err.Error(), "404") {
+		if apierror.IsNotFound(err) {
 			accepted, _ := validateOrgInvitationAlreadyAccepted(ctx, meta.(*MongoDBClient), username, orgID)
 			if accepted {
 				d.SetId("")
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"github.com/mwielbut/pointy"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -65,9 +65,9 @@ func resourceMongoDBAtlasOrganization() *schema.Resource {
 
 func resourceMongoDBAtlasOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*MongoDBClient).Atlas
-	organization, resp, err := conn.Organizations.Create(ctx, newCreateOrganizationRequest(d))
+	organization, _, err := conn.Organizations.Create(ctx, newCreateOrganizationRequest(d))
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -108,9 +108,9 @@ func resourceMongoDBAtlasOrganizationRead(ctx context.Context, d *schema.Resourc
 	ids := decodeStateID(d.Id())
 	orgID := ids["org_id"]
 
-	organization, resp, err := conn.Organizations.Get(ctx, orgID)
+	organization, _, err := conn.Organizations.Get(ctx, orgID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			log.Printf("warning Organization deleted will recreate: %s \n", err.Error())
 			d.SetId("")
 			return nil
@@ -0,0 +1,135 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mwielbut/pointy"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	errorOrganizationSettingsRead    = "error reading settings of organization (%s): %s"
+	errorOrganizationSettingsUpdate  = "error updating settings of organization (%s): %s"
+	errorOrganizationSettingsSetting = "error setting `%s` for settings of organization (%s): %s"
+)
+
+// resourceMongoDBAtlasOrganizationSettings manages the security settings of an existing organization. The settings
+// always exist, so creating the resource updates them and destroying it only removes them from the state.
+func resourceMongoDBAtlasOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMongoDBAtlasOrganizationSettingsCreate,
+		ReadContext:   resourceMongoDBAtlasOrganizationSettingsRead,
+		UpdateContext: resourceMongoDBAtlasOrganizationSettingsUpdate,
+		DeleteContext: resourceMongoDBAtlasOrganizationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_access_list_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"multi_factor_auth_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"restrict_employee_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMongoDBAtlasOrganizationSettingsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	orgID := d.Get("org_id").(string)
+	if err := updateOrganizationSettings(ctx, meta.(*MongoDBClient).AtlasV2, orgID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(orgID)
+
+	return resourceMongoDBAtlasOrganizationSettingsRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasOrganizationSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	connV2 := meta.(*MongoDBClient).AtlasV2
+	orgID := d.Id()
+
+	settings, _, err := connV2.OrganizationsApi.GetOrganizationSettings(ctx, orgID).Execute()
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(errorOrganizationSettingsRead, orgID, err))
+	}
+
+	values := map[string]any{
+		"org_id":                     orgID,
+		"api_access_list_required":   settings.GetApiAccessListRequired(),
+		"multi_factor_auth_required": settings.GetMultiFactorAuthRequired(),
+		"restrict_employee_access":   settings.GetRestrictEmployeeAccess(),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf(errorOrganizationSettingsSetting, key, orgID, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceMongoDBAtlasOrganizationSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := updateOrganizationSettings(ctx, meta.(*MongoDBClient).AtlasV2, d.Id(), d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMongoDBAtlasOrganizationSettingsRead(ctx, d, meta)
+}
+
+func resourceMongoDBAtlasOrganizationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[INFO] settings of organization (%s) removed from the state, their values are left unchanged in Atlas", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func updateOrganizationSettings(ctx context.Context, connV2 *admin.APIClient, orgID string, d *schema.ResourceData) error {
+	settings := newOrganizationSettings(d)
+	if settings.ApiAccessListRequired == nil && settings.MultiFactorAuthRequired == nil && settings.RestrictEmployeeAccess == nil {
+		return nil
+	}
+	if _, _, err := connV2.OrganizationsApi.UpdateOrganizationSettings(ctx, orgID, settings).Execute(); err != nil {
+		return fmt.Errorf(errorOrganizationSettingsUpdate, orgID, err)
+	}
+	return nil
+}
+
+// newOrganizationSettings returns the configured settings, settings that aren't set in the configuration are nil so
+// they keep the value they have in Atlas.
+func newOrganizationSettings(d *schema.ResourceData) *admin.OrganizationSettings {
+	settings := admin.NewOrganizationSettings()
+	if v, ok := d.GetOkExists("api_access_list_required"); ok {
+		settings.ApiAccessListRequired = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("multi_factor_auth_required"); ok {
+		settings.MultiFactorAuthRequired = pointy.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("restrict_employee_access"); ok {
+		settings.RestrictEmployeeAccess = pointy.Bool(v.(bool))
+	}
+	return settings
+}
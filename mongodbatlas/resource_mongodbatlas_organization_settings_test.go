@@ -0,0 +1,80 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigRSOrganizationSettings_basic(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_organization_settings.test"
+		orgID        = os.Getenv("MONGODB_ATLAS_ORG_ID")
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasOrganizationSettingsConfig(orgID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasOrganizationSettings(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "org_id", orgID),
+					resource.TestCheckResourceAttr(resourceName, "restrict_employee_access", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "multi_factor_auth_required"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_access_list_required"),
+				),
+			},
+			{
+				Config: testAccMongoDBAtlasOrganizationSettingsConfig(orgID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasOrganizationSettings(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "restrict_employee_access", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     orgID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// leave the organization as it was
+				Config: testAccMongoDBAtlasOrganizationSettingsConfig(orgID, false),
+			},
+		},
+	})
+}
+
+func testAccCheckMongoDBAtlasOrganizationSettings(resourceName string, restrictEmployeeAccess bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		connV2 := testAccProviderSdkV2.Meta().(*MongoDBClient).AtlasV2
+		settings, _, err := connV2.OrganizationsApi.GetOrganizationSettings(context.Background(), rs.Primary.ID).Execute()
+		if err != nil {
+			return fmt.Errorf("settings of organization (%s) can't be read: %s", rs.Primary.ID, err)
+		}
+		if settings.GetRestrictEmployeeAccess() != restrictEmployeeAccess {
+			return fmt.Errorf("expected restrict_employee_access to be %t in organization (%s)", restrictEmployeeAccess, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccMongoDBAtlasOrganizationSettingsConfig(orgID string, restrictEmployeeAccess bool) string {
+	return fmt.Sprintf(`
+	resource "mongodbatlas_organization_settings" "test" {
+		org_id                   = %[1]q
+		restrict_employee_access = %[2]t
+	}
+	`, orgID, restrictEmployeeAccess)
+}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 )
 
 type permCtxKey string
@@ -66,9 +66,9 @@ func resourceMongoDBAtlasPrivateEndpointRegionalModeRead(ctx context.Context, d
 
 	projectID := d.Id()
 
-	setting, resp, err := conn.PrivateEndpoints.GetRegionalizedPrivateEndpointSetting(context.Background(), projectID)
+	setting, _, err := conn.PrivateEndpoints.GetRegionalizedPrivateEndpointSetting(context.Background(), projectID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -94,9 +94,9 @@ func resourceMongoDBAtlasPrivateEndpointRegionalModeUpdate(ctx context.Context,
 		timeoutKey = schema.TimeoutUpdate
 	}
 
-	_, resp, err := conn.PrivateEndpoints.UpdateRegionalizedPrivateEndpointSetting(ctx, projectID, enabled)
+	_, _, err := conn.PrivateEndpoints.UpdateRegionalizedPrivateEndpointSetting(ctx, projectID, enabled)
 	if err != nil {
-		if resp != nil && resp.Response.StatusCode == 404 {
+		if apierror.IsNotFound(err) {
 			return nil
 		}
 
@@ -105,16 +105,17 @@ func resourceMongoDBAtlasPrivateEndpointRegionalModeUpdate(ctx context.Context,
 
 	log.Println("[INFO] Waiting for MongoDB Clusters' Private Endpoints to be updated")
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"REPEATING", "PENDING"},
-		Target:     []string{"IDLE", "DELETED"},
-		Refresh:    resourceClusterListAdvancedRefreshFunc(ctx, projectID, conn),
-		Timeout:    d.Timeout(timeoutKey.(string)),
-		MinTimeout: 5 * time.Second,
-		Delay:      3 * time.Second,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("pending cluster changes of project %s", projectID),
+		Pending:      []string{"REPEATING", "PENDING"},
+		Target:       []string{"IDLE", "DELETED"},
+		Refresh:      resourceClusterListAdvancedRefreshFunc(ctx, projectID, conn),
+		Timeout:      d.Timeout(timeoutKey.(string)),
+		PollInterval: 5 * time.Second,
+		Delay:        3 * time.Second,
 	}
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf(errorPrivateEndpointRegionalModeUpdate, projectID, err)
 	}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"strings"
 	"time"
 
@@ -13,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -105,6 +106,7 @@ func resourceMongoDBAtlasPrivateLinkEndpoint() *schema.Resource {
 				},
 			},
 		},
+		CustomizeDiff: resourceMongoDBAtlasPrivateLinkEndpointCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Hour),
 			Delete: schema.DefaultTimeout(1 * time.Hour),
@@ -112,6 +114,16 @@ func resourceMongoDBAtlasPrivateLinkEndpoint() *schema.Resource {
 	}
 }
 
+// resourceMongoDBAtlasPrivateLinkEndpointCustomizeDiff rejects the regions that the cloud provider doesn't offer, both
+// the Atlas and the cloud provider names of a region are accepted.
+func resourceMongoDBAtlasPrivateLinkEndpointCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	providerName := ctyString(rawConfigAttr(d, "provider_name"))
+	if providerName == "" {
+		return nil
+	}
+	return validateCatalogRegion("region", providerName, rawConfigAttr(d, "region"))
+}
+
 func resourceMongoDBAtlasPrivateLinkEndpointCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*MongoDBClient).Atlas
 	projectID := d.Get("project_id").(string)
@@ -128,17 +140,18 @@ func resourceMongoDBAtlasPrivateLinkEndpointCreate(ctx context.Context, d *schem
 		return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsCreate, err))
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"INITIATING", "DELETING"},
-		Target:     []string{"WAITING_FOR_USER", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourcePrivateLinkEndpointRefreshFunc(ctx, conn, projectID, providerName, privateEndpointConn.ID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      3 * time.Second,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of private endpoint %s", privateEndpointConn.ID),
+		Pending:      []string{"INITIATING", "DELETING"},
+		Target:       []string{"WAITING_FOR_USER", "FAILED", "DELETED", "AVAILABLE"},
+		Refresh:      resourcePrivateLinkEndpointRefreshFunc(ctx, conn, projectID, providerName, privateEndpointConn.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+		Delay:        3 * time.Second,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsCreate, err))
 	}
@@ -162,9 +175,9 @@ func resourceMongoDBAtlasPrivateLinkEndpointRead(ctx context.Context, d *schema.
 	providerName := ids["provider_name"]
 	region := ids["region"]
 
-	privateEndpoint, resp, err := conn.PrivateEndpoints.Get(context.Background(), projectID, providerName, privateLinkID)
+	privateEndpoint, _, err := conn.PrivateEndpoints.Get(context.Background(), projectID, providerName, privateLinkID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -235,9 +248,9 @@ func resourceMongoDBAtlasPrivateLinkEndpointDelete(ctx context.Context, d *schem
 	projectID := ids["project_id"]
 	providerName := ids["provider_name"]
 
-	resp, err := conn.PrivateEndpoints.Delete(ctx, projectID, providerName, privateLinkID)
+	_, err := conn.PrivateEndpoints.Delete(ctx, projectID, providerName, privateLinkID)
 	if err != nil {
-		if resp.Response.StatusCode == 404 {
+		if apierror.IsNotFound(err) {
 			return nil
 		}
 
@@ -246,16 +259,17 @@ func resourceMongoDBAtlasPrivateLinkEndpointDelete(ctx context.Context, d *schem
 
 	log.Println("[INFO] Waiting for MongoDB Private Endpoints Connection to be destroyed")
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"DELETING"},
-		Target:     []string{"DELETED", "FAILED"},
-		Refresh:    resourcePrivateLinkEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 5 * time.Second,
-		Delay:      3 * time.Second,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of private endpoint %s", privateLinkID),
+		Pending:      []string{"DELETING"},
+		Target:       []string{"DELETED", "FAILED"},
+		Refresh:      resourcePrivateLinkEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: 5 * time.Second,
+		Delay:        3 * time.Second,
 	}
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorPrivateLinkEndpointsDelete, privateLinkID, err))
 	}
@@ -304,9 +318,9 @@ func resourceMongoDBAtlasPrivateLinkEndpointImportState(ctx context.Context, d *
 
 func resourcePrivateLinkEndpointRefreshFunc(ctx context.Context, client *matlas.Client, projectID, providerName, privateLinkID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		p, resp, err := client.PrivateEndpoints.Get(ctx, projectID, providerName, privateLinkID)
+		p, _, err := client.PrivateEndpoints.Get(ctx, projectID, providerName, privateLinkID)
 		if err != nil {
-			if resp.Response.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
 
@@ -5,15 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 )
 
 const (
@@ -85,17 +88,18 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessCreate(ctx context.Context
 		return diag.Errorf(errorServerlessServiceEndpointAdd, privateLinkRequest.CloudProviderEndpointID, err)
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
-		Target:     []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endPoint.ID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Second,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of serverless private endpoint %s", endPoint.ID),
+		Pending:      []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
+		Target:       []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
+		Refresh:      resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endPoint.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Second,
 	}
 	// RESERVATION_REQUESTED, RESERVED, INITIATING, AVAILABLE, FAILED, DELETING.
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorServerlessEndpointAdd, err, endPoint.ID))
 	}
@@ -121,7 +125,7 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessRead(ctx context.Context,
 	if err != nil {
 		// case 404/ 400
 		// deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
+		if apierror.IsNotFound(err) || apierror.HasStatus(err, http.StatusBadRequest) {
 			d.SetId("")
 			return nil
 		}
@@ -164,7 +168,7 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessDelete(ctx context.Context
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
+		if apierror.IsNotFound(err) || apierror.HasStatus(err, http.StatusBadRequest) {
 			d.SetId("")
 			return nil
 		}
@@ -177,16 +181,17 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessDelete(ctx context.Context
 		return diag.Errorf("error deleting serverless private link endpoint(%s): %s", endpointID, err)
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"DELETING"},
-		Target:     []string{"DELETED", "FAILED"},
-		Refresh:    resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endpointID),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Second,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of serverless private endpoint %s", endpointID),
+		Pending:      []string{"DELETING"},
+		Target:       []string{"DELETED", "FAILED"},
+		Refresh:      resourcePrivateLinkEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endpointID),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Second,
 	}
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorServerlessEndpointDelete, endpointID, err))
 	}
@@ -247,9 +252,9 @@ func resourceMongoDBAtlasPrivateLinkEndpointServerlessImportState(ctx context.Co
 
 func resourcePrivateLinkEndpointServerlessRefreshFunc(ctx context.Context, client *matlas.Client, projectID, instanceName, privateLinkID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		p, resp, err := client.ServerlessPrivateEndpoints.Get(ctx, projectID, instanceName, privateLinkID)
+		p, _, err := client.ServerlessPrivateEndpoints.Get(ctx, projectID, instanceName, privateLinkID)
 		if err != nil {
-			if resp.Response.StatusCode == 404 || resp.Response.StatusCode == 400 {
+			if apierror.IsNotFound(err) || apierror.HasStatus(err, http.StatusBadRequest) {
 				return "", "DELETED", nil
 			}
 
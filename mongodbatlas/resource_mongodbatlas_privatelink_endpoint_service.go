@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"strings"
 	"time"
 
@@ -13,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/spf13/cast"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -171,30 +172,32 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkCreate(ctx context.Context, d
 		return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, providerName, privateLinkID, err))
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"NONE", "INITIATING", "PENDING_ACCEPTANCE", "PENDING", "DELETING", "VERIFIED"},
-		Target:     []string{"AVAILABLE", "REJECTED", "DELETED", "FAILED"},
-		Refresh:    resourceServiceEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID, endpointServiceID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of private endpoint service %s", endpointServiceID),
+		Pending:      []string{"NONE", "INITIATING", "PENDING_ACCEPTANCE", "PENDING", "DELETING", "VERIFIED"},
+		Target:       []string{"AVAILABLE", "REJECTED", "DELETED", "FAILED"},
+		Refresh:      resourceServiceEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID, endpointServiceID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Minute,
 	}
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, endpointServiceID, privateLinkID, err))
 	}
 
-	clusterConf := &retry.StateChangeConf{
-		Pending:    []string{"REPEATING", "PENDING"},
-		Target:     []string{"IDLE", "DELETED"},
-		Refresh:    resourceClusterListAdvancedRefreshFunc(ctx, projectID, conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Minute,
+	clusterWaiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("pending cluster changes of project %s", projectID),
+		Pending:      []string{"REPEATING", "PENDING"},
+		Target:       []string{"IDLE", "DELETED"},
+		Refresh:      resourceClusterListAdvancedRefreshFunc(ctx, projectID, conn),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Minute,
 	}
 
-	if _, err = clusterConf.WaitForStateContext(ctx); err != nil {
+	if _, err = clusterWaiter.Wait(ctx); err != nil {
 		// error awaiting advanced clusters IDLE should not result in failure to apply changes to this resource
 		log.Printf(errorAdvancedClusterListStatus, err)
 	}
@@ -218,9 +221,9 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkRead(ctx context.Context, d *
 	endpointServiceID := ids["endpoint_service_id"]
 	providerName := ids["provider_name"]
 
-	privateEndpoint, resp, err := conn.PrivateEndpoints.GetOnePrivateEndpoint(context.Background(), projectID, providerName, privateLinkID, endpointServiceID)
+	privateEndpoint, _, err := conn.PrivateEndpoints.GetOnePrivateEndpoint(context.Background(), projectID, providerName, privateLinkID, endpointServiceID)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -294,31 +297,33 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkDelete(ctx context.Context, d
 			return diag.FromErr(fmt.Errorf(errorEndpointDelete, endpointServiceID, err))
 		}
 
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"NONE", "PENDING_ACCEPTANCE", "PENDING", "DELETING", "INITIATING"},
-			Target:     []string{"REJECTED", "DELETED", "FAILED"},
-			Refresh:    resourceServiceEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID, endpointServiceID),
-			Timeout:    d.Timeout(schema.TimeoutDelete),
-			MinTimeout: 5 * time.Second,
-			Delay:      3 * time.Second,
+		waiter := &retrystrategy.Waiter[any]{
+			Operation:    fmt.Sprintf("deletion of private endpoint service %s", endpointServiceID),
+			Pending:      []string{"NONE", "PENDING_ACCEPTANCE", "PENDING", "DELETING", "INITIATING"},
+			Target:       []string{"REJECTED", "DELETED", "FAILED"},
+			Refresh:      resourceServiceEndpointRefreshFunc(ctx, conn, projectID, providerName, privateLinkID, endpointServiceID),
+			Timeout:      d.Timeout(schema.TimeoutDelete),
+			PollInterval: 5 * time.Second,
+			Delay:        3 * time.Second,
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = waiter.Wait(ctx)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf(errorEndpointDelete, endpointServiceID, err))
 		}
 
-		clusterConf := &retry.StateChangeConf{
-			Pending:    []string{"REPEATING", "PENDING"},
-			Target:     []string{"IDLE", "DELETED"},
-			Refresh:    resourceClusterListAdvancedRefreshFunc(ctx, projectID, conn),
-			Timeout:    d.Timeout(schema.TimeoutDelete),
-			MinTimeout: 5 * time.Second,
-			Delay:      5 * time.Minute,
+		clusterWaiter := &retrystrategy.Waiter[any]{
+			Operation:    fmt.Sprintf("pending cluster changes of project %s", projectID),
+			Pending:      []string{"REPEATING", "PENDING"},
+			Target:       []string{"IDLE", "DELETED"},
+			Refresh:      resourceClusterListAdvancedRefreshFunc(ctx, projectID, conn),
+			Timeout:      d.Timeout(schema.TimeoutDelete),
+			PollInterval: 5 * time.Second,
+			Delay:        5 * time.Minute,
 		}
 
-		if _, err = clusterConf.WaitForStateContext(ctx); err != nil {
+		if _, err = clusterWaiter.Wait(ctx); err != nil {
 			// error awaiting advanced clusters IDLE should not result in failure to apply changes to this resource
 			log.Printf(errorAdvancedClusterListStatus, err)
 		}
@@ -373,9 +378,9 @@ func resourceMongoDBAtlasPrivateEndpointServiceLinkImportState(ctx context.Conte
 
 func resourceServiceEndpointRefreshFunc(ctx context.Context, client *matlas.Client, projectID, providerName, privateLinkID, endpointServiceID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		i, resp, err := client.PrivateEndpoints.GetOnePrivateEndpoint(ctx, projectID, providerName, privateLinkID, endpointServiceID)
+		i, _, err := client.PrivateEndpoints.GetOnePrivateEndpoint(ctx, projectID, providerName, privateLinkID, endpointServiceID)
 		if err != nil {
-			if resp != nil && resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
 
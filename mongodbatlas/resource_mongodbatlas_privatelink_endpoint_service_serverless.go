@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -109,30 +112,32 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessCreate(ctx context.
 		return diag.Errorf(errorServerlessServiceEndpointAdd, endpointID, err)
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
-		Target:     []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourceServiceEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endpointID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of serverless private endpoint service %s", endpointID),
+		Pending:      []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
+		Target:       []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
+		Refresh:      resourceServiceEndpointServerlessRefreshFunc(ctx, conn, projectID, instanceName, endpointID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Minute,
 	}
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorServerlessServiceEndpointAdd, endpointID, err))
 	}
 
-	clusterConf := &retry.StateChangeConf{
-		Pending:    []string{"REPEATING", "PENDING"},
-		Target:     []string{"IDLE", "DELETED"},
-		Refresh:    resourceServerlessInstanceListRefreshFunc(ctx, projectID, conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Minute,
+	clusterWaiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("pending serverless instance changes of project %s", projectID),
+		Pending:      []string{"REPEATING", "PENDING"},
+		Target:       []string{"IDLE", "DELETED"},
+		Refresh:      resourceServerlessInstanceListRefreshFunc(ctx, projectID, conn),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Minute,
 	}
 
-	if _, err = clusterConf.WaitForStateContext(ctx); err != nil {
+	if _, err = clusterWaiter.Wait(ctx); err != nil {
 		// error awaiting serverless instances to IDLE should not result in failure to apply changes to this resource
 		log.Printf(errorServerlessInstanceListStatus, err)
 	}
@@ -158,7 +163,7 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessRead(ctx context.Co
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
+		if apierror.IsNotFound(err) || apierror.HasStatus(err, http.StatusBadRequest) {
 			d.SetId("")
 			return nil
 		}
@@ -215,7 +220,7 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessDelete(ctx context.
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
+		if apierror.IsNotFound(err) || apierror.HasStatus(err, http.StatusBadRequest) {
 			d.SetId("")
 			return nil
 		}
@@ -278,9 +283,9 @@ func resourceMongoDBAtlasPrivateLinkEndpointServiceServerlessImportState(ctx con
 
 func resourceServiceEndpointServerlessRefreshFunc(ctx context.Context, client *matlas.Client, projectID, instanceName, endpointServiceID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		i, resp, err := client.ServerlessPrivateEndpoints.Get(ctx, projectID, instanceName, endpointServiceID)
+		i, _, err := client.ServerlessPrivateEndpoints.Get(ctx, projectID, instanceName, endpointServiceID)
 		if err != nil {
-			if resp != nil && resp.StatusCode == 404 || resp.Response.StatusCode == 400 {
+			if apierror.IsNotFound(err) || apierror.HasStatus(err, http.StatusBadRequest) {
 				return "", "DELETED", nil
 			}
 
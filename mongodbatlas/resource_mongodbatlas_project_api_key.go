@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	"go.mongodb.org/atlas-sdk/v20231001001/admin"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -79,7 +79,6 @@ func resourceMongoDBAtlasProjectAPIKeyCreate(ctx context.Context, d *schema.Reso
 
 	var apiKey *matlas.APIKey
 	var err error
-	var resp *matlas.Response
 
 	createRequest.Desc = d.Get("description").(string)
 	if projectAssignments, ok := d.GetOk("project_assignment"); ok {
@@ -87,9 +86,9 @@ func resourceMongoDBAtlasProjectAPIKeyCreate(ctx context.Context, d *schema.Reso
 		for _, apiKeyList := range projectAssignmentList {
 			if apiKeyList.ProjectID == projectID {
 				createRequest.Roles = apiKeyList.RoleNames
-				apiKey, resp, err = conn.ProjectAPIKeys.Create(ctx, projectID, createRequest)
+				apiKey, _, err = conn.ProjectAPIKeys.Create(ctx, projectID, createRequest)
 				if err != nil {
-					if resp != nil && resp.StatusCode == http.StatusNotFound {
+					if apierror.IsNotFound(err) {
 						d.SetId("")
 						return nil
 					}
@@ -104,7 +103,7 @@ func resourceMongoDBAtlasProjectAPIKeyCreate(ctx context.Context, d *schema.Reso
 					Roles: createRequest.Roles,
 				})
 				if err != nil {
-					if resp != nil && resp.StatusCode == http.StatusNotFound {
+					if apierror.IsNotFound(err) {
 						d.SetId("")
 						return nil
 					}
@@ -3,12 +3,12 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -67,11 +67,11 @@ func resourceMongoDBAtlasProjectInvitationRead(ctx context.Context, d *schema.Re
 	username := ids["username"]
 	invitationID := ids["invitation_id"]
 
-	projectInvitation, resp, err := conn.Projects.Invitation(ctx, projectID, invitationID)
+	projectInvitation, _, err := conn.Projects.Invitation(ctx, projectID, invitationID)
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -13,10 +14,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/util"
 	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+	"golang.org/x/exp/slices"
 )
 
+const vectorSearchMaxDimensions = 4096
+
+var vectorSearchSimilarities = []string{"euclidean", "cosine", "dotProduct"}
+
 func resourceMongoDBAtlasSearchIndex() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMongoDBAtlasSearchIndexCreate,
@@ -26,7 +36,8 @@ func resourceMongoDBAtlasSearchIndex() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasSearchIndexImportState,
 		},
-		Schema: returnSearchIndexSchema(),
+		Schema:        returnSearchIndexSchema(),
+		CustomizeDiff: resourceSearchIndexCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Hour),
 			Update: schema.DefaultTimeout(3 * time.Hour),
@@ -111,6 +122,19 @@ func returnSearchIndexSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"type": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringInSlice([]string{searchIndexTypeSearch, searchIndexTypeVectorSearch}, false),
+			DiffSuppressFunc: validateSearchIndexTypeDiff,
+		},
+		"fields": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: validateSearchIndexMappingDiff,
+		},
 		"wait_for_index_build_completion": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -128,12 +152,18 @@ func resourceMongoDBAtlasSearchIndexImportState(ctx context.Context, d *schema.R
 	clusterName := parts[1]
 	indexID := parts[2]
 
-	connV2 := meta.(*MongoDBClient).AtlasV2
-	_, _, err := connV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, clusterName, indexID).Execute()
+	// the versioned API is used as the SDK doesn't expose the type of the index
+	searchIndex, err := getVersionedSearchIndex(ctx, meta.(*MongoDBClient).Atlas, projectID, clusterName, indexID)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't import search index (%s) in projectID (%s) and Cluster (%s), error: %s", indexID, projectID, clusterName, err)
 	}
 
+	if searchIndex.Type == searchIndexTypeVectorSearch {
+		if err := d.Set("type", searchIndex.Type); err != nil {
+			log.Printf("[WARN] Error setting type for (%s): %s", indexID, err)
+		}
+	}
+
 	if err := d.Set("project_id", projectID); err != nil {
 		log.Printf("[WARN] Error setting project_id for (%s): %s", projectID, err)
 	}
@@ -176,9 +206,47 @@ func resourceMongoDBAtlasSearchIndexUpdate(ctx context.Context, d *schema.Resour
 	clusterName := ids["cluster_name"]
 	indexID := ids["index_id"]
 
+	if d.Get("type").(string) == searchIndexTypeVectorSearch {
+		conn := meta.(*MongoDBClient).Atlas
+		if _, err := updateVersionedSearchIndex(ctx, conn, projectID, clusterName, indexID, newVectorSearchIndex(d)); err != nil {
+			return diag.Errorf("error updating search index (%s): %s", d.Get("name").(string), err)
+		}
+	} else if err := updateAtlasSearchIndex(ctx, d, connV2, projectID, clusterName, indexID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_index_build_completion").(bool) {
+		timeout := d.Timeout(schema.TimeoutCreate)
+		waiter := &retrystrategy.Waiter[any]{
+			Operation:    fmt.Sprintf("update of search index %s", indexID),
+			Pending:      []string{"IN_PROGRESS", "MIGRATING"},
+			Target:       []string{"STEADY"},
+			Refresh:      resourceSearchIndexRefreshFunc(ctx, clusterName, projectID, indexID, connV2),
+			Timeout:      timeout,
+			PollInterval: 1 * time.Minute,
+			Delay:        1 * time.Minute,
+		}
+
+		// Wait, catching any errors
+		if _, err := waiter.Wait(ctx); err != nil {
+			d.SetId(encodeStateID(map[string]string{
+				"project_id":   projectID,
+				"cluster_name": clusterName,
+				"index_id":     indexID,
+			}))
+			resourceMongoDBAtlasSearchIndexDelete(ctx, d, meta)
+			d.SetId("")
+			return diag.FromErr(fmt.Errorf("error creating index in cluster (%s): %s", clusterName, err))
+		}
+	}
+
+	return resourceMongoDBAtlasSearchIndexRead(ctx, d, meta)
+}
+
+func updateAtlasSearchIndex(ctx context.Context, d *schema.ResourceData, connV2 *admin.APIClient, projectID, clusterName, indexID string) error {
 	searchIndex, _, err := connV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, clusterName, indexID).Execute()
 	if err != nil {
-		return diag.Errorf("error getting search index information: %s", err)
+		return fmt.Errorf("error getting search index information: %s", err)
 	}
 
 	if d.HasChange("analyzer") {
@@ -220,35 +288,9 @@ func resourceMongoDBAtlasSearchIndexUpdate(ctx context.Context, d *schema.Resour
 
 	searchIndex.IndexID = stringPtr("")
 	if _, _, err := connV2.AtlasSearchApi.UpdateAtlasSearchIndex(ctx, projectID, clusterName, indexID, searchIndex).Execute(); err != nil {
-		return diag.Errorf("error updating search index (%s): %s", searchIndex.Name, err)
+		return fmt.Errorf("error updating search index (%s): %s", searchIndex.Name, err)
 	}
-
-	if d.Get("wait_for_index_build_completion").(bool) {
-		timeout := d.Timeout(schema.TimeoutCreate)
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"IN_PROGRESS", "MIGRATING"},
-			Target:     []string{"STEADY"},
-			Refresh:    resourceSearchIndexRefreshFunc(ctx, clusterName, projectID, indexID, connV2),
-			Timeout:    timeout,
-			MinTimeout: 1 * time.Minute,
-			Delay:      1 * time.Minute,
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
-			d.SetId(encodeStateID(map[string]string{
-				"project_id":   projectID,
-				"cluster_name": clusterName,
-				"index_id":     indexID,
-			}))
-			resourceMongoDBAtlasSearchIndexDelete(ctx, d, meta)
-			d.SetId("")
-			return diag.FromErr(fmt.Errorf("error creating index in cluster (%s): %s", clusterName, err))
-		}
-	}
-
-	return resourceMongoDBAtlasSearchIndexRead(ctx, d, meta)
+	return nil
 }
 
 func resourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -257,11 +299,15 @@ func resourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resource
 	clusterName := ids["cluster_name"]
 	indexID := ids["index_id"]
 
+	if d.Get("type").(string) == searchIndexTypeVectorSearch {
+		return resourceMongoDBAtlasVectorSearchIndexRead(ctx, d, meta.(*MongoDBClient).Atlas, projectID, clusterName, indexID)
+	}
+
 	connV2 := meta.(*MongoDBClient).AtlasV2
-	searchIndex, resp, err := connV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, clusterName, indexID).Execute()
+	searchIndex, _, err := connV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, clusterName, indexID).Execute()
 	if err != nil {
 		// deleted in the backend case
-		if resp.StatusCode == 404 && !d.IsNewResource() {
+		if apierror.IsNotFound(err) && !d.IsNewResource() {
 			d.SetId("")
 			return nil
 		}
@@ -303,7 +349,7 @@ func resourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resource
 		return diag.Errorf("error setting `searchAnalyzer` for search index (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("mappings_dynamic", searchIndex.Mappings.Dynamic); err != nil {
+	if err := d.Set("mappings_dynamic", searchIndex.GetMappings().Dynamic); err != nil {
 		return diag.Errorf("error setting `mappings_dynamic` for search index (%s): %s", d.Id(), err)
 	}
 
@@ -311,7 +357,7 @@ func resourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resource
 		return diag.Errorf("error setting `synonyms` for search index (%s): %s", d.Id(), err)
 	}
 
-	if len(searchIndex.Mappings.Fields) > 0 {
+	if len(searchIndex.GetMappings().Fields) > 0 {
 		searchIndexMappingFields, err := marshalSearchIndex(searchIndex.Mappings.Fields)
 		if err != nil {
 			return diag.FromErr(err)
@@ -325,6 +371,51 @@ func resourceMongoDBAtlasSearchIndexRead(ctx context.Context, d *schema.Resource
 	return nil
 }
 
+func resourceMongoDBAtlasVectorSearchIndexRead(ctx context.Context, d *schema.ResourceData, conn *matlas.Client, projectID, clusterName, indexID string) diag.Diagnostics {
+	searchIndex, err := getVersionedSearchIndex(ctx, conn, projectID, clusterName, indexID)
+	if err != nil {
+		// deleted in the backend case
+		if apierror.IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting search index information: %s", err)
+	}
+
+	if err := d.Set("index_id", indexID); err != nil {
+		return diag.Errorf("error setting `index_id` for search index (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("collection_name", searchIndex.CollectionName); err != nil {
+		return diag.Errorf("error setting `collectionName` for search index (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("database", searchIndex.Database); err != nil {
+		return diag.Errorf("error setting `database` for search index (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("name", searchIndex.Name); err != nil {
+		return diag.Errorf("error setting `name` for search index (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("type", searchIndex.Type); err != nil {
+		return diag.Errorf("error setting `type` for search index (%s): %s", d.Id(), err)
+	}
+
+	if len(searchIndex.Fields) > 0 {
+		searchIndexFields, err := marshalSearchIndex(searchIndex.Fields)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set("fields", searchIndexFields); err != nil {
+			return diag.Errorf("error setting `fields` for search index (%s): %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
 func flattenSearchIndexSynonyms(synonyms []admin.SearchSynonymMappingDefinition) []map[string]any {
 	synonymsMap := make([]map[string]any, len(synonyms))
 	for i, s := range synonyms {
@@ -346,40 +437,51 @@ func resourceMongoDBAtlasSearchIndexCreate(ctx context.Context, d *schema.Resour
 	connV2 := meta.(*MongoDBClient).AtlasV2
 	projectID := d.Get("project_id").(string)
 	clusterName := d.Get("cluster_name").(string)
-	dynamic := d.Get("mappings_dynamic").(bool)
-	searchIndexRequest := &admin.ClusterSearchIndex{
-		Analyzer:       stringPtr(d.Get("analyzer").(string)),
-		Analyzers:      unmarshalSearchIndexAnalyzersFields(d.Get("analyzers").(string)),
-		CollectionName: d.Get("collection_name").(string),
-		Database:       d.Get("database").(string),
-		Mappings: &admin.ApiAtlasFTSMappings{
-			Dynamic: &dynamic,
-			Fields:  unmarshalSearchIndexMappingFields(d.Get("mappings_fields").(string)),
-		},
-		Name:           d.Get("name").(string),
-		SearchAnalyzer: stringPtr(d.Get("search_analyzer").(string)),
-		Status:         stringPtr(d.Get("status").(string)),
-		Synonyms:       expandSearchIndexSynonyms(d),
-	}
-	dbSearchIndexRes, _, err := connV2.AtlasSearchApi.CreateAtlasSearchIndex(ctx, projectID, clusterName, searchIndexRequest).Execute()
-	if err != nil {
-		return diag.Errorf("error creating index: %s", err)
+
+	var indexID string
+	if d.Get("type").(string) == searchIndexTypeVectorSearch {
+		searchIndex, err := createVersionedSearchIndex(ctx, meta.(*MongoDBClient).Atlas, projectID, clusterName, newVectorSearchIndex(d))
+		if err != nil {
+			return diag.Errorf("error creating index: %s", err)
+		}
+		indexID = util.SafeString(searchIndex.IndexID)
+	} else {
+		dynamic := d.Get("mappings_dynamic").(bool)
+		searchIndexRequest := &admin.ClusterSearchIndex{
+			Analyzer:       stringPtr(d.Get("analyzer").(string)),
+			Analyzers:      unmarshalSearchIndexAnalyzersFields(d.Get("analyzers").(string)),
+			CollectionName: d.Get("collection_name").(string),
+			Database:       d.Get("database").(string),
+			Mappings: &admin.ApiAtlasFTSMappings{
+				Dynamic: &dynamic,
+				Fields:  unmarshalSearchIndexMappingFields(d.Get("mappings_fields").(string)),
+			},
+			Name:           d.Get("name").(string),
+			SearchAnalyzer: stringPtr(d.Get("search_analyzer").(string)),
+			Status:         stringPtr(d.Get("status").(string)),
+			Synonyms:       expandSearchIndexSynonyms(d),
+		}
+		dbSearchIndexRes, _, err := connV2.AtlasSearchApi.CreateAtlasSearchIndex(ctx, projectID, clusterName, searchIndexRequest).Execute()
+		if err != nil {
+			return diag.Errorf("error creating index: %s", err)
+		}
+		indexID = util.SafeString(dbSearchIndexRes.IndexID)
 	}
-	indexID := util.SafeString(dbSearchIndexRes.IndexID)
+
 	if d.Get("wait_for_index_build_completion").(bool) {
 		timeout := d.Timeout(schema.TimeoutCreate)
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"IN_PROGRESS", "MIGRATING"},
-			Target:     []string{"STEADY"},
-			Refresh:    resourceSearchIndexRefreshFunc(ctx, clusterName, projectID, indexID, connV2),
-			Timeout:    timeout,
-			MinTimeout: 1 * time.Minute,
-			Delay:      1 * time.Minute,
+		waiter := &retrystrategy.Waiter[any]{
+			Operation:    fmt.Sprintf("creation of search index %s", indexID),
+			Pending:      []string{"IN_PROGRESS", "MIGRATING"},
+			Target:       []string{"STEADY"},
+			Refresh:      resourceSearchIndexRefreshFunc(ctx, clusterName, projectID, indexID, connV2),
+			Timeout:      timeout,
+			PollInterval: 1 * time.Minute,
+			Delay:        1 * time.Minute,
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
+		if _, err := waiter.Wait(ctx); err != nil {
 			d.SetId(encodeStateID(map[string]string{
 				"project_id":   projectID,
 				"cluster_name": clusterName,
@@ -399,6 +501,18 @@ func resourceMongoDBAtlasSearchIndexCreate(ctx context.Context, d *schema.Resour
 	return resourceMongoDBAtlasSearchIndexRead(ctx, d, meta)
 }
 
+func newVectorSearchIndex(d *schema.ResourceData) *versionedSearchIndex {
+	return &versionedSearchIndex{
+		ClusterSearchIndex: admin.ClusterSearchIndex{
+			CollectionName: d.Get("collection_name").(string),
+			Database:       d.Get("database").(string),
+			Name:           d.Get("name").(string),
+		},
+		Type:   searchIndexTypeVectorSearch,
+		Fields: unmarshalSearchIndexFields(d.Get("fields").(string)),
+	}
+}
+
 func expandSearchIndexSynonyms(d *schema.ResourceData) []admin.SearchSynonymMappingDefinition {
 	var synonymsList []admin.SearchSynonymMappingDefinition
 	if vSynonyms, ok := d.GetOk("synonyms"); ok {
@@ -442,6 +556,20 @@ func validateSearchIndexMappingDiff(k, old, newStr string, d *schema.ResourceDat
 	return true
 }
 
+// validateSearchIndexTypeDiff treats an unset type as search, the type of the indexes created before vector search
+// was supported.
+func validateSearchIndexTypeDiff(k, old, newStr string, d *schema.ResourceData) bool {
+	if old == "" {
+		old = searchIndexTypeSearch
+	}
+
+	if newStr == "" {
+		newStr = searchIndexTypeSearch
+	}
+
+	return old == newStr
+}
+
 func validateSearchAnalyzersDiff(k, old, newStr string, d *schema.ResourceData) bool {
 	var j, j2 any
 
@@ -479,6 +607,18 @@ func unmarshalSearchIndexMappingFields(mappingString string) map[string]any {
 	return fields
 }
 
+func unmarshalSearchIndexFields(fieldsString string) []map[string]any {
+	if fieldsString == "" {
+		return nil
+	}
+	var fields []map[string]any
+	if err := json.Unmarshal([]byte(fieldsString), &fields); err != nil {
+		log.Printf("[ERROR] cannot unmarshal search index fields: %v", err)
+		return nil
+	}
+	return fields
+}
+
 func unmarshalSearchIndexAnalyzersFields(mappingString string) []admin.ApiAtlasFTSAnalyzers {
 	if mappingString == "" {
 		return nil
@@ -491,6 +631,78 @@ func unmarshalSearchIndexAnalyzersFields(mappingString string) []admin.ApiAtlasF
 	return fields
 }
 
+func resourceSearchIndexCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Get("type").(string) != searchIndexTypeVectorSearch {
+		if d.Get("fields").(string) != "" {
+			return errors.New("`fields` can only be set for indexes of type vectorSearch")
+		}
+		return nil
+	}
+
+	for _, attr := range []string{"analyzer", "analyzers", "search_analyzer", "mappings_fields"} {
+		if d.Get(attr).(string) != "" {
+			return fmt.Errorf("`%s` can't be set for indexes of type vectorSearch", attr)
+		}
+	}
+	if d.Get("mappings_dynamic").(bool) {
+		return errors.New("`mappings_dynamic` can't be set for indexes of type vectorSearch")
+	}
+	if d.Get("synonyms").(*schema.Set).Len() > 0 {
+		return errors.New("`synonyms` can't be set for indexes of type vectorSearch")
+	}
+
+	// fields can reference attributes of other resources and only be known at apply time
+	if !d.NewValueKnown("fields") {
+		return nil
+	}
+	return validateVectorSearchFields(d.Get("fields").(string))
+}
+
+// validateVectorSearchFields checks the definition of the fields of a vector search index: vector fields need the
+// number of dimensions and the similarity function of the embeddings, filter fields only the path to index.
+func validateVectorSearchFields(fieldsString string) error {
+	if fieldsString == "" {
+		return errors.New("`fields` must be set for indexes of type vectorSearch")
+	}
+	var fields []map[string]any
+	if err := json.Unmarshal([]byte(fieldsString), &fields); err != nil {
+		return fmt.Errorf("`fields` must be a JSON array of field definitions: %s", err)
+	}
+	if len(fields) == 0 {
+		return errors.New("`fields` must contain at least one field definition")
+	}
+
+	hasVector := false
+	for i, field := range fields {
+		if path, _ := field["path"].(string); path == "" {
+			return fmt.Errorf("field %d: `path` must be set", i)
+		}
+		switch field["type"] {
+		case "vector":
+			hasVector = true
+			numDimensions, ok := field["numDimensions"].(float64)
+			if !ok || numDimensions != float64(int(numDimensions)) || numDimensions < 1 || numDimensions > vectorSearchMaxDimensions {
+				return fmt.Errorf("field %d: `numDimensions` must be an integer between 1 and %d", i, vectorSearchMaxDimensions)
+			}
+			if similarity, _ := field["similarity"].(string); !slices.Contains(vectorSearchSimilarities, similarity) {
+				return fmt.Errorf("field %d: `similarity` must be one of %s", i, strings.Join(vectorSearchSimilarities, ", "))
+			}
+		case "filter":
+			for _, attr := range []string{"numDimensions", "similarity"} {
+				if _, ok := field[attr]; ok {
+					return fmt.Errorf("field %d: `%s` can't be set for filter fields", i, attr)
+				}
+			}
+		default:
+			return fmt.Errorf("field %d: `type` must be vector or filter", i)
+		}
+	}
+	if !hasVector {
+		return errors.New("`fields` must contain at least one field of type vector")
+	}
+	return nil
+}
+
 func resourceSearchIndexRefreshFunc(ctx context.Context, clusterName, projectID, indexID string, connV2 *admin.APIClient) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		searchIndex, resp, err := connV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, clusterName, indexID).Execute()
@@ -501,10 +713,10 @@ func resourceSearchIndexRefreshFunc(ctx context.Context, clusterName, projectID,
 		if err != nil && searchIndex == nil && resp == nil {
 			return nil, "", err
 		} else if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
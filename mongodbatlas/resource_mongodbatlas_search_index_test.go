@@ -9,6 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
 func TestAccClusterRSSearchIndex_basic(t *testing.T) {
@@ -157,6 +160,150 @@ func TestAccClusterRSSearchIndex_importBasic(t *testing.T) {
 	})
 }
 
+func TestClusterRSSearchIndex_offline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	projectID := server.CreateProject(offlineOrgID, "offline-project")
+	if err := server.CreateCluster(projectID, &matlas.AdvancedCluster{Name: "offline-cluster"}); err != nil {
+		t.Fatalf("unexpected error seeding cluster: %s", err)
+	}
+	resourceName := "mongodbatlas_search_index.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasSearchIndexDestroyOffline(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasSearchIndexConfigOffline(projectID, "offline-cluster", "lucene.standard"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "index_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "name_test"),
+					resource.TestCheckResourceAttr(resourceName, "mappings_dynamic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "search_analyzer", "lucene.standard"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasSearchIndexConfigOffline(projectID, "offline-cluster", "lucene.simple"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "search_analyzer", "lucene.simple"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterRSSearchIndex_withVector(t *testing.T) {
+	var (
+		resourceName   = "mongodbatlas_search_index.test"
+		datasourceName = "data.mongodbatlas_search_index.test"
+		clusterName    = acctest.RandomWithPrefix("test-acc-index")
+		projectID      = os.Getenv("MONGODB_ATLAS_PROJECT_ID")
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckBasic(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasSearchIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMongoDBAtlasSearchIndexConfigVector(projectID, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMongoDBAtlasSearchIndexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "vector_test"),
+					resource.TestCheckResourceAttr(resourceName, "type", "vectorSearch"),
+					resource.TestCheckResourceAttrSet(resourceName, "fields"),
+					resource.TestCheckResourceAttr(datasourceName, "type", "vectorSearch"),
+					resource.TestCheckResourceAttrSet(datasourceName, "fields"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasSearchIndexImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_index_build_completion"},
+			},
+		},
+	})
+}
+
+func TestClusterRSSearchIndex_vectorOffline(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	projectID := server.CreateProject(offlineOrgID, "offline-project")
+	if err := server.CreateCluster(projectID, &matlas.AdvancedCluster{Name: "offline-cluster"}); err != nil {
+		t.Fatalf("unexpected error seeding cluster: %s", err)
+	}
+	resourceName := "mongodbatlas_search_index.test"
+	datasourceName := "data.mongodbatlas_search_indexes.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testOfflinePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderV6Factories,
+		CheckDestroy:             testAccCheckMongoDBAtlasSearchIndexDestroyOffline(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasSearchIndexConfigVectorOffline(projectID, "offline-cluster", 1536),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "index_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "vectorSearch"),
+					resource.TestCheckResourceAttr(resourceName, "fields",
+						`[{"numDimensions":1536,"path":"plot_embedding","similarity":"cosine","type":"vector"},{"path":"genres","type":"filter"}]`),
+					resource.TestCheckResourceAttr(datasourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "results.0.type", "vectorSearch"),
+					resource.TestCheckResourceAttrSet(datasourceName, "results.0.fields"),
+				),
+			},
+			{
+				Config: testOfflineProviderConfig(server) + testAccMongoDBAtlasSearchIndexConfigVectorOffline(projectID, "offline-cluster", 768),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fields",
+						`[{"numDimensions":768,"path":"plot_embedding","similarity":"cosine","type":"vector"},{"path":"genres","type":"filter"}]`),
+				),
+			},
+			{
+				Config:                  testOfflineProviderConfig(server) + testAccMongoDBAtlasSearchIndexConfigVectorOffline(projectID, "offline-cluster", 768),
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       testAccCheckMongoDBAtlasSearchIndexImportStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_index_build_completion"},
+			},
+		},
+	})
+}
+
+func TestValidateVectorSearchFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  string
+		wantErr bool
+	}{
+		{name: "vector and filter", fields: `[{"type":"vector","path":"embedding","numDimensions":1536,"similarity":"cosine"},{"type":"filter","path":"genre"}]`},
+		{name: "dot product", fields: `[{"type":"vector","path":"embedding","numDimensions":4096,"similarity":"dotProduct"}]`},
+		{name: "unset", fields: "", wantErr: true},
+		{name: "not an array", fields: `{"type":"vector"}`, wantErr: true},
+		{name: "empty", fields: `[]`, wantErr: true},
+		{name: "only filters", fields: `[{"type":"filter","path":"genre"}]`, wantErr: true},
+		{name: "missing path", fields: `[{"type":"vector","numDimensions":3,"similarity":"cosine"}]`, wantErr: true},
+		{name: "missing dimensions", fields: `[{"type":"vector","path":"embedding","similarity":"cosine"}]`, wantErr: true},
+		{name: "fractional dimensions", fields: `[{"type":"vector","path":"embedding","numDimensions":1.5,"similarity":"cosine"}]`, wantErr: true},
+		{name: "too many dimensions", fields: `[{"type":"vector","path":"embedding","numDimensions":4097,"similarity":"cosine"}]`, wantErr: true},
+		{name: "unknown similarity", fields: `[{"type":"vector","path":"embedding","numDimensions":3,"similarity":"manhattan"}]`, wantErr: true},
+		{name: "filter with similarity", fields: `[{"type":"vector","path":"embedding","numDimensions":3,"similarity":"cosine"},{"type":"filter","path":"genre","similarity":"cosine"}]`, wantErr: true},
+		{name: "unknown type", fields: `[{"type":"string","path":"title"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateVectorSearchFields(tt.fields); (err != nil) != tt.wantErr {
+				t.Errorf("validateVectorSearchFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func testAccCheckMongoDBAtlasSearchIndexExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -225,6 +372,108 @@ func testAccMongoDBAtlasSearchIndexConfig(projectID, clusterName string) string
 	`, projectID, clusterName)
 }
 
+func testAccMongoDBAtlasSearchIndexConfigOffline(projectID, clusterName, searchAnalyzer string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_search_index" "test" {
+			project_id                      = "%[1]s"
+			cluster_name                    = "%[2]s"
+			collection_name                 = "collection_test"
+			database                        = "database_test"
+			mappings_dynamic                = true
+			name                            = "name_test"
+			search_analyzer                 = "%[3]s"
+			wait_for_index_build_completion = false
+		}
+	`, projectID, clusterName, searchAnalyzer)
+}
+
+func testAccMongoDBAtlasSearchIndexConfigVectorOffline(projectID, clusterName string, numDimensions int) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_search_index" "test" {
+			project_id      = "%[1]s"
+			cluster_name    = "%[2]s"
+			collection_name = "collection_test"
+			database        = "database_test"
+			name            = "vector_test"
+			type            = "vectorSearch"
+			fields = jsonencode([
+				{
+					type          = "vector"
+					path          = "plot_embedding"
+					numDimensions = %[3]d
+					similarity    = "cosine"
+				},
+				{
+					type = "filter"
+					path = "genres"
+				}
+			])
+			wait_for_index_build_completion = false
+		}
+
+		data "mongodbatlas_search_indexes" "test" {
+			project_id      = mongodbatlas_search_index.test.project_id
+			cluster_name    = mongodbatlas_search_index.test.cluster_name
+			database        = mongodbatlas_search_index.test.database
+			collection_name = mongodbatlas_search_index.test.collection_name
+		}
+	`, projectID, clusterName, numDimensions)
+}
+
+func testAccMongoDBAtlasSearchIndexConfigVector(projectID, clusterName string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_cluster" "aws_conf" {
+			project_id   = "%[1]s"
+			name         = "%[2]s"
+			disk_size_gb = 10
+
+			cluster_type = "REPLICASET"
+			replication_specs {
+				num_shards = 1
+				regions_config {
+					region_name     = "US_EAST_2"
+					electable_nodes = 3
+					priority        = 7
+					read_only_nodes = 0
+				}
+			}
+			backup_enabled               = false
+			auto_scaling_disk_gb_enabled = false
+
+			// Provider Settings "block"
+			provider_name               = "AWS"
+			provider_instance_size_name = "M10"
+		}
+
+		resource "mongodbatlas_search_index" "test" {
+			project_id      = mongodbatlas_cluster.aws_conf.project_id
+			cluster_name    = mongodbatlas_cluster.aws_conf.name
+			collection_name = "collection_test"
+			database        = "database_test"
+			name            = "vector_test"
+			type            = "vectorSearch"
+			fields = jsonencode([
+				{
+					type          = "vector"
+					path          = "plot_embedding"
+					numDimensions = 1536
+					similarity    = "euclidean"
+				},
+				{
+					type = "filter"
+					path = "genres"
+				}
+			])
+		}
+
+		data "mongodbatlas_search_index" "test" {
+			project_id   = mongodbatlas_search_index.test.project_id
+			cluster_name = mongodbatlas_search_index.test.cluster_name
+			index_id     = mongodbatlas_search_index.test.index_id
+		}
+	`, projectID, clusterName)
+}
+
 func testAccMongoDBAtlasSearchIndexConfigAdvanced(projectID, clusterName string) string {
 	return fmt.Sprintf(`
 		resource "mongodbatlas_cluster" "aws_conf" {
@@ -410,6 +659,26 @@ func testAccCheckMongoDBAtlasSearchIndexDestroy(state *terraform.State) error {
 	return nil
 }
 
+func testAccCheckMongoDBAtlasSearchIndexDestroyOffline(server *atlastest.Server) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connV2 := testOfflineClient(server).AtlasV2
+
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "mongodbatlas_search_index" {
+				continue
+			}
+
+			ids := decodeStateID(rs.Primary.ID)
+
+			if _, _, err := connV2.AtlasSearchApi.GetAtlasSearchIndex(context.Background(), ids["project_id"], ids["cluster_name"], ids["index_id"]).Execute(); !apierror.IsNotFound(err) {
+				return fmt.Errorf("index id (%s) still exists", ids["index_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckMongoDBAtlasSearchIndexImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -5,13 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
 	"github.com/mwielbut/pointy"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
@@ -30,10 +32,20 @@ func resourceMongoDBAtlasServerlessInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMongoDBAtlasServerlessInstanceImportState,
 		},
-		Schema: returnServerlessInstanceSchema(),
+		Schema:        returnServerlessInstanceSchema(),
+		CustomizeDiff: resourceMongoDBAtlasServerlessInstanceCustomizeDiff,
 	}
 }
 
+// resourceMongoDBAtlasServerlessInstanceCustomizeDiff rejects the regions that the backing provider doesn't offer.
+func resourceMongoDBAtlasServerlessInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	backingProviderName := ctyString(rawConfigAttr(d, "provider_settings_backing_provider_name"))
+	if backingProviderName == "" {
+		return nil
+	}
+	return validateCatalogRegion("provider_settings_region_name", backingProviderName, rawConfigAttr(d, "provider_settings_region_name"))
+}
+
 func resourceMongoDBAtlasServerlessInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Get client connection.
 	conn := meta.(*MongoDBClient).Atlas
@@ -61,17 +73,18 @@ func resourceMongoDBAtlasServerlessInstanceUpdate(ctx context.Context, d *schema
 			return diag.Errorf("error updating serverless instance: %s", err)
 		}
 
-		stateConf := &retry.StateChangeConf{
-			Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
-			Target:     []string{"IDLE"},
-			Refresh:    resourceServerlessInstanceRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
-			Timeout:    3 * time.Hour,
-			MinTimeout: 1 * time.Minute,
-			Delay:      3 * time.Minute,
+		waiter := &retrystrategy.Waiter[any]{
+			Operation:    fmt.Sprintf("update of serverless instance %s", d.Get("name").(string)),
+			Pending:      []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+			Target:       []string{"IDLE"},
+			Refresh:      resourceServerlessInstanceRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
+			Timeout:      3 * time.Hour,
+			PollInterval: 1 * time.Minute,
+			Delay:        3 * time.Minute,
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForStateContext(ctx)
+		_, err = waiter.Wait(ctx)
 		if err != nil {
 			return diag.Errorf("error updating MongoDB Serverless Instance: %s", err)
 		}
@@ -208,17 +221,18 @@ func resourceMongoDBAtlasServerlessInstanceDelete(ctx context.Context, d *schema
 
 	log.Println("[INFO] Waiting for MongoDB Serverless Instance to be destroyed")
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
-		Target:     []string{"DELETED"},
-		Refresh:    resourceServerlessInstanceRefreshFunc(ctx, serverlessName, projectID, conn),
-		Timeout:    3 * time.Hour,
-		MinTimeout: 30 * time.Second,
-		Delay:      1 * time.Minute, // Wait 30 secs before starting
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("deletion of serverless instance %s", serverlessName),
+		Pending:      []string{"IDLE", "CREATING", "UPDATING", "REPAIRING", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      resourceServerlessInstanceRefreshFunc(ctx, serverlessName, projectID, conn),
+		Timeout:      3 * time.Hour,
+		PollInterval: 30 * time.Second,
+		Delay:        1 * time.Minute, // Wait 30 secs before starting
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting MongoDB Serverless Instance (%s): %s", serverlessName, err))
 	}
@@ -237,7 +251,7 @@ func resourceMongoDBAtlasServerlessInstanceRead(ctx context.Context, d *schema.R
 	if err != nil {
 		// case 404
 		// deleted in the backend case
-		reset := strings.Contains(err.Error(), "404") && !d.IsNewResource()
+		reset := apierror.IsNotFound(err) && !d.IsNewResource()
 
 		if reset {
 			d.SetId("")
@@ -336,17 +350,18 @@ func resourceMongoDBAtlasServerlessInstanceCreate(ctx context.Context, d *schema
 		return diag.Errorf("error creating serverless instance: %s", err)
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
-		Target:     []string{"IDLE"},
-		Refresh:    resourceServerlessInstanceRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
-		Timeout:    3 * time.Hour,
-		MinTimeout: 1 * time.Minute,
-		Delay:      3 * time.Minute,
+	waiter := &retrystrategy.Waiter[any]{
+		Operation:    fmt.Sprintf("creation of serverless instance %s", d.Get("name").(string)),
+		Pending:      []string{"CREATING", "UPDATING", "REPAIRING", "REPEATING", "PENDING"},
+		Target:       []string{"IDLE"},
+		Refresh:      resourceServerlessInstanceRefreshFunc(ctx, d.Get("name").(string), projectID, conn),
+		Timeout:      3 * time.Hour,
+		PollInterval: 1 * time.Minute,
+		Delay:        3 * time.Minute,
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return diag.Errorf("error creating MongoDB Serverless Instance: %s", err)
 	}
@@ -363,17 +378,17 @@ func resourceServerlessInstanceRefreshFunc(ctx context.Context, name, projectID
 	return func() (any, string, error) {
 		c, resp, err := client.ServerlessInstances.Get(ctx, projectID, name)
 
-		if err != nil && strings.Contains(err.Error(), "reset by peer") {
+		if apierror.IsTransientNetworkError(err) {
 			return nil, "REPEATING", nil
 		}
 
 		if err != nil && c == nil && resp == nil {
 			return nil, "", err
 		} else if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
@@ -391,17 +406,17 @@ func resourceServerlessInstanceListRefreshFunc(ctx context.Context, projectID st
 	return func() (any, string, error) {
 		c, resp, err := client.ServerlessInstances.List(ctx, projectID, nil)
 
-		if err != nil && strings.Contains(err.Error(), "reset by peer") {
+		if apierror.IsTransientNetworkError(err) {
 			return nil, "REPEATING", nil
 		}
 
 		if err != nil && c == nil && resp == nil {
 			return nil, "", err
 		} else if err != nil {
-			if resp.StatusCode == 404 {
+			if apierror.IsNotFound(err) {
 				return "", "DELETED", nil
 			}
-			if resp.StatusCode == 503 {
+			if apierror.HasStatus(err, http.StatusServiceUnavailable) {
 				return "", "PENDING", nil
 			}
 			return nil, "", err
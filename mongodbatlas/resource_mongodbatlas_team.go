@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 	matlas "go.mongodb.org/atlas/mongodbatlas"
 )
 
@@ -88,11 +89,11 @@ func resourceMongoDBAtlasTeamRead(ctx context.Context, d *schema.ResourceData, m
 	orgID := ids["org_id"]
 	teamID := ids["id"]
 
-	team, resp, err := conn.Teams.Get(context.Background(), orgID, teamID)
+	team, _, err := conn.Teams.Get(context.Background(), orgID, teamID)
 
 	if err != nil {
 		// new resource missing
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -178,7 +179,7 @@ func resourceMongoDBAtlasTeamUpdate(ctx context.Context, d *schema.ResourceData,
 
 			if err != nil {
 				// this must be handle as a soft error
-				if !strings.Contains(err.Error(), "401") {
+				if !apierror.HasStatus(err, http.StatusUnauthorized) {
 					// In this case is a hard error doing a rollback from the initial operation
 					return diag.FromErr(fmt.Errorf("error getting Atlas User (%s) information: %s", username, err))
 				}
@@ -224,8 +225,7 @@ func resourceMongoDBAtlasTeamDelete(ctx context.Context, d *schema.ResourceData,
 	err := retry.RetryContext(ctx, 1*time.Hour, func() *retry.RetryError {
 		_, err := conn.Teams.RemoveTeamFromOrganization(ctx, orgID, id)
 		if err != nil {
-			var target *matlas.ErrorResponse
-			if errors.As(err, &target) && target.ErrorCode == "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT" {
+			if apierror.HasErrorCode(err, "CANNOT_DELETE_TEAM_ASSIGNED_TO_PROJECT") {
 				projectID, err := getProjectIDByTeamID(ctx, conn, id)
 				if err != nil {
 					return retry.NonRetryableError(err)
@@ -3,12 +3,12 @@ package mongodbatlas
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
 )
 
 var integrationTypes = []string{
@@ -166,9 +166,9 @@ func resourceMongoDBAtlasThirdPartyIntegrationRead(ctx context.Context, d *schem
 	projectID := ids["project_id"]
 	integrationType := ids["type"]
 
-	integration, resp, err := conn.Integrations.Get(context.Background(), projectID, integrationType)
+	integration, _, err := conn.Integrations.Get(context.Background(), projectID, integrationType)
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
+		if apierror.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -0,0 +1,110 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	retrystrategy "github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/framework/retry"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	searchDeploymentPath = "api/atlas/v2/groups/%s/clusters/%s/search/deployment"
+	// the versioned SDK doesn't support dedicated search nodes yet
+	searchDeploymentMediaType = "application/vnd.atlas.2023-01-01+json"
+
+	searchDeploymentStateIdle     = "IDLE"
+	searchDeploymentStateUpdating = "UPDATING"
+	searchDeploymentStatePaused   = "PAUSED"
+	searchDeploymentStateDeleted  = "DELETED"
+
+	searchDeploymentDelay        = 1 * time.Minute
+	searchDeploymentPollInterval = 30 * time.Second
+)
+
+// searchDeployment is the set of dedicated search nodes of a cluster.
+type searchDeployment struct {
+	ID        string                 `json:"id,omitempty"`
+	GroupID   string                 `json:"groupId,omitempty"`
+	StateName string                 `json:"stateName,omitempty"`
+	Specs     []searchDeploymentSpec `json:"specs"`
+}
+
+type searchDeploymentSpec struct {
+	InstanceSize string `json:"instanceSize"`
+	NodeCount    int    `json:"nodeCount"`
+}
+
+func createSearchDeployment(ctx context.Context, conn *matlas.Client, projectID, clusterName string, deployment *searchDeployment) (*searchDeployment, error) {
+	created := new(searchDeployment)
+	path := fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName))
+	if err := doVersionedRequest(ctx, conn, searchDeploymentMediaType, http.MethodPost, path, deployment, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func getSearchDeployment(ctx context.Context, conn *matlas.Client, projectID, clusterName string) (*searchDeployment, error) {
+	deployment := new(searchDeployment)
+	path := fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName))
+	if err := doVersionedRequest(ctx, conn, searchDeploymentMediaType, http.MethodGet, path, nil, deployment); err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+func updateSearchDeployment(ctx context.Context, conn *matlas.Client, projectID, clusterName string, deployment *searchDeployment) (*searchDeployment, error) {
+	updated := new(searchDeployment)
+	path := fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName))
+	if err := doVersionedRequest(ctx, conn, searchDeploymentMediaType, http.MethodPatch, path, deployment, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteSearchDeployment(ctx context.Context, conn *matlas.Client, projectID, clusterName string) error {
+	path := fmt.Sprintf(searchDeploymentPath, projectID, url.PathEscape(clusterName))
+	return doVersionedRequest(ctx, conn, searchDeploymentMediaType, http.MethodDelete, path, nil, nil)
+}
+
+// searchDeploymentRefreshFunc reports the state of the search deployment of a cluster. A deployment that doesn't
+// exist, either reported as an error or as an empty deployment, is in the DELETED state. A deployment is PAUSED as long
+// as its cluster is, so it's reported as an error rather than waiting for a state it can't reach.
+func searchDeploymentRefreshFunc(ctx context.Context, conn *matlas.Client, projectID, clusterName string) func() (*searchDeployment, string, error) {
+	return func() (*searchDeployment, string, error) {
+		deployment, err := getSearchDeployment(ctx, conn, projectID, clusterName)
+		if err != nil {
+			if apierror.IsNotFound(err) {
+				return nil, searchDeploymentStateDeleted, nil
+			}
+			return nil, "", err
+		}
+		if deployment.ID == "" {
+			return nil, searchDeploymentStateDeleted, nil
+		}
+		if deployment.StateName == searchDeploymentStatePaused {
+			return nil, "", fmt.Errorf("the search deployment is paused because cluster %q is paused, resume the cluster and apply again", clusterName)
+		}
+		return deployment, deployment.StateName, nil
+	}
+}
+
+var searchDeploymentLifecycle = retrystrategy.Lifecycle{
+	Pending:      []string{searchDeploymentStateUpdating},
+	Ready:        searchDeploymentStateIdle,
+	Deleted:      searchDeploymentStateDeleted,
+	Delay:        searchDeploymentDelay,
+	PollInterval: searchDeploymentPollInterval,
+}
+
+// newSearchDeploymentWaiter waits for the search deployment of a cluster to be IDLE, or to be DELETED if deleted
+// is true.
+func newSearchDeploymentWaiter(ctx context.Context, conn *matlas.Client, projectID, clusterName, operation string, deleted bool,
+	timeout time.Duration) *retrystrategy.Waiter[*searchDeployment] {
+	return retrystrategy.NewWaiter(fmt.Sprintf("%s of search deployment of cluster %q", operation, clusterName), searchDeploymentLifecycle,
+		searchDeploymentRefreshFunc(ctx, conn, projectID, clusterName), deleted, timeout)
+}
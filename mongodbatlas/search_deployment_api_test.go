@@ -0,0 +1,116 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+)
+
+const searchDeploymentTestPath = "/api/atlas/v2/groups/project/clusters/cluster/search/deployment"
+
+func TestSearchDeploymentRefreshFunc(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		wantState string
+		wantErr   string
+	}{
+		{name: "updating", response: `{"id":"65a1b2c3d4e5f6a7b8c9d0e1","stateName":"UPDATING"}`, wantState: searchDeploymentStateUpdating},
+		{name: "deleted with error", response: `{"error":400,"errorCode":"ATLAS_SEARCH_DEPLOYMENT_DOES_NOT_EXIST"}`, wantState: searchDeploymentStateDeleted},
+		{name: "deleted as empty", response: `{}`, wantState: searchDeploymentStateDeleted},
+		{name: "paused cluster", response: `{"id":"65a1b2c3d4e5f6a7b8c9d0e1","stateName":"PAUSED"}`, wantErr: `cluster "cluster" is paused`},
+		{name: "api error", response: `{"error":400,"errorCode":"INVALID_ATTRIBUTE"}`, wantErr: "INVALID_ATTRIBUTE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := atlastest.NewServer()
+			defer server.Close()
+			server.AddRoute(http.MethodGet, searchDeploymentTestPath, searchDeploymentMediaType, atlastest.Responses(tt.response))
+			conn := testOfflineClient(server).Atlas
+
+			_, state, err := searchDeploymentRefreshFunc(context.Background(), conn, "project", "cluster")()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil || state != tt.wantState {
+				t.Errorf("expected state %s, got %s (error %v)", tt.wantState, state, err)
+			}
+		})
+	}
+}
+
+func TestSearchDeploymentRequests(t *testing.T) {
+	const deploymentID = "65a1b2c3d4e5f6a7b8c9d0e1"
+	server := atlastest.NewServer()
+	defer server.Close()
+	echo := func(r *http.Request) (int, any) {
+		var deployment searchDeployment
+		if err := json.NewDecoder(r.Body).Decode(&deployment); err != nil {
+			t.Errorf("unexpected error decoding the request: %s", err)
+		}
+		deployment.ID = deploymentID
+		deployment.StateName = searchDeploymentStateUpdating
+		return http.StatusOK, deployment
+	}
+	server.AddRoute(http.MethodPost, searchDeploymentTestPath, searchDeploymentMediaType, echo)
+	server.AddRoute(http.MethodPatch, searchDeploymentTestPath, searchDeploymentMediaType, echo)
+	server.AddRoute(http.MethodDelete, searchDeploymentTestPath, searchDeploymentMediaType, func(r *http.Request) (int, any) {
+		return http.StatusAccepted, nil
+	})
+	conn := testOfflineClient(server).Atlas
+	ctx := context.Background()
+	deployment := &searchDeployment{Specs: []searchDeploymentSpec{{InstanceSize: "S30_HIGHCPU_NVME", NodeCount: 3}}}
+
+	created, err := createSearchDeployment(ctx, conn, "project", "cluster", deployment)
+	if err != nil {
+		t.Fatalf("unexpected error creating the search deployment: %s", err)
+	}
+	if created.ID != deploymentID || created.StateName != searchDeploymentStateUpdating || created.Specs[0].InstanceSize != "S30_HIGHCPU_NVME" {
+		t.Errorf("unexpected search deployment %+v", created)
+	}
+	if _, err := updateSearchDeployment(ctx, conn, "project", "cluster", deployment); err != nil {
+		t.Errorf("unexpected error updating the search deployment: %s", err)
+	}
+	if err := deleteSearchDeployment(ctx, conn, "project", "cluster"); err != nil {
+		t.Errorf("unexpected error deleting the search deployment: %s", err)
+	}
+
+	expected := []string{"POST " + searchDeploymentTestPath, "PATCH " + searchDeploymentTestPath, "DELETE " + searchDeploymentTestPath}
+	if requests := server.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestSplitSearchDeploymentImportID(t *testing.T) {
+	tests := []struct {
+		id          string
+		projectID   string
+		clusterName string
+		wantErr     bool
+	}{
+		{id: "5d0f1f74cf09a29120e123cd-Cluster0", projectID: "5d0f1f74cf09a29120e123cd", clusterName: "Cluster0"},
+		{id: "5d0f1f74cf09a29120e123cd-my-cluster", projectID: "5d0f1f74cf09a29120e123cd", clusterName: "my-cluster"},
+		{id: "5d0f1f74cf09a29120e123cd-", wantErr: true},
+		{id: "project-Cluster0", wantErr: true},
+		{id: "Cluster0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			projectID, clusterName, err := splitSearchDeploymentImportID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitSearchDeploymentImportID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if projectID != tt.projectID || clusterName != tt.clusterName {
+				t.Errorf("expected %s and %s, got %s and %s", tt.projectID, tt.clusterName, projectID, clusterName)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	searchIndexesPath = "api/atlas/v2/groups/%s/clusters/%s/fts/indexes"
+	// the versioned SDK doesn't model the type and fields of vector search indexes, the endpoints are the same as
+	// the ones used for Atlas Search indexes
+	searchIndexMediaType = "application/vnd.atlas.2023-01-01+json"
+
+	searchIndexTypeSearch       = "search"
+	searchIndexTypeVectorSearch = "vectorSearch"
+)
+
+// versionedSearchIndex is a search index as represented by the versioned Atlas Admin API, including the type and
+// fields of vector search indexes.
+type versionedSearchIndex struct {
+	admin.ClusterSearchIndex
+	Type   string           `json:"type,omitempty"`
+	Fields []map[string]any `json:"fields,omitempty"`
+}
+
+func createVersionedSearchIndex(ctx context.Context, conn *matlas.Client, projectID, clusterName string, index *versionedSearchIndex) (*versionedSearchIndex, error) {
+	created := new(versionedSearchIndex)
+	path := fmt.Sprintf(searchIndexesPath, projectID, url.PathEscape(clusterName))
+	if err := doVersionedRequest(ctx, conn, searchIndexMediaType, http.MethodPost, path, index, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func getVersionedSearchIndex(ctx context.Context, conn *matlas.Client, projectID, clusterName, indexID string) (*versionedSearchIndex, error) {
+	index := new(versionedSearchIndex)
+	path := fmt.Sprintf(searchIndexesPath+"/%s", projectID, url.PathEscape(clusterName), indexID)
+	if err := doVersionedRequest(ctx, conn, searchIndexMediaType, http.MethodGet, path, nil, index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func updateVersionedSearchIndex(ctx context.Context, conn *matlas.Client, projectID, clusterName, indexID string, index *versionedSearchIndex) (*versionedSearchIndex, error) {
+	updated := new(versionedSearchIndex)
+	path := fmt.Sprintf(searchIndexesPath+"/%s", projectID, url.PathEscape(clusterName), indexID)
+	if err := doVersionedRequest(ctx, conn, searchIndexMediaType, http.MethodPatch, path, index, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func listVersionedSearchIndexes(ctx context.Context, conn *matlas.Client, projectID, clusterName, databaseName, collectionName string) ([]versionedSearchIndex, error) {
+	var indexes []versionedSearchIndex
+	path := fmt.Sprintf(searchIndexesPath+"/%s/%s", projectID, url.PathEscape(clusterName), url.PathEscape(databaseName), url.PathEscape(collectionName))
+	if err := doVersionedRequest(ctx, conn, searchIndexMediaType, http.MethodGet, path, nil, &indexes); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
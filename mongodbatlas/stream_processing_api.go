@@ -0,0 +1,172 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	streamInstancesPath      = "api/atlas/v2/groups/%s/streams"
+	streamProcessorPath      = "api/atlas/v2/groups/%s/streams/%s/processor"
+	streamProcessorsListPath = "api/atlas/v2/groups/%s/streams/%s/processors"
+	// the versioned SDK doesn't support the tier of stream instances nor stream processors yet
+	streamInstanceMediaType  = "application/vnd.atlas.2023-02-01+json"
+	streamProcessorMediaType = "application/vnd.atlas.2024-05-30+json"
+
+	streamProcessorStateCreated = "CREATED"
+	streamProcessorStateStarted = "STARTED"
+	streamProcessorStateStopped = "STOPPED"
+	streamProcessorStateFailed  = "FAILED"
+)
+
+// streamInstance is an Atlas Stream Processing instance, named a tenant in the Atlas Admin API.
+type streamInstance struct {
+	DataProcessRegion *streamDataProcessRegion `json:"dataProcessRegion,omitempty"`
+	StreamConfig      *streamConfig            `json:"streamConfig,omitempty"`
+	ID                string                   `json:"_id,omitempty"`
+	GroupID           string                   `json:"groupId,omitempty"`
+	Name              string                   `json:"name,omitempty"`
+	Hostnames         []string                 `json:"hostnames,omitempty"`
+}
+
+type streamDataProcessRegion struct {
+	CloudProvider string `json:"cloudProvider"`
+	Region        string `json:"region"`
+}
+
+type streamConfig struct {
+	Tier string `json:"tier,omitempty"`
+}
+
+type streamInstancesPage struct {
+	Results    []streamInstance `json:"results"`
+	TotalCount int              `json:"totalCount"`
+}
+
+type streamProcessor struct {
+	Options  *streamProcessorOptions `json:"options,omitempty"`
+	ID       string                  `json:"_id,omitempty"`
+	Name     string                  `json:"name,omitempty"`
+	State    string                  `json:"state,omitempty"`
+	Pipeline json.RawMessage         `json:"pipeline,omitempty"`
+	Stats    json.RawMessage         `json:"stats,omitempty"`
+}
+
+type streamProcessorOptions struct {
+	DLQ *streamProcessorDLQ `json:"dlq,omitempty"`
+}
+
+// streamProcessorDLQ is the dead letter queue where documents that fail to be processed are written.
+type streamProcessorDLQ struct {
+	Coll           string `json:"coll"`
+	ConnectionName string `json:"connectionName"`
+	DB             string `json:"db"`
+}
+
+type streamProcessorsPage struct {
+	Results    []streamProcessor `json:"results"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func createStreamInstance(ctx context.Context, conn *matlas.Client, projectID string, instance *streamInstance) (*streamInstance, error) {
+	created := new(streamInstance)
+	path := fmt.Sprintf(streamInstancesPath, projectID)
+	if err := doVersionedRequest(ctx, conn, streamInstanceMediaType, http.MethodPost, path, instance, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func getStreamInstance(ctx context.Context, conn *matlas.Client, projectID, instanceName string) (*streamInstance, error) {
+	instance := new(streamInstance)
+	path := fmt.Sprintf(streamInstancesPath+"/%s", projectID, url.PathEscape(instanceName))
+	if err := doVersionedRequest(ctx, conn, streamInstanceMediaType, http.MethodGet, path, nil, instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+func updateStreamInstance(ctx context.Context, conn *matlas.Client, projectID, instanceName string, region *streamDataProcessRegion) (*streamInstance, error) {
+	instance := new(streamInstance)
+	path := fmt.Sprintf(streamInstancesPath+"/%s", projectID, url.PathEscape(instanceName))
+	if err := doVersionedRequest(ctx, conn, streamInstanceMediaType, http.MethodPatch, path, region, instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+func deleteStreamInstance(ctx context.Context, conn *matlas.Client, projectID, instanceName string) error {
+	path := fmt.Sprintf(streamInstancesPath+"/%s", projectID, url.PathEscape(instanceName))
+	return doVersionedRequest(ctx, conn, streamInstanceMediaType, http.MethodDelete, path, nil, nil)
+}
+
+func listStreamInstances(ctx context.Context, conn *matlas.Client, projectID string, options *matlas.ListOptions) (*streamInstancesPage, error) {
+	page := new(streamInstancesPage)
+	path := fmt.Sprintf(streamInstancesPath, projectID) + listOptionsQuery(options)
+	if err := doVersionedRequest(ctx, conn, streamInstanceMediaType, http.MethodGet, path, nil, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func createStreamProcessor(ctx context.Context, conn *matlas.Client, projectID, instanceName string, processor *streamProcessor) (*streamProcessor, error) {
+	created := new(streamProcessor)
+	path := fmt.Sprintf(streamProcessorPath, projectID, url.PathEscape(instanceName))
+	if err := doVersionedRequest(ctx, conn, streamProcessorMediaType, http.MethodPost, path, processor, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func getStreamProcessor(ctx context.Context, conn *matlas.Client, projectID, instanceName, processorName string) (*streamProcessor, error) {
+	processor := new(streamProcessor)
+	path := fmt.Sprintf(streamProcessorPath+"/%s", projectID, url.PathEscape(instanceName), url.PathEscape(processorName))
+	if err := doVersionedRequest(ctx, conn, streamProcessorMediaType, http.MethodGet, path, nil, processor); err != nil {
+		return nil, err
+	}
+	return processor, nil
+}
+
+func deleteStreamProcessor(ctx context.Context, conn *matlas.Client, projectID, instanceName, processorName string) error {
+	path := fmt.Sprintf(streamProcessorPath+"/%s", projectID, url.PathEscape(instanceName), url.PathEscape(processorName))
+	return doVersionedRequest(ctx, conn, streamProcessorMediaType, http.MethodDelete, path, nil, nil)
+}
+
+// startStreamProcessor starts the processor if start is true or stops it otherwise.
+func startStreamProcessor(ctx context.Context, conn *matlas.Client, projectID, instanceName, processorName string, start bool) error {
+	action := "stop"
+	if start {
+		action = "start"
+	}
+	path := fmt.Sprintf(streamProcessorPath+"/%s:%s", projectID, url.PathEscape(instanceName), url.PathEscape(processorName), action)
+	return doVersionedRequest(ctx, conn, streamProcessorMediaType, http.MethodPost, path, nil, nil)
+}
+
+func listStreamProcessors(ctx context.Context, conn *matlas.Client, projectID, instanceName string, options *matlas.ListOptions) (*streamProcessorsPage, error) {
+	page := new(streamProcessorsPage)
+	path := fmt.Sprintf(streamProcessorsListPath, projectID, url.PathEscape(instanceName)) + listOptionsQuery(options)
+	if err := doVersionedRequest(ctx, conn, streamProcessorMediaType, http.MethodGet, path, nil, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func listOptionsQuery(options *matlas.ListOptions) string {
+	query := url.Values{}
+	if options != nil && options.PageNum > 0 {
+		query.Set("pageNum", strconv.Itoa(options.PageNum))
+	}
+	if options != nil && options.ItemsPerPage > 0 {
+		query.Set("itemsPerPage", strconv.Itoa(options.ItemsPerPage))
+	}
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
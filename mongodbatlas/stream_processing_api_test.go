@@ -0,0 +1,95 @@
+package mongodbatlas
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func TestStreamProcessorRequests(t *testing.T) {
+	const (
+		processors  = "/api/atlas/v2/groups/project/streams/instance/processor"
+		processorID = "6661b2c3d4e5f6a7b8c9d0e1"
+	)
+	server := atlastest.NewServer()
+	defer server.Close()
+	server.AddRoute(http.MethodPost, processors, streamProcessorMediaType, func(r *http.Request) (int, any) {
+		var processor streamProcessor
+		if err := json.NewDecoder(r.Body).Decode(&processor); err != nil {
+			t.Errorf("unexpected error decoding the request: %s", err)
+		}
+		processor.ID = processorID
+		processor.State = streamProcessorStateCreated
+		return http.StatusOK, processor
+	})
+	for _, action := range []string{"start", "stop"} {
+		server.AddRoute(http.MethodPost, processors+"/processor:"+action, streamProcessorMediaType, func(r *http.Request) (int, any) {
+			return http.StatusOK, nil
+		})
+	}
+	server.AddRoute(http.MethodGet, processors+"s", streamProcessorMediaType,
+		atlastest.Responses(`{"results":[{"name":"processor","state":"STARTED"}],"totalCount":1}`))
+	conn := testOfflineClient(server).Atlas
+	ctx := context.Background()
+
+	created, err := createStreamProcessor(ctx, conn, "project", "instance", &streamProcessor{
+		Name:     "processor",
+		Pipeline: json.RawMessage(`[{"$source":{"connectionName":"sample_stream_solar"}}]`),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating the stream processor: %s", err)
+	}
+	if created.ID != processorID || created.State != streamProcessorStateCreated {
+		t.Errorf("unexpected stream processor %+v", created)
+	}
+
+	if err := startStreamProcessor(ctx, conn, "project", "instance", "processor", true); err != nil {
+		t.Errorf("unexpected error starting the stream processor: %s", err)
+	}
+	if err := startStreamProcessor(ctx, conn, "project", "instance", "processor", false); err != nil {
+		t.Errorf("unexpected error stopping the stream processor: %s", err)
+	}
+
+	page, err := listStreamProcessors(ctx, conn, "project", "instance", &matlas.ListOptions{PageNum: 2, ItemsPerPage: 50})
+	if err != nil {
+		t.Fatalf("unexpected error listing the stream processors: %s", err)
+	}
+	if page.TotalCount != 1 || len(page.Results) != 1 || page.Results[0].State != streamProcessorStateStarted {
+		t.Errorf("unexpected stream processors %+v", page)
+	}
+
+	expected := []string{
+		"POST " + processors,
+		"POST " + processors + "/processor:start",
+		"POST " + processors + "/processor:stop",
+		"GET " + processors + "s?itemsPerPage=50&pageNum=2",
+	}
+	if requests := server.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestListOptionsQuery(t *testing.T) {
+	tests := []struct {
+		options  *matlas.ListOptions
+		name     string
+		expected string
+	}{
+		{name: "nil", options: nil, expected: ""},
+		{name: "empty", options: &matlas.ListOptions{}, expected: ""},
+		{name: "page", options: &matlas.ListOptions{PageNum: 3}, expected: "?pageNum=3"},
+		{name: "both", options: &matlas.ListOptions{PageNum: 1, ItemsPerPage: 10}, expected: "?itemsPerPage=10&pageNum=1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listOptionsQuery(tt.options); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
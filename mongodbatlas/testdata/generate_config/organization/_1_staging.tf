@@ -0,0 +1,32 @@
+resource "mongodbatlas_project" "_1_staging" {
+  name   = "1 Staging"
+  org_id = "6523b29fc1b9e3a7a5d4f000"
+}
+
+resource "mongodbatlas_advanced_cluster" "orders_2" {
+  project_id                     = mongodbatlas_project._1_staging.id
+  name                           = "orders"
+  backup_enabled                 = false
+  cluster_type                   = "REPLICASET"
+  pit_enabled                    = false
+  termination_protection_enabled = false
+  version_release_system         = "CONTINUOUS"
+  replication_specs {
+    num_shards = 1
+    zone_name  = "Zone 1"
+    region_configs {
+      priority      = 7
+      provider_name = "GCP"
+      region_name   = "CENTRAL_US"
+      auto_scaling {
+        compute_enabled            = false
+        compute_scale_down_enabled = false
+        disk_gb_enabled            = true
+      }
+      electable_specs {
+        instance_size = "M10"
+        node_count    = 3
+      }
+    }
+  }
+}
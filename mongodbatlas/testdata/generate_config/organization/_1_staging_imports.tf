@@ -0,0 +1,9 @@
+import {
+  to = mongodbatlas_project._1_staging
+  id = "6523b2a0c1b9e3a7a5d4f002"
+}
+
+import {
+  to = mongodbatlas_advanced_cluster.orders_2
+  id = "6523b2a0c1b9e3a7a5d4f002-orders"
+}
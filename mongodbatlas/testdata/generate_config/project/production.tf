@@ -0,0 +1,260 @@
+resource "mongodbatlas_project" "production" {
+  name   = "Production"
+  org_id = "6523b29fc1b9e3a7a5d4f000"
+}
+
+resource "mongodbatlas_advanced_cluster" "orders" {
+  project_id                     = mongodbatlas_project.production.id
+  name                           = "orders"
+  backup_enabled                 = true
+  cluster_type                   = "REPLICASET"
+  disk_size_gb                   = 40
+  mongo_db_major_version         = "6.0"
+  pit_enabled                    = true
+  termination_protection_enabled = true
+  version_release_system         = "LTS"
+  labels {
+    key   = "team"
+    value = "checkout"
+  }
+  replication_specs {
+    num_shards = 1
+    zone_name  = "Zone 1"
+    region_configs {
+      priority      = 7
+      provider_name = "AWS"
+      region_name   = "US_EAST_1"
+      analytics_auto_scaling {
+        compute_enabled            = false
+        compute_scale_down_enabled = false
+        disk_gb_enabled            = false
+      }
+      analytics_specs {
+        ebs_volume_type = "STANDARD"
+        instance_size   = "M30"
+        node_count      = 1
+      }
+      auto_scaling {
+        compute_enabled            = true
+        compute_max_instance_size  = "M50"
+        compute_min_instance_size  = "M30"
+        compute_scale_down_enabled = true
+        disk_gb_enabled            = false
+      }
+      electable_specs {
+        ebs_volume_type = "STANDARD"
+        instance_size   = "M30"
+        node_count      = 3
+      }
+    }
+  }
+  tags {
+    key   = "environment"
+    value = "production"
+  }
+}
+
+resource "mongodbatlas_cloud_backup_schedule" "orders" {
+  project_id               = mongodbatlas_project.production.id
+  auto_export_enabled      = false
+  cluster_name             = mongodbatlas_advanced_cluster.orders.name
+  reference_hour_of_day    = 3
+  reference_minute_of_hour = 45
+  restore_window_days      = 7
+  policy_item_daily {
+    frequency_interval = 1
+    retention_unit     = "days"
+    retention_value    = 7
+  }
+  policy_item_hourly {
+    frequency_interval = 6
+    retention_unit     = "days"
+    retention_value    = 2
+  }
+  policy_item_monthly {
+    frequency_interval = 40
+    retention_unit     = "months"
+    retention_value    = 12
+  }
+}
+
+resource "mongodbatlas_search_index" "orders_default" {
+  project_id       = mongodbatlas_project.production.id
+  name             = "default"
+  analyzer         = "lucene.standard"
+  cluster_name     = mongodbatlas_advanced_cluster.orders.name
+  collection_name  = "products"
+  database         = "store"
+  mappings_dynamic = false
+  mappings_fields  = "{\"title\":{\"type\":\"string\"}}"
+  search_analyzer  = "lucene.standard"
+}
+
+resource "mongodbatlas_search_index" "orders_embeddings" {
+  project_id      = mongodbatlas_project.production.id
+  name            = "embeddings"
+  cluster_name    = mongodbatlas_advanced_cluster.orders.name
+  collection_name = "products"
+  database        = "store"
+  fields          = "[{\"numDimensions\":1536,\"path\":\"embedding\",\"similarity\":\"cosine\",\"type\":\"vector\"}]"
+  type            = "vectorSearch"
+}
+
+resource "mongodbatlas_advanced_cluster" "sandbox" {
+  project_id                     = mongodbatlas_project.production.id
+  name                           = "sandbox"
+  backup_enabled                 = false
+  cluster_type                   = "REPLICASET"
+  disk_size_gb                   = 2
+  mongo_db_major_version         = "7.0"
+  pit_enabled                    = false
+  termination_protection_enabled = false
+  version_release_system         = "LTS"
+  replication_specs {
+    num_shards = 1
+    zone_name  = "Zone 1"
+    region_configs {
+      backing_provider_name = "AWS"
+      priority              = 7
+      provider_name         = "TENANT"
+      region_name           = "US_EAST_1"
+      electable_specs {
+        instance_size = "M2"
+      }
+    }
+  }
+}
+
+resource "mongodbatlas_database_user" "orders_app" {
+  project_id         = mongodbatlas_project.production.id
+  auth_database_name = "admin"
+  username           = "orders-app"
+  labels {
+    key   = "team"
+    value = "checkout"
+  }
+  roles {
+    database_name = "orders"
+    role_name     = "readWrite"
+  }
+  roles {
+    collection_name = "products"
+    database_name   = "store"
+    role_name       = "read"
+  }
+  scopes {
+    name = "orders"
+    type = "CLUSTER"
+  }
+}
+
+resource "mongodbatlas_database_user" "arn_aws_iam_123456789012_role_orders" {
+  project_id         = mongodbatlas_project.production.id
+  auth_database_name = "$external"
+  aws_iam_type       = "ROLE"
+  username           = "arn:aws:iam::123456789012:role/orders"
+  roles {
+    database_name = "admin"
+    role_name     = "readAnyDatabase"
+  }
+}
+
+resource "mongodbatlas_custom_db_role" "ordersadmin" {
+  project_id = mongodbatlas_project.production.id
+  role_name  = "ordersAdmin"
+  actions {
+    action = "FIND"
+    resources {
+      database_name = "orders"
+    }
+  }
+  actions {
+    action = "SERVER_STATUS"
+    resources {
+      cluster = true
+    }
+  }
+  inherited_roles {
+    database_name = "store"
+    role_name     = "read"
+  }
+}
+
+resource "mongodbatlas_project_ip_access_list" "_10_0_0_0_16" {
+  project_id = mongodbatlas_project.production.id
+  cidr_block = "10.0.0.0/16"
+  comment    = "VPC"
+}
+
+resource "mongodbatlas_project_ip_access_list" "_203_0_113_7" {
+  project_id = mongodbatlas_project.production.id
+  comment    = "Office"
+  ip_address = "203.0.113.7"
+}
+
+resource "mongodbatlas_alert_configuration" "outside_metric_threshold" {
+  project_id = mongodbatlas_project.production.id
+  enabled    = true
+  event_type = "OUTSIDE_METRIC_THRESHOLD"
+  matcher {
+    field_name = "CLUSTER_NAME"
+    operator   = "EQUALS"
+    value      = "orders"
+  }
+  metric_threshold_config {
+    metric_name = "ASSERT_REGULAR"
+    mode        = "AVERAGE"
+    operator    = "GREATER_THAN"
+    threshold   = 99.5
+    units       = "RAW"
+  }
+  notification {
+    delay_min     = 0
+    email_enabled = true
+    interval_min  = 60
+    roles         = ["GROUP_OWNER"]
+    sms_enabled   = false
+    type_name     = "GROUP"
+  }
+  notification {
+    api_token    = var.outside_metric_threshold_notification_1_api_token
+    channel_name = "#alerts"
+    delay_min    = 0
+    interval_min = 5
+    type_name    = "SLACK"
+  }
+}
+
+resource "mongodbatlas_alert_configuration" "replication_oplog_window_running_out" {
+  project_id = mongodbatlas_project.production.id
+  enabled    = false
+  event_type = "REPLICATION_OPLOG_WINDOW_RUNNING_OUT"
+  notification {
+    delay_min     = 5
+    email_address = "oncall@example.com"
+    interval_min  = 60
+    type_name     = "EMAIL"
+  }
+  threshold_config {
+    operator  = "LESS_THAN"
+    threshold = 1
+    units     = "HOURS"
+  }
+}
+
+resource "mongodbatlas_third_party_integration" "datadog" {
+  project_id = mongodbatlas_project.production.id
+  api_key    = var.datadog_api_key
+  region     = "US"
+  type       = "DATADOG"
+}
+
+resource "mongodbatlas_third_party_integration" "prometheus" {
+  project_id        = mongodbatlas_project.production.id
+  enabled           = true
+  password          = var.prometheus_password
+  scheme            = "https"
+  service_discovery = "http"
+  type              = "PROMETHEUS"
+  user_name         = "prometheus"
+}
@@ -0,0 +1,74 @@
+import {
+  to = mongodbatlas_project.production
+  id = "6523b2a0c1b9e3a7a5d4f001"
+}
+
+import {
+  to = mongodbatlas_advanced_cluster.orders
+  id = "6523b2a0c1b9e3a7a5d4f001-orders"
+}
+
+import {
+  to = mongodbatlas_cloud_backup_schedule.orders
+  id = "6523b2a0c1b9e3a7a5d4f001-orders"
+}
+
+import {
+  to = mongodbatlas_search_index.orders_default
+  id = "6523b2a0c1b9e3a7a5d4f001--orders--6523b2a1c1b9e3a7a5d4f016"
+}
+
+import {
+  to = mongodbatlas_search_index.orders_embeddings
+  id = "6523b2a0c1b9e3a7a5d4f001--orders--6523b2a1c1b9e3a7a5d4f017"
+}
+
+import {
+  to = mongodbatlas_advanced_cluster.sandbox
+  id = "6523b2a0c1b9e3a7a5d4f001-sandbox"
+}
+
+import {
+  to = mongodbatlas_database_user.orders_app
+  id = "6523b2a0c1b9e3a7a5d4f001-orders-app-admin"
+}
+
+import {
+  to = mongodbatlas_database_user.arn_aws_iam_123456789012_role_orders
+  id = "6523b2a0c1b9e3a7a5d4f001-arn:aws:iam::123456789012:role/orders-$external"
+}
+
+import {
+  to = mongodbatlas_custom_db_role.ordersadmin
+  id = "6523b2a0c1b9e3a7a5d4f001-ordersAdmin"
+}
+
+import {
+  to = mongodbatlas_project_ip_access_list._10_0_0_0_16
+  id = "6523b2a0c1b9e3a7a5d4f001-10.0.0.0/16"
+}
+
+import {
+  to = mongodbatlas_project_ip_access_list._203_0_113_7
+  id = "6523b2a0c1b9e3a7a5d4f001-203.0.113.7"
+}
+
+import {
+  to = mongodbatlas_alert_configuration.outside_metric_threshold
+  id = "6523b2a0c1b9e3a7a5d4f001-6523b2a1c1b9e3a7a5d4f030"
+}
+
+import {
+  to = mongodbatlas_alert_configuration.replication_oplog_window_running_out
+  id = "6523b2a0c1b9e3a7a5d4f001-6523b2a1c1b9e3a7a5d4f031"
+}
+
+import {
+  to = mongodbatlas_third_party_integration.datadog
+  id = "6523b2a0c1b9e3a7a5d4f001-DATADOG"
+}
+
+import {
+  to = mongodbatlas_third_party_integration.prometheus
+  id = "6523b2a0c1b9e3a7a5d4f001-PROMETHEUS"
+}
@@ -0,0 +1,14 @@
+variable "outside_metric_threshold_notification_1_api_token" {
+  type      = string
+  sensitive = true
+}
+
+variable "datadog_api_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "prometheus_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,122 @@
+package atlastest
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// accessListEntry returns the key Atlas uses to address an entry, normalizing single IP addresses to
+// their /32 CIDR block the same way Atlas does.
+func accessListEntry(entry *matlas.ProjectIPAccessList) (string, error) {
+	switch {
+	case entry.AwsSecurityGroup != "":
+		return entry.AwsSecurityGroup, nil
+	case entry.CIDRBlock != "":
+		if _, _, err := net.ParseCIDR(entry.CIDRBlock); err != nil {
+			return "", fmt.Errorf("%s is not a valid CIDR block", entry.CIDRBlock)
+		}
+		return entry.CIDRBlock, nil
+	case entry.IPAddress != "":
+		if net.ParseIP(entry.IPAddress) == nil {
+			return "", fmt.Errorf("%s is not a valid IP address", entry.IPAddress)
+		}
+		entry.CIDRBlock = entry.IPAddress + "/32"
+		return entry.IPAddress, nil
+	}
+	return "", fmt.Errorf("one of ipAddress, cidrBlock or awsSecurityGroup must be specified")
+}
+
+// lookupAccessListEntry returns the key of the entry addressed by the entry parameter, which may refer to
+// a single IP address either directly or through its /32 CIDR block, or writes a 404 response.
+func lookupAccessListEntry(w http.ResponseWriter, p *project, params map[string]string) (string, bool) {
+	entry := params["entry"]
+	if _, ok := p.accessList[entry]; ok {
+		return entry, true
+	}
+	if ip := strings.TrimSuffix(entry, "/32"); ip != entry {
+		if _, ok := p.accessList[ip]; ok {
+			return ip, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "ATLAS_NETWORK_PERMISSION_ENTRY_NOT_FOUND", fmt.Sprintf("IP Address %s not on Atlas access list for group %s.", entry, p.project.ID))
+	return "", false
+}
+
+func (s *Server) registerAccessListRoutes() {
+	const accessList = "/api/atlas/v1.0/groups/{groupId}/accessList"
+
+	s.handle(http.MethodPost, accessList, s.createAccessListEntries)
+	s.handle(http.MethodGet, accessList, s.listAccessListEntries)
+	s.handle(http.MethodGet, accessList+"/{entry}", s.getAccessListEntry)
+	s.handle(http.MethodDelete, accessList+"/{entry}", s.deleteAccessListEntry)
+}
+
+func (s *Server) createAccessListEntries(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	var req []*matlas.ProjectIPAccessList
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	entries := map[string]*matlas.ProjectIPAccessList{}
+	for _, entry := range req {
+		key, err := accessListEntry(entry)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_ATTRIBUTE", err.Error())
+			return
+		}
+		entry.GroupID = p.project.ID
+		entries[key] = entry
+	}
+	for key, entry := range entries {
+		p.accessList[key] = entry
+	}
+	s.listAccessListEntries(w, r, params)
+}
+
+func (s *Server) listAccessListEntries(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	keys := make([]string, 0, len(p.accessList))
+	for key := range p.accessList {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	results := make([]matlas.ProjectIPAccessList, 0, len(keys))
+	for _, key := range keys {
+		results = append(results, *p.accessList[key])
+	}
+	writeJSON(w, http.StatusOK, listResponse(results))
+}
+
+func (s *Server) getAccessListEntry(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	if key, ok := lookupAccessListEntry(w, p, params); ok {
+		writeJSON(w, http.StatusOK, p.accessList[key])
+	}
+}
+
+func (s *Server) deleteAccessListEntry(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	if key, ok := lookupAccessListEntry(w, p, params); ok {
+		delete(p.accessList, key)
+		writeJSON(w, http.StatusNoContent, nil)
+	}
+}
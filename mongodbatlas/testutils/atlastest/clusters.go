@@ -0,0 +1,280 @@
+package atlastest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const (
+	stateIdle     = "IDLE"
+	stateCreating = "CREATING"
+	stateUpdating = "UPDATING"
+	stateDeleting = "DELETING"
+)
+
+type cluster struct {
+	cluster       matlas.AdvancedCluster
+	processArgs   matlas.ProcessArgs
+	searchIndexes map[string]*searchIndex
+	advice        *PerformanceAdvice
+	pendingReads  int
+}
+
+// CreateCluster adds an IDLE cluster to an existing project without going through the API, so that
+// resources depending on a cluster can be tested without waiting for it to be provisioned.
+func (s *Server) CreateCluster(projectID string, c *matlas.AdvancedCluster) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.projects[projectID]
+	if !ok {
+		return fmt.Errorf("project %s does not exist", projectID)
+	}
+	if _, ok := p.clusters[c.Name]; ok {
+		return fmt.Errorf("cluster %s already exists in project %s", c.Name, projectID)
+	}
+	s.addCluster(p, c).cluster.StateName = stateIdle
+	return nil
+}
+
+func (s *Server) addCluster(p *project, c *matlas.AdvancedCluster) *cluster {
+	cl := &cluster{
+		cluster: *c,
+		processArgs: matlas.ProcessArgs{
+			FailIndexKeyTooLong:              pointer(false),
+			JavascriptEnabled:                pointer(true),
+			MinimumEnabledTLSProtocol:        "TLS1_2",
+			NoTableScan:                      pointer(false),
+			SampleSizeBIConnector:            pointer(int64(1000)),
+			SampleRefreshIntervalBIConnector: pointer(int64(300)),
+		},
+		searchIndexes: map[string]*searchIndex{},
+	}
+	cl.cluster.ID = s.newID()
+	cl.cluster.GroupID = p.project.ID
+	cl.cluster.CreateDate = time.Now().UTC().Format(time.RFC3339)
+	s.setClusterDefaults(&cl.cluster)
+	p.clusters[c.Name] = cl
+	return cl
+}
+
+// setClusterDefaults fills in the attributes Atlas computes when they are not part of the request.
+func (s *Server) setClusterDefaults(c *matlas.AdvancedCluster) {
+	host := strings.ToLower(c.Name)
+	c.ConnectionStrings = &matlas.ConnectionStrings{
+		Standard:    fmt.Sprintf("mongodb://%s-shard-00-00.mongodb.net:27017/?ssl=true&authSource=admin", host),
+		StandardSrv: fmt.Sprintf("mongodb+srv://%s.mongodb.net", host),
+	}
+	if c.ClusterType == "" {
+		c.ClusterType = "REPLICASET"
+	}
+	if c.MongoDBMajorVersion == "" {
+		c.MongoDBMajorVersion = "7.0"
+	}
+	c.MongoDBVersion = c.MongoDBMajorVersion + ".2"
+	if c.BackupEnabled == nil {
+		c.BackupEnabled = pointer(false)
+	}
+	if c.BiConnector == nil {
+		c.BiConnector = &matlas.BiConnector{Enabled: pointer(false), ReadPreference: "secondary"}
+	}
+	if c.DiskSizeGB == nil {
+		c.DiskSizeGB = pointer(10.0)
+	}
+	if c.EncryptionAtRestProvider == "" {
+		c.EncryptionAtRestProvider = "NONE"
+	}
+	if c.Paused == nil {
+		c.Paused = pointer(false)
+	}
+	if c.PitEnabled == nil {
+		c.PitEnabled = pointer(false)
+	}
+	if c.RootCertType == "" {
+		c.RootCertType = "ISRGROOTX1"
+	}
+	if c.TerminationProtectionEnabled == nil {
+		c.TerminationProtectionEnabled = pointer(false)
+	}
+	if c.VersionReleaseSystem == "" {
+		c.VersionReleaseSystem = "LTS"
+	}
+	for i, spec := range c.ReplicationSpecs {
+		if spec.ID == "" {
+			spec.ID = s.newID()
+		}
+		if spec.NumShards == 0 {
+			spec.NumShards = 1
+		}
+		if spec.ZoneName == "" {
+			spec.ZoneName = fmt.Sprintf("Zone %d", i+1)
+		}
+	}
+}
+
+// readCluster returns the cluster as seen by a client and advances any pending state transition.
+// It reports false once a deleted cluster has finished its transition.
+func (p *project) readCluster(cl *cluster) (matlas.AdvancedCluster, bool) {
+	if cl.pendingReads > 0 {
+		cl.pendingReads--
+		return cl.cluster, true
+	}
+	switch cl.cluster.StateName {
+	case stateDeleting:
+		delete(p.clusters, cl.cluster.Name)
+		return cl.cluster, false
+	case stateCreating, stateUpdating:
+		cl.cluster.StateName = stateIdle
+	}
+	return cl.cluster, true
+}
+
+// lookupCluster returns the cluster identified by the groupId and clusterName parameters or writes a
+// 404 response.
+func (s *Server) lookupCluster(w http.ResponseWriter, params map[string]string) (*project, *cluster, bool) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return nil, nil, false
+	}
+	cl, ok := p.clusters[params["clusterName"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "CLUSTER_NOT_FOUND", fmt.Sprintf("No cluster named %s exists in group %s.", params["clusterName"], params["groupId"]))
+	}
+	return p, cl, ok
+}
+
+func (s *Server) registerClusterRoutes() {
+	const (
+		clusters = "/api/atlas/v1.5/groups/{groupId}/clusters"
+		cluster  = clusters + "/{clusterName}"
+		args     = "/api/atlas/v1.0/groups/{groupId}/clusters/{clusterName}/processArgs"
+		// the versioned endpoints return the same documents, only reads are supported
+		clustersV2 = "/api/atlas/v2/groups/{groupId}/clusters"
+	)
+
+	s.handle(http.MethodPost, clusters, s.createCluster)
+	s.handle(http.MethodGet, clusters, s.listClusters)
+	s.handle(http.MethodGet, cluster, s.getCluster)
+	s.handle(http.MethodPatch, cluster, s.updateCluster)
+	s.handle(http.MethodDelete, cluster, s.deleteCluster)
+	s.handle(http.MethodGet, args, s.getProcessArgs)
+	s.handle(http.MethodPatch, args, s.updateProcessArgs)
+	s.handle(http.MethodGet, clustersV2, s.listClusters)
+	s.handle(http.MethodGet, clustersV2+"/{clusterName}", s.getCluster)
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	var req matlas.AdvancedCluster
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" || len(req.ReplicationSpecs) == 0 {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes name and replicationSpecs were not specified.")
+		return
+	}
+	if _, ok := p.clusters[req.Name]; ok {
+		writeError(w, http.StatusBadRequest, "DUPLICATE_CLUSTER_NAME", fmt.Sprintf("A cluster named %s is already present in group %s.", req.Name, p.project.ID))
+		return
+	}
+
+	cl := s.addCluster(p, &req)
+	cl.cluster.StateName = stateCreating
+	cl.pendingReads = s.transitionReads
+	writeJSON(w, http.StatusCreated, cl.cluster)
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	names := make([]string, 0, len(p.clusters))
+	for name := range p.clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]matlas.AdvancedCluster, 0, len(names))
+	for _, name := range names {
+		if c, exists := p.readCluster(p.clusters[name]); exists {
+			results = append(results, c)
+		}
+	}
+	writeJSON(w, http.StatusOK, listResponse(results))
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, cl, ok := s.lookupCluster(w, params)
+	if !ok {
+		return
+	}
+	c, exists := p.readCluster(cl)
+	if !exists {
+		writeError(w, http.StatusNotFound, "CLUSTER_NOT_FOUND", fmt.Sprintf("No cluster named %s exists in group %s.", c.Name, p.project.ID))
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, cl, ok := s.lookupCluster(w, params)
+	if !ok {
+		return
+	}
+	if cl.cluster.StateName == stateDeleting {
+		writeError(w, http.StatusBadRequest, "CLUSTER_ALREADY_REQUESTED_DELETION", fmt.Sprintf("Cluster %s is being deleted.", cl.cluster.Name))
+		return
+	}
+
+	updated := cl.cluster
+	if !mergeBody(w, r, &updated) {
+		return
+	}
+	if *cl.cluster.Paused && *updated.Paused {
+		writeError(w, http.StatusBadRequest, "CANNOT_UPDATE_PAUSED_CLUSTER", fmt.Sprintf("Cannot update cluster %s while it is paused or being paused.", cl.cluster.Name))
+		return
+	}
+
+	updated.ID, updated.GroupID, updated.Name, updated.CreateDate = cl.cluster.ID, cl.cluster.GroupID, cl.cluster.Name, cl.cluster.CreateDate
+	s.setClusterDefaults(&updated)
+	updated.StateName = stateUpdating
+	cl.cluster = updated
+	cl.pendingReads = s.transitionReads
+	writeJSON(w, http.StatusOK, cl.cluster)
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	_, cl, ok := s.lookupCluster(w, params)
+	if !ok {
+		return
+	}
+	if *cl.cluster.TerminationProtectionEnabled {
+		writeError(w, http.StatusBadRequest, "CANNOT_TERMINATE_CLUSTER_WHEN_TERMINATION_PROTECTION_ENABLED", fmt.Sprintf("Cluster %s has termination protection enabled.", cl.cluster.Name))
+		return
+	}
+	cl.cluster.StateName = stateDeleting
+	cl.pendingReads = s.transitionReads
+	writeJSON(w, http.StatusAccepted, nil)
+}
+
+func (s *Server) getProcessArgs(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, cl, ok := s.lookupCluster(w, params); ok {
+		writeJSON(w, http.StatusOK, cl.processArgs)
+	}
+}
+
+func (s *Server) updateProcessArgs(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, cl, ok := s.lookupCluster(w, params)
+	if !ok || !mergeBody(w, r, &cl.processArgs) {
+		return
+	}
+	writeJSON(w, http.StatusOK, cl.processArgs)
+}
@@ -0,0 +1,122 @@
+package atlastest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+func databaseUserKey(databaseName, username string) string {
+	return databaseName + "/" + username
+}
+
+// withoutPassword returns the user as reported by Atlas, which never discloses passwords.
+func withoutPassword(u *matlas.DatabaseUser) matlas.DatabaseUser {
+	user := *u
+	user.Password = ""
+	if user.Scopes == nil {
+		user.Scopes = []matlas.Scope{}
+	}
+	return user
+}
+
+func (s *Server) lookupDatabaseUser(w http.ResponseWriter, params map[string]string) (*project, *matlas.DatabaseUser, bool) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return nil, nil, false
+	}
+	user, ok := p.databaseUsers[databaseUserKey(params["databaseName"], params["username"])]
+	if !ok {
+		writeError(w, http.StatusNotFound, "USERNAME_NOT_FOUND", fmt.Sprintf("No user with username %s exists.", params["username"]))
+	}
+	return p, user, ok
+}
+
+func (s *Server) registerDatabaseUserRoutes() {
+	const (
+		users = "/api/atlas/v1.0/groups/{groupId}/databaseUsers"
+		user  = users + "/{databaseName}/{username}"
+	)
+
+	s.handle(http.MethodPost, users, s.createDatabaseUser)
+	s.handle(http.MethodGet, users, s.listDatabaseUsers)
+	s.handle(http.MethodGet, user, s.getDatabaseUser)
+	s.handle(http.MethodPatch, user, s.updateDatabaseUser)
+	s.handle(http.MethodDelete, user, s.deleteDatabaseUser)
+}
+
+func (s *Server) createDatabaseUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	var req matlas.DatabaseUser
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Username == "" || req.DatabaseName == "" || len(req.Roles) == 0 {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes username, databaseName and roles were not specified.")
+		return
+	}
+	key := databaseUserKey(req.DatabaseName, req.Username)
+	if _, ok := p.databaseUsers[key]; ok {
+		writeError(w, http.StatusConflict, "USER_ALREADY_EXISTS", fmt.Sprintf("The specified user %s already exists.", req.Username))
+		return
+	}
+
+	req.GroupID = p.project.ID
+	p.databaseUsers[key] = &req
+	writeJSON(w, http.StatusCreated, withoutPassword(&req))
+}
+
+func (s *Server) listDatabaseUsers(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	keys := make([]string, 0, len(p.databaseUsers))
+	for key := range p.databaseUsers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	results := make([]matlas.DatabaseUser, 0, len(keys))
+	for _, key := range keys {
+		results = append(results, withoutPassword(p.databaseUsers[key]))
+	}
+	writeJSON(w, http.StatusOK, listResponse(results))
+}
+
+func (s *Server) getDatabaseUser(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, user, ok := s.lookupDatabaseUser(w, params); ok {
+		writeJSON(w, http.StatusOK, withoutPassword(user))
+	}
+}
+
+func (s *Server) updateDatabaseUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, user, ok := s.lookupDatabaseUser(w, params)
+	if !ok {
+		return
+	}
+	updated := *user
+	if !mergeBody(w, r, &updated) {
+		return
+	}
+	if updated.Username != user.Username || updated.DatabaseName != user.DatabaseName {
+		writeError(w, http.StatusBadRequest, "INVALID_ATTRIBUTE", "The username and databaseName of a user cannot be changed.")
+		return
+	}
+	*user = updated
+	writeJSON(w, http.StatusOK, withoutPassword(user))
+}
+
+func (s *Server) deleteDatabaseUser(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, user, ok := s.lookupDatabaseUser(w, params)
+	if !ok {
+		return
+	}
+	delete(p.databaseUsers, databaseUserKey(user.DatabaseName, user.Username))
+	writeJSON(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,239 @@
+package atlastest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	processPort          = 27017
+	processReplicaNodes  = 3
+	typeReplicaPrimary   = "REPLICA_PRIMARY"
+	typeReplicaSecondary = "REPLICA_SECONDARY"
+	typeShardPrimary     = "SHARD_PRIMARY"
+	typeShardSecondary   = "SHARD_SECONDARY"
+)
+
+// PerformanceAdvice is what the Performance Advisor reports for a cluster. The suggested indexes and slow queries
+// are returned for the primaries of the cluster and the schema advice for the cluster itself.
+type PerformanceAdvice struct {
+	SuggestedIndexes []admin.PerformanceAdvisorIndex
+	Shapes           []admin.PerformanceAdvisorShape
+	SlowQueries      []admin.PerformanceAdvisorSlowQuery
+	SchemaAdvice     []SchemaAdviceRecommendation
+}
+
+// SchemaAdviceRecommendation is a recommendation of the schema advisor, which the versioned SDK doesn't model.
+type SchemaAdviceRecommendation struct {
+	Recommendation     string                  `json:"recommendation"`
+	Description        string                  `json:"description"`
+	AffectedNamespaces []SchemaAdviceNamespace `json:"affectedNamespaces"`
+}
+
+// SchemaAdviceNamespace is a namespace affected by a schema advisor recommendation.
+type SchemaAdviceNamespace struct {
+	Namespace string                `json:"namespace"`
+	Triggers  []SchemaAdviceTrigger `json:"triggers"`
+}
+
+// SchemaAdviceTrigger is the reason a schema advisor recommendation applies to a namespace.
+type SchemaAdviceTrigger struct {
+	TriggerType string `json:"triggerType"`
+	Description string `json:"description"`
+}
+
+// SetPerformanceAdvice sets what the Performance Advisor reports for an existing cluster.
+func (s *Server) SetPerformanceAdvice(projectID, clusterName string, advice *PerformanceAdvice) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.projects[projectID]
+	if !ok {
+		return fmt.Errorf("project %s does not exist", projectID)
+	}
+	cl, ok := p.clusters[clusterName]
+	if !ok {
+		return fmt.Errorf("cluster %s does not exist in project %s", clusterName, projectID)
+	}
+	cl.advice = advice
+	return nil
+}
+
+// processes returns the mongod processes of a cluster, three per shard, named like the ones of Atlas clusters:
+// the host name is internal to Atlas and the user alias shares the host of the SRV connection string.
+func (cl *cluster) processes() []admin.ApiHostViewAtlas {
+	shards, primary, secondary := 1, typeReplicaPrimary, typeReplicaSecondary
+	if cl.cluster.ClusterType != "REPLICASET" {
+		shards, primary, secondary = 0, typeShardPrimary, typeShardSecondary
+		for _, spec := range cl.cluster.ReplicationSpecs {
+			shards += spec.NumShards
+		}
+	}
+
+	host := strings.ToLower(cl.cluster.Name)
+	internalHost := "atlas-" + cl.cluster.ID[len(cl.cluster.ID)-6:]
+	var processes []admin.ApiHostViewAtlas
+	for shard := 0; shard < shards; shard++ {
+		for node := 0; node < processReplicaNodes; node++ {
+			suffix := fmt.Sprintf("-shard-%02d-%02d.mongodb.net", shard, node)
+			process := admin.ApiHostViewAtlas{
+				GroupId:        pointer(cl.cluster.GroupID),
+				Hostname:       pointer(internalHost + suffix),
+				Id:             pointer(fmt.Sprintf("%s%s:%d", internalHost, suffix, processPort)),
+				Port:           pointer(processPort),
+				ReplicaSetName: pointer(fmt.Sprintf("%s-shard-%d", internalHost, shard)),
+				TypeName:       pointer(secondary),
+				UserAlias:      pointer(host + suffix),
+				Version:        pointer(cl.cluster.MongoDBVersion),
+			}
+			if node == 0 {
+				process.TypeName = pointer(primary)
+			}
+			processes = append(processes, process)
+		}
+	}
+	return processes
+}
+
+// lookupProcess returns the cluster running the process identified by the groupId and processId parameters, and
+// whether the process is a primary, or writes a 404 response.
+func (s *Server) lookupProcess(w http.ResponseWriter, params map[string]string) (*cluster, bool, bool) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return nil, false, false
+	}
+	for _, cl := range p.clusters {
+		for _, process := range cl.processes() {
+			if *process.Id == params["processId"] {
+				return cl, *process.TypeName == typeReplicaPrimary || *process.TypeName == typeShardPrimary, true
+			}
+		}
+	}
+	writeError(w, http.StatusNotFound, "HOST_NOT_FOUND", fmt.Sprintf("No host with ID %s exists in group %s.", params["processId"], params["groupId"]))
+	return nil, false, false
+}
+
+func (s *Server) registerPerformanceAdvisorRoutes() {
+	const (
+		processes = "/api/atlas/v2/groups/{groupId}/processes"
+		advisor   = processes + "/{processId}/performanceAdvisor"
+	)
+
+	s.handle(http.MethodGet, processes, s.listProcesses)
+	s.handle(http.MethodGet, advisor+"/suggestedIndexes", s.listSuggestedIndexes)
+	s.handle(http.MethodGet, advisor+"/slowQueryLogs", s.listSlowQueries)
+	s.handle(http.MethodGet, "/api/atlas/v2/groups/{groupId}/clusters/{clusterName}/performanceAdvisor/schemaAdvice", s.getSchemaAdvice)
+}
+
+func (s *Server) listProcesses(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	names := make([]string, 0, len(p.clusters))
+	for name := range p.clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := []admin.ApiHostViewAtlas{}
+	for _, name := range names {
+		results = append(results, p.clusters[name].processes()...)
+	}
+	writeJSON(w, http.StatusOK, listResponse(results))
+}
+
+func (s *Server) listSuggestedIndexes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	cl, isPrimary, ok := s.lookupProcess(w, params)
+	if !ok {
+		return
+	}
+	namespaces, ok := performanceAdvisorFilter(w, r.URL.Query())
+	if !ok {
+		return
+	}
+	response := admin.PerformanceAdvisorResponse{
+		Shapes:           []admin.PerformanceAdvisorShape{},
+		SuggestedIndexes: []admin.PerformanceAdvisorIndex{},
+	}
+	if isPrimary && cl.advice != nil {
+		for _, index := range cl.advice.SuggestedIndexes {
+			if namespaces.match(index.GetNamespace()) {
+				response.SuggestedIndexes = append(response.SuggestedIndexes, index)
+			}
+		}
+		for _, shape := range cl.advice.Shapes {
+			if namespaces.match(shape.GetNamespace()) {
+				response.Shapes = append(response.Shapes, shape)
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) listSlowQueries(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	cl, isPrimary, ok := s.lookupProcess(w, params)
+	if !ok {
+		return
+	}
+	namespaces, ok := performanceAdvisorFilter(w, r.URL.Query())
+	if !ok {
+		return
+	}
+	response := admin.PerformanceAdvisorSlowQueryList{SlowQueries: []admin.PerformanceAdvisorSlowQuery{}}
+	if isPrimary && cl.advice != nil {
+		for _, query := range cl.advice.SlowQueries {
+			if namespaces.match(query.GetNamespace()) {
+				response.SlowQueries = append(response.SlowQueries, query)
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) getSchemaAdvice(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	_, cl, ok := s.lookupCluster(w, params)
+	if !ok {
+		return
+	}
+	recommendations := []SchemaAdviceRecommendation{}
+	if cl.advice != nil {
+		recommendations = append(recommendations, cl.advice.SchemaAdvice...)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"content": map[string]any{"recommendations": recommendations},
+	})
+}
+
+type namespaceFilter []string
+
+func (f namespaceFilter) match(namespace string) bool {
+	if len(f) == 0 {
+		return true
+	}
+	for _, ns := range f {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// performanceAdvisorFilter validates the time window of a Performance Advisor request and returns its namespaces.
+// The fake reports the same advice for any time window.
+func performanceAdvisorFilter(w http.ResponseWriter, query url.Values) (namespaceFilter, bool) {
+	for _, param := range []string{"since", "duration"} {
+		if value := query.Get(param); value != "" {
+			if n, err := strconv.ParseInt(value, 10, 64); err != nil || n < 0 {
+				writeError(w, http.StatusBadRequest, "INVALID_QUERY_PARAMETER", fmt.Sprintf("Invalid value %s for query parameter %s.", value, param))
+				return nil, false
+			}
+		}
+	}
+	return namespaceFilter(query["namespaces"]), true
+}
@@ -0,0 +1,264 @@
+package atlastest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+type project struct {
+	project       matlas.Project
+	settings      matlas.ProjectSettings
+	limits        map[string]admin.DataFederationLimit
+	clusters      map[string]*cluster
+	databaseUsers map[string]*matlas.DatabaseUser
+	accessList    map[string]*matlas.ProjectIPAccessList
+	teams         []*matlas.Result
+}
+
+// CreateProject adds a project to the server without going through the API and returns its ID.
+func (s *Server) CreateProject(orgID, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addProject(matlas.Project{OrgID: orgID, Name: name}).project.ID
+}
+
+func (s *Server) addProject(p matlas.Project) *project {
+	p.ID = s.newID()
+	p.Created = time.Now().UTC().Format(time.RFC3339)
+	p.WithDefaultAlertsSettings = nil
+	p.Links = nil
+
+	proj := &project{
+		project: p,
+		settings: matlas.ProjectSettings{
+			IsCollectDatabaseSpecificsStatisticsEnabled: pointer(true),
+			IsDataExplorerEnabled:                       pointer(true),
+			IsExtendedStorageSizesEnabled:               pointer(false),
+			IsPerformanceAdvisorEnabled:                 pointer(true),
+			IsRealtimePerformancePanelEnabled:           pointer(true),
+			IsSchemaAdvisorEnabled:                      pointer(true),
+		},
+		limits:        map[string]admin.DataFederationLimit{},
+		clusters:      map[string]*cluster{},
+		databaseUsers: map[string]*matlas.DatabaseUser{},
+		accessList:    map[string]*matlas.ProjectIPAccessList{},
+	}
+	s.projects[p.ID] = proj
+	return proj
+}
+
+// lookupProject returns the project identified by the groupId parameter or writes a 404 response.
+func (s *Server) lookupProject(w http.ResponseWriter, params map[string]string) (*project, bool) {
+	p, ok := s.projects[params["groupId"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "GROUP_NOT_FOUND", fmt.Sprintf("No group with ID %s exists.", params["groupId"]))
+	}
+	return p, ok
+}
+
+func (s *Server) registerProjectRoutes() {
+	const (
+		groups = "/api/atlas/v1.0/groups"
+		group  = groups + "/{groupId}"
+		limits = "/api/atlas/v2/groups/{groupId}/limits"
+	)
+
+	s.handle(http.MethodPost, groups, s.createProject)
+	s.handle(http.MethodGet, group, s.getProject)
+	s.handle(http.MethodPatch, group, s.updateProject)
+	s.handle(http.MethodDelete, group, s.deleteProject)
+	s.handle(http.MethodGet, group+"/settings", s.getProjectSettings)
+	s.handle(http.MethodPatch, group+"/settings", s.updateProjectSettings)
+	s.handle(http.MethodGet, group+"/teams", s.getProjectTeams)
+	s.handle(http.MethodPost, group+"/teams", s.addProjectTeams)
+	s.handle(http.MethodPatch, group+"/teams/{teamId}", s.updateProjectTeamRoles)
+	s.handle(http.MethodDelete, group+"/teams/{teamId}", s.removeProjectTeam)
+	s.handle(http.MethodGet, group+"/containers", s.listContainers)
+	s.handle(http.MethodGet, limits, s.listProjectLimits)
+	s.handle(http.MethodPatch, limits+"/{limitName}", s.setProjectLimit)
+	s.handle(http.MethodDelete, limits+"/{limitName}", s.deleteProjectLimit)
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var req matlas.Project
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.OrgID == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes name and orgId were not specified.")
+		return
+	}
+	for _, p := range s.projects {
+		if p.project.OrgID == req.OrgID && p.project.Name == req.Name {
+			writeError(w, http.StatusConflict, "GROUP_ALREADY_EXISTS", fmt.Sprintf("A group with name %q already exists.", req.Name))
+			return
+		}
+	}
+	writeJSON(w, http.StatusCreated, s.addProject(req).project)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if p, ok := s.lookupProject(w, params); ok {
+		p.project.ClusterCount = len(p.clusters)
+		writeJSON(w, http.StatusOK, p.project)
+	}
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	var req matlas.ProjectUpdateRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	p.project.Name = req.Name
+	writeJSON(w, http.StatusOK, p.project)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	if len(p.clusters) > 0 {
+		writeError(w, http.StatusConflict, "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS", "Cannot close group while it has active clusters.")
+		return
+	}
+	delete(s.projects, p.project.ID)
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (s *Server) getProjectSettings(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if p, ok := s.lookupProject(w, params); ok {
+		writeJSON(w, http.StatusOK, p.settings)
+	}
+}
+
+func (s *Server) updateProjectSettings(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok || !mergeBody(w, r, &p.settings) {
+		return
+	}
+	writeJSON(w, http.StatusOK, p.settings)
+}
+
+func (s *Server) getProjectTeams(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if p, ok := s.lookupProject(w, params); ok {
+		writeJSON(w, http.StatusOK, listResponse(p.teams))
+	}
+}
+
+func (s *Server) addProjectTeams(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	var req []*matlas.ProjectTeam
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	for _, team := range req {
+		if assigned := p.lookupTeam(team.TeamID); assigned != nil {
+			assigned.RoleNames = team.RoleNames
+			continue
+		}
+		p.teams = append(p.teams, &matlas.Result{TeamID: team.TeamID, RoleNames: team.RoleNames})
+	}
+	writeJSON(w, http.StatusOK, listResponse(p.teams))
+}
+
+func (s *Server) updateProjectTeamRoles(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	team := p.lookupTeam(params["teamId"])
+	if team == nil {
+		writeError(w, http.StatusNotFound, "TEAM_NOT_FOUND_IN_GROUP", fmt.Sprintf("Team %s is not assigned to group %s.", params["teamId"], params["groupId"]))
+		return
+	}
+	var req matlas.TeamUpdateRoles
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	team.RoleNames = req.RoleNames
+	writeJSON(w, http.StatusOK, listResponse([]matlas.TeamRoles{{TeamID: team.TeamID, RoleNames: team.RoleNames}}))
+}
+
+func (s *Server) removeProjectTeam(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	for i, team := range p.teams {
+		if team.TeamID == params["teamId"] {
+			p.teams = append(p.teams[:i], p.teams[i+1:]...)
+			writeJSON(w, http.StatusNoContent, nil)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "TEAM_NOT_FOUND_IN_GROUP", fmt.Sprintf("Team %s is not assigned to group %s.", params["teamId"], params["groupId"]))
+}
+
+func (p *project) lookupTeam(teamID string) *matlas.Result {
+	for _, team := range p.teams {
+		if team.TeamID == teamID {
+			return team
+		}
+	}
+	return nil
+}
+
+// listContainers reports no network containers: the server does not model network peering.
+func (s *Server) listContainers(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, ok := s.lookupProject(w, params); ok {
+		writeJSON(w, http.StatusOK, listResponse([]matlas.Container{}))
+	}
+}
+
+func (s *Server) listProjectLimits(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	limits := make([]admin.DataFederationLimit, 0, len(p.limits))
+	for _, limit := range p.limits {
+		limits = append(limits, limit)
+	}
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Name < limits[j].Name })
+	writeJSON(w, http.StatusOK, limits)
+}
+
+func (s *Server) setProjectLimit(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	var req admin.DataFederationLimit
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	req.Name = params["limitName"]
+	p.limits[req.Name] = req
+	writeJSON(w, http.StatusOK, req)
+}
+
+func (s *Server) deleteProjectLimit(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	p, ok := s.lookupProject(w, params)
+	if !ok {
+		return
+	}
+	delete(p.limits, params["limitName"])
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,153 @@
+package atlastest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+)
+
+const (
+	searchIndexInProgress = "IN_PROGRESS"
+	searchIndexSteady     = "STEADY"
+	vectorSearchType      = "vectorSearch"
+)
+
+// searchIndexDocument extends the SDK model with the type and fields of vector search indexes, which the
+// versioned API returns for the same endpoints.
+type searchIndexDocument struct {
+	admin.ClusterSearchIndex
+	Type   *string          `json:"type,omitempty"`
+	Fields []map[string]any `json:"fields,omitempty"`
+}
+
+type searchIndex struct {
+	index        searchIndexDocument
+	pendingReads int
+}
+
+// read returns the index as seen by a client and advances its build.
+func (i *searchIndex) read() searchIndexDocument {
+	if i.pendingReads > 0 {
+		i.pendingReads--
+	} else {
+		i.index.Status = pointer(searchIndexSteady)
+	}
+	return i.index
+}
+
+func (s *Server) lookupSearchIndex(w http.ResponseWriter, params map[string]string) (*cluster, *searchIndex, bool) {
+	_, cl, ok := s.lookupCluster(w, params)
+	if !ok {
+		return nil, nil, false
+	}
+	index, ok := cl.searchIndexes[params["indexId"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "INDEX_NOT_FOUND", fmt.Sprintf("Index %s not found.", params["indexId"]))
+	}
+	return cl, index, ok
+}
+
+func (s *Server) registerSearchIndexRoutes() {
+	const indexes = "/api/atlas/v2/groups/{groupId}/clusters/{clusterName}/fts/indexes"
+
+	s.handle(http.MethodPost, indexes, s.createSearchIndex)
+	s.handle(http.MethodGet, indexes+"/{databaseName}/{collectionName}", s.listSearchIndexes)
+	s.handle(http.MethodGet, indexes+"/{indexId}", s.getSearchIndex)
+	s.handle(http.MethodPatch, indexes+"/{indexId}", s.updateSearchIndex)
+	s.handle(http.MethodDelete, indexes+"/{indexId}", s.deleteSearchIndex)
+}
+
+func (s *Server) createSearchIndex(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, cl, ok := s.lookupCluster(w, params)
+	if !ok {
+		return
+	}
+	var req searchIndexDocument
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.Database == "" || req.CollectionName == "" {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attributes name, database and collectionName were not specified.")
+		return
+	}
+	if req.Type != nil && *req.Type == vectorSearchType && len(req.Fields) == 0 {
+		writeError(w, http.StatusBadRequest, "MISSING_ATTRIBUTE", "The required attribute fields was not specified.")
+		return
+	}
+	for _, existing := range cl.searchIndexes {
+		if existing.index.Name == req.Name && existing.index.Database == req.Database && existing.index.CollectionName == req.CollectionName {
+			writeError(w, http.StatusBadRequest, "DUPLICATE_SEARCH_INDEX_NAME", fmt.Sprintf("Index %s already exists.", req.Name))
+			return
+		}
+	}
+
+	setSearchIndexDefaults(&req)
+	req.IndexID = pointer(s.newID())
+	req.Status = pointer(searchIndexInProgress)
+	cl.searchIndexes[*req.IndexID] = &searchIndex{index: req, pendingReads: s.transitionReads}
+	writeJSON(w, http.StatusOK, req)
+}
+
+func (s *Server) getSearchIndex(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	if _, index, ok := s.lookupSearchIndex(w, params); ok {
+		writeJSON(w, http.StatusOK, index.read())
+	}
+}
+
+func (s *Server) listSearchIndexes(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	_, cl, ok := s.lookupCluster(w, params)
+	if !ok {
+		return
+	}
+	results := []searchIndexDocument{}
+	for _, index := range cl.searchIndexes {
+		if index.index.Database == params["databaseName"] && index.index.CollectionName == params["collectionName"] {
+			results = append(results, index.read())
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return *results[i].IndexID < *results[j].IndexID })
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) updateSearchIndex(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	_, index, ok := s.lookupSearchIndex(w, params)
+	if !ok {
+		return
+	}
+	updated := index.index
+	if !mergeBody(w, r, &updated) {
+		return
+	}
+	setSearchIndexDefaults(&updated)
+	updated.IndexID = index.index.IndexID
+	updated.Status = pointer(searchIndexInProgress)
+	index.index = updated
+	index.pendingReads = s.transitionReads
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (s *Server) deleteSearchIndex(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+	cl, index, ok := s.lookupSearchIndex(w, params)
+	if !ok {
+		return
+	}
+	delete(cl.searchIndexes, *index.index.IndexID)
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func setSearchIndexDefaults(index *searchIndexDocument) {
+	if index.Type != nil && *index.Type == vectorSearchType {
+		return
+	}
+	if index.Analyzer == nil {
+		index.Analyzer = pointer("lucene.standard")
+	}
+	if index.SearchAnalyzer == nil {
+		index.SearchAnalyzer = pointer("lucene.standard")
+	}
+	if index.Mappings == nil {
+		index.Mappings = &admin.ApiAtlasFTSMappings{Dynamic: pointer(false)}
+	}
+}
@@ -0,0 +1,286 @@
+// Package atlastest provides an in-memory stand-in for the Atlas Admin API so that resources can be
+// exercised end to end without credentials or network access.
+//
+// The server understands the subset of the legacy (v1.0/v1.5) and versioned (v2) endpoints used by the
+// project, project settings and limits, advanced cluster, database user, project IP access list and
+// search index resources, including vector search indexes, and by the Performance Advisor data sources,
+// which report the advice set with SetPerformanceAdvice. Asynchronous operations are simulated: a new or
+// updated cluster is reported as CREATING or UPDATING and a new search index as IN_PROGRESS for a
+// configurable number of reads before reaching IDLE or STEADY, and a deleted cluster is reported as
+// DELETING before disappearing.
+//
+// Endpoints the server doesn't emulate can be answered by routes added with AddRoute, and the requests
+// received are returned by Requests so that tests can check the calls made to the API.
+package atlastest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const defaultTransitionReads = 1
+
+// Server is a fake Atlas Admin API backed by an httptest.Server.
+type Server struct {
+	server          *httptest.Server
+	projects        map[string]*project
+	routes          []route
+	requests        []string
+	transitionReads int
+	lastID          int
+	mu              sync.Mutex
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithTransitionReads sets how many reads of a cluster or search index report the intermediate state
+// (CREATING, UPDATING, DELETING or IN_PROGRESS) before the operation completes. Zero completes every
+// operation synchronously.
+func WithTransitionReads(reads int) Option {
+	return func(s *Server) {
+		s.transitionReads = reads
+	}
+}
+
+// NewServer starts a fake Atlas Admin API. Callers must call Close when finished.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		projects:        map[string]*project{},
+		transitionReads: defaultTransitionReads,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.registerProjectRoutes()
+	s.registerClusterRoutes()
+	s.registerDatabaseUserRoutes()
+	s.registerAccessListRoutes()
+	s.registerSearchIndexRoutes()
+	s.registerPerformanceAdvisorRoutes()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL returns the base URL of the server, suitable for the provider `base_url` argument.
+func (s *Server) URL() string {
+	return s.server.URL + "/"
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Requests returns the requests received by the server, as method and URI, in order.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// RouteHandler answers a request to a route added with AddRoute with a status code and a body. A string
+// body is written as it is and any other body but nil is encoded as JSON.
+type RouteHandler func(r *http.Request) (status int, body any)
+
+// AddRoute answers the requests to pattern, e.g. "/api/atlas/v2/groups/{groupId}/streams", with handler.
+// Added routes take precedence over the emulated endpoints. If mediaType is set the route is versioned:
+// requests that don't accept mediaType are rejected as Atlas does and responses use it as content type.
+func (s *Server) AddRoute(method, pattern, mediaType string, handler RouteHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append([]route{{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler: func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			contentType := "application/json"
+			if mediaType != "" {
+				if accept := r.Header.Get("Accept"); accept != mediaType {
+					writeError(w, http.StatusNotAcceptable, "INVALID_VERSION_DATE", fmt.Sprintf("Invalid Accept header %q.", accept))
+					return
+				}
+				contentType = mediaType
+			}
+			status, body := handler(r)
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(status)
+			switch body := body.(type) {
+			case nil:
+			case string:
+				_, _ = w.Write([]byte(body))
+			default:
+				_ = json.NewEncoder(w).Encode(body)
+			}
+		},
+	}}, s.routes...)
+}
+
+// Responses returns a RouteHandler that answers each request with the next of the given JSON responses,
+// with the status of their "error" field if they are API errors. Requests made after all the responses
+// are used are answered with a 400 UNEXPECTED_REQUEST error, which isn't retried by the provider.
+func Responses(responses ...string) RouteHandler {
+	return func(r *http.Request) (int, any) {
+		if len(responses) == 0 {
+			return http.StatusBadRequest, map[string]any{
+				"detail":    fmt.Sprintf("Unexpected request %s %s.", r.Method, r.URL.RequestURI()),
+				"error":     http.StatusBadRequest,
+				"errorCode": "UNEXPECTED_REQUEST",
+			}
+		}
+		response := responses[0]
+		responses = responses[1:]
+		var apiError struct {
+			Error int `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(response), &apiError); err == nil && apiError.Error != 0 {
+			return apiError.Error, response
+		}
+		return http.StatusOK, response
+	}
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type route struct {
+	handler  handlerFunc
+	method   string
+	segments []string
+}
+
+func (s *Server) handle(method, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+	routes := s.routes
+	s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = unescaped
+		}
+	}
+
+	methodMismatch := false
+	for _, rt := range routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			methodMismatch = true
+			continue
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		rt.handler(w, r, params)
+		return
+	}
+
+	if methodMismatch {
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", fmt.Sprintf("Method %s is not allowed for %s.", r.Method, r.URL.Path))
+		return
+	}
+	writeError(w, http.StatusNotFound, "RESOURCE_NOT_FOUND", fmt.Sprintf("Cannot find resource %s.", r.URL.Path))
+}
+
+func (rt *route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// newID returns a unique 24-hexadecimal digit identifier like the ones generated by Atlas.
+func (s *Server) newID() string {
+	s.lastID++
+	return fmt.Sprintf("%024x", s.lastID)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, errorCode, detail string) {
+	writeJSON(w, status, map[string]any{
+		"detail":     detail,
+		"error":      status,
+		"errorCode":  errorCode,
+		"parameters": []string{},
+		"reason":     http.StatusText(status),
+	})
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", fmt.Sprintf("Received JSON is malformed: %s.", err))
+		return false
+	}
+	return true
+}
+
+// mergeBody applies the top-level attributes present in the request body to v, emulating the partial
+// update semantics of Atlas PATCH endpoints.
+func mergeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	current := map[string]json.RawMessage{}
+	changes := map[string]json.RawMessage{}
+	existing, _ := json.Marshal(v)
+	if err := json.Unmarshal(existing, &current); err != nil {
+		writeError(w, http.StatusInternalServerError, "UNEXPECTED_ERROR", err.Error())
+		return false
+	}
+	if !decodeBody(w, r, &changes) {
+		return false
+	}
+	for k, change := range changes {
+		current[k] = change
+	}
+
+	// Decode into a zero value so nested objects present in the request replace the existing ones.
+	target := reflect.ValueOf(v).Elem()
+	target.Set(reflect.Zero(target.Type()))
+	merged, _ := json.Marshal(current)
+	if err := json.Unmarshal(merged, v); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return false
+	}
+	return true
+}
+
+func listResponse[T any](results []T) map[string]any {
+	if results == nil {
+		results = []T{}
+	}
+	return map[string]any{
+		"links":      []any{},
+		"results":    results,
+		"totalCount": len(results),
+	}
+}
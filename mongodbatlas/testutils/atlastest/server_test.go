@@ -0,0 +1,342 @@
+package atlastest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/apierror"
+	"github.com/mongodb/terraform-provider-mongodbatlas/mongodbatlas/testutils/atlastest"
+	"go.mongodb.org/atlas-sdk/v20231001001/admin"
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+const orgID = "5e2211c17a3e5a48f5497de3"
+
+func newClients(t *testing.T, server *atlastest.Server) (*matlas.Client, *admin.APIClient) {
+	t.Helper()
+	client, err := matlas.New(nil, matlas.SetBaseURL(server.URL()))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	clientV2, err := admin.NewClient(admin.UseBaseURL(server.URL()))
+	if err != nil {
+		t.Fatalf("unexpected error creating versioned client: %s", err)
+	}
+	return client, clientV2
+}
+
+func TestProjects(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+	client, clientV2 := newClients(t, server)
+	ctx := context.Background()
+
+	project, _, err := client.Projects.Create(ctx, &matlas.Project{OrgID: orgID, Name: "test"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	if _, _, err := client.Projects.Create(ctx, &matlas.Project{OrgID: orgID, Name: "test"}, nil); !apierror.IsConflict(err) {
+		t.Errorf("expected conflict creating a duplicate project, got %v", err)
+	}
+
+	settings, _, err := client.Projects.UpdateProjectSettings(ctx, project.ID, &matlas.ProjectSettings{IsDataExplorerEnabled: pointer(false)})
+	if err != nil {
+		t.Fatalf("unexpected error updating settings: %s", err)
+	}
+	if *settings.IsDataExplorerEnabled || !*settings.IsSchemaAdvisorEnabled {
+		t.Errorf("expected only isDataExplorerEnabled to change, got %+v", settings)
+	}
+
+	limit := admin.NewDataFederationLimit("atlas.project.deployment.clusters", 10)
+	if _, _, err := clientV2.ProjectsApi.SetProjectLimit(ctx, limit.Name, project.ID, limit).Execute(); err != nil {
+		t.Fatalf("unexpected error setting limit: %s", err)
+	}
+	limits, _, err := clientV2.ProjectsApi.ListProjectLimits(ctx, project.ID).Execute()
+	if err != nil || len(limits) != 1 || limits[0].Value != 10 {
+		t.Errorf("unexpected limits %+v (error %v)", limits, err)
+	}
+
+	const teamID = "6b0a1f1d2e3c4b5a69788796"
+	if _, _, err := client.Projects.AddTeamsToProject(ctx, project.ID, []*matlas.ProjectTeam{{TeamID: teamID, RoleNames: []string{"GROUP_READ_ONLY"}}}); err != nil {
+		t.Fatalf("unexpected error adding team: %s", err)
+	}
+	if _, _, err := client.Teams.UpdateTeamRoles(ctx, project.ID, teamID, &matlas.TeamUpdateRoles{RoleNames: []string{"GROUP_OWNER"}}); err != nil {
+		t.Fatalf("unexpected error updating team roles: %s", err)
+	}
+	teams, _, err := client.Projects.GetProjectTeamsAssigned(ctx, project.ID)
+	if err != nil || len(teams.Results) != 1 || teams.Results[0].RoleNames[0] != "GROUP_OWNER" {
+		t.Errorf("unexpected teams %+v (error %v)", teams, err)
+	}
+	if _, err := client.Teams.RemoveTeamFromProject(ctx, project.ID, teamID); err != nil {
+		t.Fatalf("unexpected error removing team: %s", err)
+	}
+	if _, err := client.Teams.RemoveTeamFromProject(ctx, project.ID, teamID); !apierror.IsNotFound(err) {
+		t.Errorf("expected team to be removed, got %v", err)
+	}
+
+	if _, err := client.Projects.Delete(ctx, project.ID); err != nil {
+		t.Fatalf("unexpected error deleting project: %s", err)
+	}
+	if _, _, err := client.Projects.GetOneProject(ctx, project.ID); !apierror.IsNotFound(err) {
+		t.Errorf("expected project to be deleted, got %v", err)
+	}
+}
+
+func TestClusterStateTransitions(t *testing.T) {
+	server := atlastest.NewServer(atlastest.WithTransitionReads(2))
+	defer server.Close()
+	client, _ := newClients(t, server)
+	ctx := context.Background()
+	projectID := server.CreateProject(orgID, "test")
+
+	request := &matlas.AdvancedCluster{
+		Name:        "cluster",
+		ClusterType: "REPLICASET",
+		ReplicationSpecs: []*matlas.AdvancedReplicationSpec{{
+			RegionConfigs: []*matlas.AdvancedRegionConfig{{
+				ProviderName:   "AWS",
+				RegionName:     "US_EAST_1",
+				Priority:       pointer(7),
+				ElectableSpecs: &matlas.Specs{InstanceSize: "M10", NodeCount: pointer(3)},
+			}},
+		}},
+	}
+	created, _, err := client.AdvancedClusters.Create(ctx, projectID, request)
+	if err != nil {
+		t.Fatalf("unexpected error creating cluster: %s", err)
+	}
+	if created.StateName != "CREATING" || created.ID == "" || created.ReplicationSpecs[0].ID == "" {
+		t.Errorf("unexpected cluster after create: %+v", created)
+	}
+
+	expectStates := func(states ...string) {
+		t.Helper()
+		for _, state := range states {
+			cluster, _, err := client.AdvancedClusters.Get(ctx, projectID, "cluster")
+			if state == "" {
+				if !apierror.IsNotFound(err) {
+					t.Fatalf("expected cluster to be deleted, got %v", err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("unexpected error reading cluster: %s", err)
+			}
+			if cluster.StateName != state {
+				t.Fatalf("expected state %s, got %s", state, cluster.StateName)
+			}
+		}
+	}
+	expectStates("CREATING", "CREATING", "IDLE", "IDLE")
+
+	if _, _, err := client.AdvancedClusters.Update(ctx, projectID, "cluster", &matlas.AdvancedCluster{Paused: pointer(true)}); err != nil {
+		t.Fatalf("unexpected error pausing cluster: %s", err)
+	}
+	expectStates("UPDATING", "UPDATING", "IDLE")
+	if _, _, err := client.AdvancedClusters.Update(ctx, projectID, "cluster", &matlas.AdvancedCluster{DiskSizeGB: pointer(20.0)}); !apierror.IsClusterPaused(err) {
+		t.Errorf("expected paused cluster error, got %v", err)
+	}
+
+	if _, err := client.Projects.Delete(ctx, projectID); !apierror.IsConflict(err) {
+		t.Errorf("expected conflict deleting a project with clusters, got %v", err)
+	}
+	if _, err := client.AdvancedClusters.Delete(ctx, projectID, "cluster", nil); err != nil {
+		t.Fatalf("unexpected error deleting cluster: %s", err)
+	}
+	expectStates("DELETING", "DELETING", "")
+}
+
+func TestDatabaseUsersAndAccessList(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+	client, _ := newClients(t, server)
+	ctx := context.Background()
+	projectID := server.CreateProject(orgID, "test")
+
+	user := &matlas.DatabaseUser{
+		Username:     "user@example.com",
+		Password:     "secret",
+		DatabaseName: "admin",
+		Roles:        []matlas.Role{{RoleName: "readAnyDatabase", DatabaseName: "admin"}},
+	}
+	if _, _, err := client.DatabaseUsers.Create(ctx, projectID, user); err != nil {
+		t.Fatalf("unexpected error creating user: %s", err)
+	}
+	got, _, err := client.DatabaseUsers.Get(ctx, "admin", projectID, "user@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error reading user: %s", err)
+	}
+	if got.Password != "" || got.GroupID != projectID || len(got.Roles) != 1 {
+		t.Errorf("unexpected user %+v", got)
+	}
+	if _, err := client.DatabaseUsers.Delete(ctx, "admin", projectID, "user@example.com"); err != nil {
+		t.Fatalf("unexpected error deleting user: %s", err)
+	}
+	if _, _, err := client.DatabaseUsers.Get(ctx, "admin", projectID, "user@example.com"); !apierror.IsNotFound(err) {
+		t.Errorf("expected user to be deleted, got %v", err)
+	}
+
+	entries := []*matlas.ProjectIPAccessList{{IPAddress: "10.0.0.1"}, {CIDRBlock: "192.168.0.0/16", Comment: "vpc"}}
+	list, _, err := client.ProjectIPAccessList.Create(ctx, projectID, entries)
+	if err != nil {
+		t.Fatalf("unexpected error creating access list: %s", err)
+	}
+	if list.TotalCount != 2 {
+		t.Errorf("expected 2 access list entries, got %d", list.TotalCount)
+	}
+	for _, entry := range []string{"10.0.0.1", "10.0.0.1/32", "192.168.0.0/16"} {
+		if _, _, err := client.ProjectIPAccessList.Get(ctx, projectID, entry); err != nil {
+			t.Errorf("unexpected error reading access list entry %s: %s", entry, err)
+		}
+	}
+	if _, err := client.ProjectIPAccessList.Delete(ctx, projectID, "192.168.0.0/16"); err != nil {
+		t.Fatalf("unexpected error deleting access list entry: %s", err)
+	}
+	if _, _, err := client.ProjectIPAccessList.Get(ctx, projectID, "192.168.0.0/16"); !apierror.IsNotFound(err) {
+		t.Errorf("expected access list entry to be deleted, got %v", err)
+	}
+}
+
+func TestSearchIndexes(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+	_, clientV2 := newClients(t, server)
+	ctx := context.Background()
+	projectID := server.CreateProject(orgID, "test")
+
+	index := admin.NewClusterSearchIndex("sample", "movies", "default")
+	if _, _, err := clientV2.AtlasSearchApi.CreateAtlasSearchIndex(ctx, projectID, "cluster", index).Execute(); !apierror.IsNotFound(err) {
+		t.Errorf("expected cluster not found, got %v", err)
+	}
+
+	if err := server.CreateCluster(projectID, &matlas.AdvancedCluster{Name: "cluster"}); err != nil {
+		t.Fatalf("unexpected error seeding cluster: %s", err)
+	}
+	created, _, err := clientV2.AtlasSearchApi.CreateAtlasSearchIndex(ctx, projectID, "cluster", index).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error creating search index: %s", err)
+	}
+	for _, status := range []string{"IN_PROGRESS", "STEADY"} {
+		got, _, err := clientV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, "cluster", created.GetIndexID()).Execute()
+		if err != nil {
+			t.Fatalf("unexpected error reading search index: %s", err)
+		}
+		if got.GetStatus() != status || got.Mappings == nil {
+			t.Errorf("expected status %s with mappings, got %+v", status, got)
+		}
+	}
+
+	if _, _, err := clientV2.AtlasSearchApi.DeleteAtlasSearchIndex(ctx, projectID, "cluster", created.GetIndexID()).Execute(); err != nil {
+		t.Fatalf("unexpected error deleting search index: %s", err)
+	}
+	if _, _, err := clientV2.AtlasSearchApi.GetAtlasSearchIndex(ctx, projectID, "cluster", created.GetIndexID()).Execute(); !apierror.IsNotFound(err) {
+		t.Errorf("expected search index to be deleted, got %v", err)
+	}
+}
+
+func TestPerformanceAdvisor(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+	_, clientV2 := newClients(t, server)
+	ctx := context.Background()
+	projectID := server.CreateProject(orgID, "test")
+
+	if err := server.CreateCluster(projectID, &matlas.AdvancedCluster{Name: "Cluster0"}); err != nil {
+		t.Fatalf("unexpected error seeding cluster: %s", err)
+	}
+	err := server.SetPerformanceAdvice(projectID, "Cluster0", &atlastest.PerformanceAdvice{
+		SuggestedIndexes: []admin.PerformanceAdvisorIndex{
+			{Id: pointer("1"), Namespace: pointer("store.orders"), Index: []map[string]int{{"customerId": 1}}},
+			{Id: pointer("2"), Namespace: pointer("store.products"), Index: []map[string]int{{"sku": 1}}},
+		},
+		SlowQueries: []admin.PerformanceAdvisorSlowQuery{{Namespace: pointer("store.orders"), Line: pointer("{}")}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error seeding performance advice: %s", err)
+	}
+
+	processes, _, err := clientV2.MonitoringAndLogsApi.ListAtlasProcesses(ctx, projectID).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error listing processes: %s", err)
+	}
+	if processes.GetTotalCount() != 3 {
+		t.Fatalf("expected 3 processes, got %d", processes.GetTotalCount())
+	}
+	primary, secondary := processes.Results[0], processes.Results[1]
+	if primary.GetTypeName() != "REPLICA_PRIMARY" || primary.GetUserAlias() != "cluster0-shard-00-00.mongodb.net" {
+		t.Errorf("unexpected primary %+v", primary)
+	}
+
+	indexes, _, err := clientV2.PerformanceAdvisorApi.ListSuggestedIndexes(ctx, projectID, primary.GetId()).
+		Namespaces([]string{"store.orders"}).Since(1).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error listing suggested indexes: %s", err)
+	}
+	if len(indexes.SuggestedIndexes) != 1 || indexes.SuggestedIndexes[0].GetId() != "1" {
+		t.Errorf("expected the index of store.orders, got %+v", indexes.SuggestedIndexes)
+	}
+	queries, _, err := clientV2.PerformanceAdvisorApi.ListSlowQueries(ctx, projectID, secondary.GetId()).Execute()
+	if err != nil {
+		t.Fatalf("unexpected error listing slow queries: %s", err)
+	}
+	if len(queries.SlowQueries) != 0 {
+		t.Errorf("expected no slow queries for a secondary, got %+v", queries.SlowQueries)
+	}
+	if _, _, err := clientV2.PerformanceAdvisorApi.ListSlowQueries(ctx, projectID, "unknown:27017").Execute(); !apierror.IsNotFound(err) {
+		t.Errorf("expected process not found, got %v", err)
+	}
+}
+
+func TestAddedRoutes(t *testing.T) {
+	server := atlastest.NewServer()
+	defer server.Close()
+
+	const mediaType = "application/vnd.atlas.2023-01-01+json"
+	const deployment = "/api/atlas/v2/groups/{groupId}/clusters/{clusterName}/search/deployment"
+	server.AddRoute(http.MethodGet, deployment, mediaType, atlastest.Responses(
+		`{"stateName":"UPDATING"}`,
+		`{"error":404,"errorCode":"ATLAS_SEARCH_DEPLOYMENT_DOES_NOT_EXIST"}`,
+	))
+
+	get := func(path, accept string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, server.URL()+path, http.NoBody)
+		if err != nil {
+			t.Fatalf("unexpected error creating the request: %s", err)
+		}
+		req.Header.Set("Accept", accept)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error sending the request: %s", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+
+	const path = "api/atlas/v2/groups/project/clusters/cluster/search/deployment"
+	if status, _ := get(path, "application/json"); status != http.StatusNotAcceptable {
+		t.Errorf("expected a request of another version to be rejected, got %d", status)
+	}
+	if status, body := get(path, mediaType); status != http.StatusOK || body != `{"stateName":"UPDATING"}` {
+		t.Errorf("unexpected first response %d %s", status, body)
+	}
+	if status, _ := get(path, mediaType); status != http.StatusNotFound {
+		t.Errorf("expected the status of the error response, got %d", status)
+	}
+	if status, _ := get(path, mediaType); status != http.StatusBadRequest {
+		t.Errorf("expected an error once the responses are used, got %d", status)
+	}
+
+	expected := []string{"GET /" + path, "GET /" + path, "GET /" + path, "GET /" + path}
+	if requests := server.Requests(); !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,348 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// cassetteModeEnvVar enables recording or replaying the HTTP traffic of every client, see cassetteTransport.
+	cassetteModeEnvVar = "MONGODB_ATLAS_CASSETTE_MODE"
+	// cassetteFileEnvVar is the fixture file interactions are recorded to or replayed from. It is read on
+	// every request so a single process can switch cassettes, e.g. one per acceptance test.
+	cassetteFileEnvVar = "MONGODB_ATLAS_CASSETTE"
+
+	cassetteModeRecord = "record"
+	cassetteModeReplay = "replay"
+
+	redactedValue = "REDACTED"
+)
+
+// sensitiveKeyParts are matched against lowercase JSON keys, any value of a matching key is redacted
+// before an interaction is written to a cassette.
+var sensitiveKeyParts = []string{"password", "secret", "privatekey", "apikey", "token", "servicekey", "serviceaccountkey", "routingkey"}
+
+// sensitivePathKeys are lowercase JSON keys that are only sensitive in the bodies of the endpoints whose
+// path matches, like the value of an App Services secret.
+var sensitivePathKeys = []struct {
+	path *regexp.Regexp
+	keys []string
+}{
+	{path: regexp.MustCompile(`/apps/[^/]+/secrets(/[^/]+)?$`), keys: []string{"value"}},
+}
+
+// recordedResponseHeaders are the only response headers kept in cassettes, the rest may contain
+// authentication challenges or session cookies and are not needed to replay a response.
+var recordedResponseHeaders = []string{"Content-Type", "Link", "Location", "Retry-After"}
+
+var (
+	cassettesMu sync.Mutex
+	cassettes   = map[string]*cassette{}
+)
+
+// cassette is the fixture file holding the interactions of a recording. Variables can be used by the
+// recording side to store values, like organization IDs, that must be the same when replaying.
+type cassette struct {
+	Variables    map[string]string `json:"variables,omitempty"`
+	path         string
+	Interactions []*interaction `json:"interactions"`
+	mu           sync.Mutex
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+	replayed bool
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	StatusCode int         `json:"status_code"`
+}
+
+// openCassette returns the cassette stored at path, shared by all the clients of the process. In record
+// mode the cassette starts empty and overwrites any previous recording the first time it is saved.
+func openCassette(mode, path string) (*cassette, error) {
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	if c, ok := cassettes[path]; ok {
+		return c, nil
+	}
+
+	c := &cassette{path: path}
+	if mode == cassetteModeReplay {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(content, c); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+	}
+	cassettes[path] = c
+	return c, nil
+}
+
+// closeCassette forgets the cassette stored at path so it is read, or recorded, again the next time it is opened.
+func closeCassette(path string) {
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+	delete(cassettes, path)
+}
+
+func (c *cassette) setVariables(variables map[string]string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Variables = variables
+	return c.save()
+}
+
+func (c *cassette) record(i *interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, i)
+	return c.save()
+}
+
+// save writes the whole cassette, it must be called with the lock held.
+func (c *cassette) save() error {
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, content, 0o600)
+}
+
+// match returns the first interaction recorded for the request that was not replayed yet, so polling
+// the same URL returns the recorded responses in order. Once they are all replayed the last one is
+// returned again, as the number of polls can vary slightly between runs.
+func (c *cassette) match(req recordedRequest) *interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var last *interaction
+	for _, i := range c.Interactions {
+		if i.Request != req {
+			continue
+		}
+		if !i.replayed {
+			i.replayed = true
+			return i
+		}
+		last = i
+	}
+	return last
+}
+
+// cassetteTransport records the requests sent through it along with their responses, or replays
+// previously recorded responses without reaching the network. It must wrap the transport handling
+// authentication so that neither credentials nor authentication challenges are recorded, and so
+// that replaying does not need valid credentials.
+type cassetteTransport struct {
+	base http.RoundTripper
+	mode string
+}
+
+// newCassetteTransport wraps base with a cassetteTransport if the cassette mode environment variable
+// is set, otherwise base is returned unchanged.
+func newCassetteTransport(base http.RoundTripper) (http.RoundTripper, error) {
+	switch mode := os.Getenv(cassetteModeEnvVar); mode {
+	case "":
+		return base, nil
+	case cassetteModeRecord, cassetteModeReplay:
+		return &cassetteTransport{base: base, mode: mode}, nil
+	default:
+		return nil, fmt.Errorf("%s must be either %q or %q, got %q", cassetteModeEnvVar, cassetteModeRecord, cassetteModeReplay, mode)
+	}
+}
+
+// isReplayingCassette reports whether responses are replayed from a cassette instead of sent to Atlas.
+func isReplayingCassette() bool {
+	return os.Getenv(cassetteModeEnvVar) == cassetteModeReplay
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := os.Getenv(cassetteFileEnvVar)
+	if path == "" {
+		return nil, fmt.Errorf("%s must be set when %s is set", cassetteFileEnvVar, cassetteModeEnvVar)
+	}
+	c, err := openCassette(t.mode, path)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err = withReplayableBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := newRecordedRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.mode == cassetteModeReplay {
+		i := c.match(recorded)
+		if i == nil {
+			return nil, fmt.Errorf("cassette %s has no recorded response for %s %s", path, recorded.Method, recorded.URL)
+		}
+		return i.Response.toResponse(req), nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := http.Header{}
+	for _, name := range recordedResponseHeaders {
+		if values := resp.Header.Values(name); len(values) > 0 {
+			header[name] = values
+		}
+	}
+	i := &interaction{
+		Request:  recorded,
+		Response: recordedResponse{StatusCode: resp.StatusCode, Header: header, Body: scrubBody(req.URL.Path, body)},
+	}
+	if err := c.record(i); err != nil {
+		return nil, fmt.Errorf("failed to write cassette %s: %w", path, err)
+	}
+	return resp, nil
+}
+
+// newRecordedRequest identifies a request by its method, path, query and scrubbed body. The host is
+// left out so a cassette can be replayed against any base URL.
+func newRecordedRequest(req *http.Request) (recordedRequest, error) {
+	recorded := recordedRequest{Method: req.Method, URL: req.URL.RequestURI()}
+	if req.GetBody == nil {
+		return recorded, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return recorded, err
+	}
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return recorded, err
+	}
+	recorded.Body = scrubBody(req.URL.Path, content)
+	return recorded, nil
+}
+
+func (r *recordedResponse) toResponse(req *http.Request) *http.Response {
+	header := http.Header{}
+	for name, values := range r.Header {
+		header[name] = append([]string(nil), values...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// scrubBody redacts the values of sensitive keys of a JSON body sent to or received from path. JSON bodies
+// are re-encoded so that equivalent requests are recorded identically, any other body is kept as is.
+func scrubBody(path string, body []byte) string {
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	var pathKeys []string
+	for _, sensitive := range sensitivePathKeys {
+		if sensitive.path.MatchString(path) {
+			pathKeys = append(pathKeys, sensitive.keys...)
+		}
+	}
+	scrubbed, err := json.Marshal(scrubValue(value, pathKeys))
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+func scrubValue(value any, pathKeys []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if isSensitiveKey(key, pathKeys) {
+				v[key] = redactValue(nested)
+				continue
+			}
+			v[key] = scrubValue(nested, pathKeys)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = scrubValue(nested, pathKeys)
+		}
+	}
+	return value
+}
+
+// redactValue redacts a value whatever its type. The type is kept so that replayed bodies can still be decoded.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return redactedValue
+	case float64:
+		return 0
+	case bool:
+		return false
+	case map[string]any:
+		for key, nested := range v {
+			v[key] = redactValue(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+	}
+	return value
+}
+
+func isSensitiveKey(key string, pathKeys []string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	for _, pathKey := range pathKeys {
+		if key == pathKey {
+			return true
+		}
+	}
+	return false
+}
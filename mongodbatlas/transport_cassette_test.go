@@ -0,0 +1,136 @@
+package mongodbatlas
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCassetteTransportRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv(cassetteFileEnvVar, path)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := "CREATING"
+		if atomic.AddInt32(&calls, 1) > 1 {
+			state = "IDLE"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(`{"stateName":"` + state + `","privateKey":"my-private-key"}`))
+	}))
+
+	t.Setenv(cassetteModeEnvVar, cassetteModeRecord)
+	record := newTestCassetteClient(t)
+	doCassettePost(t, record, server.URL+"/api/atlas/v1.0/groups?pretty=true", `{"name":"test","password":"my-password"}`)
+	doCassetteGet(t, record, server.URL+"/api/atlas/v1.0/groups/1")
+	server.Close()
+	closeCassette(path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error reading cassette: %s", err)
+	}
+	for _, secret := range []string{"my-private-key", "my-password", "session"} {
+		if strings.Contains(string(content), secret) {
+			t.Errorf("expected %q to be scrubbed from the cassette, got:\n%s", secret, content)
+		}
+	}
+
+	t.Setenv(cassetteModeEnvVar, cassetteModeReplay)
+	replay := newTestCassetteClient(t)
+	defer closeCassette(path)
+
+	// the request body is matched after scrubbing, and regardless of the host and JSON key order
+	body := doCassettePost(t, replay, "http://localhost/api/atlas/v1.0/groups?pretty=true", `{"password":"other","name":"test"}`)
+	if body != `{"privateKey":"REDACTED","stateName":"CREATING"}` {
+		t.Errorf("unexpected replayed body %s", body)
+	}
+	for i := 0; i < 2; i++ {
+		if body := doCassetteGet(t, replay, "http://localhost/api/atlas/v1.0/groups/1"); !strings.Contains(body, "IDLE") {
+			t.Errorf("expected the recorded IDLE response to be replayed, got %s", body)
+		}
+	}
+	if _, err := replay.Get("http://localhost/api/atlas/v1.0/groups/2"); err == nil || !strings.Contains(err.Error(), "no recorded response") {
+		t.Errorf("expected missing interaction error, got %v", err)
+	}
+	if atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("expected the server to be called only while recording, got %d calls", calls)
+	}
+}
+
+func TestNewCassetteTransport(t *testing.T) {
+	t.Setenv(cassetteModeEnvVar, "")
+	if transport, err := newCassetteTransport(http.DefaultTransport); err != nil || transport != http.DefaultTransport {
+		t.Errorf("expected base transport when the cassette mode is not set, got %v (error %v)", transport, err)
+	}
+
+	t.Setenv(cassetteModeEnvVar, "rewind")
+	if _, err := newCassetteTransport(http.DefaultTransport); err == nil {
+		t.Error("expected error for an unknown cassette mode")
+	}
+}
+
+func TestScrubBody(t *testing.T) {
+	tests := []struct {
+		path     string
+		body     string
+		expected string
+	}{
+		{"/api/atlas/v2/groups/1/databaseUsers", `{"username":"user","password":"pwd"}`, `{"password":"REDACTED","username":"user"}`},
+		{"/api/atlas/v2/groups/1/alertConfigs", `{"notifications":[{"datadogApiKey":"key","serviceKey":"key2"}]}`,
+			`{"notifications":[{"datadogApiKey":"REDACTED","serviceKey":"REDACTED"}]}`},
+		{"/api/atlas/v2/groups/1", `{"clientSecret":"secret","accessToken":"token","delayMin":0}`, `{"accessToken":"REDACTED","clientSecret":"REDACTED","delayMin":0}`},
+		{"/api/atlas/v2/groups/1", `{"passwordChanged":true,"apiKeyCount":3}`, `{"apiKeyCount":0,"passwordChanged":false}`},
+		{"/api/atlas/v2/groups/1", `{"serviceAccountKey":{"id":"1","keys":["a","b"]}}`, `{"serviceAccountKey":{"id":"REDACTED","keys":["REDACTED","REDACTED"]}}`},
+		{"/api/admin/v3.0/groups/1/apps/2/secrets", `{"name":"key","value":"secret"}`, `{"name":"key","value":"REDACTED"}`},
+		{"/api/admin/v3.0/groups/1/apps/2/secrets/3", `{"name":"key","value":"secret"}`, `{"name":"key","value":"REDACTED"}`},
+		{"/api/admin/v3.0/groups/1/apps/2/values/3", `{"name":"key","value":"plain"}`, `{"name":"key","value":"plain"}`},
+		{"/api/atlas/v2/groups/1", `not json`, `not json`},
+	}
+	for _, tt := range tests {
+		if got := scrubBody(tt.path, []byte(tt.body)); got != tt.expected {
+			t.Errorf("scrubBody(%s, %s) = %s, expected %s", tt.path, tt.body, got, tt.expected)
+		}
+	}
+}
+
+func newTestCassetteClient(t *testing.T) *http.Client {
+	t.Helper()
+	transport, err := newCassetteTransport(http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("unexpected error creating transport: %s", err)
+	}
+	return &http.Client{Transport: transport}
+}
+
+func doCassettePost(t *testing.T, client *http.Client, url, body string) string {
+	t.Helper()
+	resp, err := client.Post(url, "application/json", strings.NewReader(body))
+	return readCassetteBody(t, resp, err)
+}
+
+func doCassetteGet(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+	resp, err := client.Get(url)
+	return readCassetteBody(t, resp, err)
+}
+
+func readCassetteBody(t *testing.T, resp *http.Response, err error) string {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %s", err)
+	}
+	return string(body)
+}
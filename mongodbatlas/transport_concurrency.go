@@ -0,0 +1,158 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// projectPathRegex matches the project ID in the path of the Atlas and App Services APIs.
+var projectPathRegex = regexp.MustCompile(`/groups/([^/]+)`)
+
+// concurrencyLimitTransport caps the number of requests sent to Atlas at the same time, in total and for each project,
+// to avoid the rate limiting of large plans refreshing many resources in parallel. A limit that is not positive
+// means no limit.
+type concurrencyLimitTransport struct {
+	base       http.RoundTripper
+	global     chan struct{}
+	projects   map[string]chan struct{}
+	mu         sync.Mutex
+	perProject int
+}
+
+// newConcurrencyLimitTransport wraps base with a concurrencyLimitTransport, if neither limit is positive base is
+// returned unchanged.
+func newConcurrencyLimitTransport(base http.RoundTripper, maxConcurrent, maxConcurrentPerProject int) http.RoundTripper {
+	if maxConcurrent <= 0 && maxConcurrentPerProject <= 0 {
+		return base
+	}
+	t := &concurrencyLimitTransport{
+		base:       base,
+		projects:   make(map[string]chan struct{}),
+		perProject: maxConcurrentPerProject,
+	}
+	if maxConcurrent > 0 {
+		t.global = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	// the project slot is taken first so requests waiting for a busy project don't hold global slots
+	if project := t.projectSemaphore(req); project != nil {
+		if err := acquire(ctx, project); err != nil {
+			return nil, closeRequestBody(req, err)
+		}
+		defer release(project)
+	}
+	if t.global != nil {
+		if err := acquire(ctx, t.global); err != nil {
+			return nil, closeRequestBody(req, err)
+		}
+		defer release(t.global)
+	}
+	return t.base.RoundTrip(req)
+}
+
+func (t *concurrencyLimitTransport) projectSemaphore(req *http.Request) chan struct{} {
+	if t.perProject <= 0 {
+		return nil
+	}
+	match := projectPathRegex.FindStringSubmatch(req.URL.Path)
+	if match == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	semaphore, ok := t.projects[match[1]]
+	if !ok {
+		semaphore = make(chan struct{}, t.perProject)
+		t.projects[match[1]] = semaphore
+	}
+	return semaphore
+}
+
+func acquire(ctx context.Context, semaphore chan struct{}) error {
+	select {
+	case semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func release(semaphore chan struct{}) {
+	<-semaphore
+}
+
+func closeRequestBody(req *http.Request, err error) error {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return err
+}
+
+// coalescingTransport sends a single request for identical GET requests in flight at the same time, and gives each
+// caller its own copy of the response. A refresh of many resources of the same project often reads the same Atlas
+// endpoint concurrently.
+type coalescingTransport struct {
+	base  http.RoundTripper
+	group singleflight.Group
+}
+
+// sharedResponse is the response of a coalesced request, its body is read once and copied for each caller.
+type sharedResponse struct {
+	resp *http.Response
+	body []byte
+}
+
+func newCoalescingTransport(base http.RoundTripper) http.RoundTripper {
+	return &coalescingTransport{base: base}
+}
+
+func (t *coalescingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Body != nil && req.Body != http.NoBody {
+		return t.base.RoundTrip(req)
+	}
+
+	key := req.URL.String() + "\n" + req.Header.Get("Accept")
+	results := t.group.DoChan(key, func() (any, error) {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &sharedResponse{resp: resp, body: body}, nil
+	})
+
+	select {
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	case r := <-results:
+		if r.Err != nil && req.Context().Err() == nil && (errors.Is(r.Err, context.Canceled) || errors.Is(r.Err, context.DeadlineExceeded)) {
+			// the request was sent on behalf of a caller that stopped waiting for it
+			return t.base.RoundTrip(req)
+		}
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		shared := r.Val.(*sharedResponse)
+		resp := *shared.resp
+		resp.Header = shared.resp.Header.Clone()
+		resp.Body = io.NopCloser(bytes.NewReader(shared.body))
+		resp.Request = req
+		return &resp, nil
+	}
+}
@@ -0,0 +1,140 @@
+package mongodbatlas
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrencyLimitTransport(t *testing.T) {
+	tests := []struct {
+		name                    string
+		paths                   []string
+		maxConcurrent           int
+		maxConcurrentPerProject int
+		expectedMax             int32
+	}{
+		{
+			name:          "overall limit",
+			paths:         []string{"/groups/1/clusters", "/groups/2/clusters", "/orgs/1/users"},
+			maxConcurrent: 2,
+			expectedMax:   2,
+		},
+		{
+			name:                    "limit per project",
+			paths:                   []string{"/groups/1/clusters", "/groups/1/databaseUsers"},
+			maxConcurrentPerProject: 1,
+			expectedMax:             1,
+		},
+		{
+			name:                    "projects are limited separately",
+			paths:                   []string{"/groups/1/clusters", "/groups/2/clusters"},
+			maxConcurrentPerProject: 1,
+			expectedMax:             2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var inFlight, maxInFlight int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					highest := atomic.LoadInt32(&maxInFlight)
+					if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newConcurrencyLimitTransport(http.DefaultTransport, tt.maxConcurrent, tt.maxConcurrentPerProject)}
+			var wg sync.WaitGroup
+			for i := 0; i < 4; i++ {
+				for _, path := range tt.paths {
+					wg.Add(1)
+					go func(path string) {
+						defer wg.Done()
+						resp, err := client.Post(server.URL+"/api/atlas/v1.0"+path, "application/json", nil)
+						if err != nil {
+							t.Errorf("unexpected error: %s", err)
+							return
+						}
+						resp.Body.Close()
+					}(path)
+				}
+			}
+			wg.Wait()
+
+			if maxInFlight != tt.expectedMax {
+				t.Errorf("expected at most %d requests in flight, got %d", tt.expectedMax, maxInFlight)
+			}
+		})
+	}
+}
+
+func TestCoalescingTransport(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Method == http.MethodGet {
+			<-release
+		}
+		_, _ = w.Write([]byte(`{"results":[]}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newCoalescingTransport(http.DefaultTransport)}
+	const requests = 5
+	bodies := make(chan string, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL + "/api/atlas/v1.0/groups/1/accessList")
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			bodies <- string(body)
+		}()
+	}
+	// wait for the first request to reach the server before letting it respond
+	for atomic.LoadInt32(&calls) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(bodies)
+
+	for body := range bodies {
+		if body != `{"results":[]}` {
+			t.Errorf("expected every caller to get the whole body, got %q", body)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected concurrent GETs to be coalesced into 1 request, got %d", calls)
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Post(server.URL+"/api/atlas/v1.0/groups/1/accessList", "application/json", strings.NewReader("[]"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+	if calls != 3 {
+		t.Errorf("expected POST requests not to be coalesced, got %d calls", calls)
+	}
+}
@@ -0,0 +1,88 @@
+package mongodbatlas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type resourceNameCtxKey struct{}
+
+// ReadOnlyError is returned for every request other than a GET sent by a provider configured with read_only = true.
+type ReadOnlyError struct {
+	// Resource is the type of the resource sending the request, empty if it's not known.
+	Resource string
+	Method   string
+	Path     string
+}
+
+func (e *ReadOnlyError) Error() string {
+	resource := e.Resource
+	if resource == "" {
+		resource = "the provider"
+	}
+	return fmt.Sprintf("%s attempted to send %s %s but the provider is configured with read_only = true, only GET requests are allowed", resource, e.Method, e.Path)
+}
+
+// readOnlyTransport rejects any request that could change Atlas, so a read-only provider is safe to run even with keys
+// that have write permissions.
+type readOnlyTransport struct {
+	base http.RoundTripper
+}
+
+// newReadOnlyTransport wraps base with a readOnlyTransport if readOnly is true, otherwise base is returned unchanged.
+func newReadOnlyTransport(base http.RoundTripper, readOnly bool) http.RoundTripper {
+	if !readOnly {
+		return base
+	}
+	return &readOnlyTransport{base: base}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, &ReadOnlyError{
+		Resource: resourceNameFromContext(req.Context()),
+		Method:   req.Method,
+		Path:     req.URL.Path,
+	}
+}
+
+// contextWithResourceName returns a copy of ctx identifying the resource sending the requests.
+func contextWithResourceName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, resourceNameCtxKey{}, name)
+}
+
+func resourceNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(resourceNameCtxKey{}).(string)
+	return name
+}
+
+// addResourceNameToContext wraps the operations of the SDKv2 resources that can change Atlas, so the requests they send
+// can be traced back to the resource.
+func addResourceNameToContext(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		r.CreateContext = withResourceName(name, r.CreateContext)
+		r.CreateWithoutTimeout = withResourceName(name, r.CreateWithoutTimeout)
+		r.UpdateContext = withResourceName(name, r.UpdateContext)
+		r.UpdateWithoutTimeout = withResourceName(name, r.UpdateWithoutTimeout)
+		r.DeleteContext = withResourceName(name, r.DeleteContext)
+		r.DeleteWithoutTimeout = withResourceName(name, r.DeleteWithoutTimeout)
+	}
+}
+
+func withResourceName[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](name string, f F) F {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return f(contextWithResourceName(ctx, name), d, meta)
+	}
+}
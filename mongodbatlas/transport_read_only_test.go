@@ -0,0 +1,71 @@
+package mongodbatlas
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newReadOnlyTransport(http.DefaultTransport, true)}
+	resp, err := client.Get(server.URL + "/api/atlas/v1.0/groups")
+	if err != nil {
+		t.Fatalf("unexpected error for GET: %s", err)
+	}
+	resp.Body.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete} {
+		ctx := contextWithResourceName(context.Background(), "mongodbatlas_cluster")
+		req, _ := http.NewRequestWithContext(ctx, method, server.URL+"/api/atlas/v1.0/groups/1/clusters", strings.NewReader("{}"))
+		_, err := client.Do(req) //nolint:bodyclose // no response is returned on error
+		var readOnlyErr *ReadOnlyError
+		if !errors.As(err, &readOnlyErr) {
+			t.Fatalf("expected read-only error for %s, got %v", method, err)
+		}
+		expected := "mongodbatlas_cluster attempted to send " + method + " /api/atlas/v1.0/groups/1/clusters"
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got %s", expected, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected only the GET request to reach the server, got %d calls", calls)
+	}
+
+	if transport := newReadOnlyTransport(http.DefaultTransport, false); transport != http.DefaultTransport {
+		t.Errorf("expected base transport when not read-only, got %v", transport)
+	}
+}
+
+func TestAddResourceNameToContext(t *testing.T) {
+	var name string
+	resources := map[string]*schema.Resource{
+		"mongodbatlas_cluster": {
+			CreateContext: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				name = resourceNameFromContext(ctx)
+				return nil
+			},
+		},
+	}
+	addResourceNameToContext(resources)
+
+	resources["mongodbatlas_cluster"].CreateContext(context.Background(), nil, nil)
+	if name != "mongodbatlas_cluster" {
+		t.Errorf("expected the resource name in the context, got %q", name)
+	}
+	if resources["mongodbatlas_cluster"].UpdateContext != nil {
+		t.Error("expected undefined operations to stay undefined")
+	}
+}
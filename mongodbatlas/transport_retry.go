@@ -0,0 +1,190 @@
+package mongodbatlas
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 4
+	retryMinBackoff   = 1 * time.Second
+	retryMaxBackoff   = 30 * time.Second
+)
+
+// retryTransport replays idempotent requests that Atlas rejected because of rate limiting (429) or
+// temporary unavailability (503). The Retry-After header is honored when present, otherwise an
+// exponential backoff with jitter is used between attempts. Responses asking to wait longer than the
+// maximum backoff are returned as they are.
+type retryTransport struct {
+	base       http.RoundTripper
+	sleep      func(ctx context.Context, d time.Duration) error
+	now        func() time.Time
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	maxRetries int
+}
+
+// newRetryTransport wraps base with a retryTransport allowing up to maxRetries retries per request.
+// If maxRetries is not positive base is returned unchanged.
+func newRetryTransport(base http.RoundTripper, maxRetries int) http.RoundTripper {
+	if maxRetries <= 0 {
+		return base
+	}
+	return &retryTransport{
+		base:       base,
+		sleep:      sleepWithContext,
+		now:        time.Now,
+		minBackoff: retryMinBackoff,
+		maxBackoff: retryMaxBackoff,
+		maxRetries: maxRetries,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotentMethod(req.Method) {
+		return t.base.RoundTrip(req)
+	}
+
+	req, err := withReplayableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+
+		wait, ok := t.backoff(attempt, resp)
+		if !ok {
+			log.Printf("[WARN] %s %s returned %d with Retry-After %q, which exceeds the maximum wait of %s, not retrying",
+				req.Method, req.URL.Path, resp.StatusCode, resp.Header.Get("Retry-After"), t.maxBackoff)
+			return resp, nil
+		}
+		log.Printf("[WARN] %s %s returned %d, retrying in %s (retry %d of %d)", req.Method, req.URL.Path, resp.StatusCode, wait, attempt+1, t.maxRetries)
+		drainAndClose(resp)
+
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewindBody(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt. A valid Retry-After header takes precedence
+// over the computed exponential backoff. It returns false when Retry-After asks for a longer wait than the
+// maximum backoff, as retrying any earlier would only be rejected again.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), t.now()); ok {
+		return wait, wait <= t.maxBackoff
+	}
+
+	backoff := t.maxBackoff
+	if attempt < 32 {
+		if exp := t.minBackoff << uint(attempt); exp > 0 && exp < t.maxBackoff {
+			backoff = exp
+		}
+	}
+
+	// equal jitter: wait at least half of the backoff so retries never fire in a tight loop
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)), true //nolint:gosec // jitter does not need a secure source
+}
+
+// parseRetryAfter supports both formats allowed by RFC 9110: delay in seconds and HTTP-date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+}
+
+// withReplayableBody makes sure the request body can be read again for each attempt.
+func withReplayableBody(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return req, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	newReq := req.Clone(req.Context())
+	newReq.Body = io.NopCloser(bytes.NewReader(body))
+	newReq.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return newReq, nil
+}
+
+func rewindBody(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	newReq := req.Clone(req.Context())
+	newReq.Body = body
+	return newReq, nil
+}
+
+func drainAndClose(resp *http.Response) {
+	const maxDrainSize = 4 << 10
+	_, _ = io.CopyN(io.Discard, resp.Body, maxDrainSize)
+	resp.Body.Close()
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,199 @@
+package mongodbatlas
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryTransport(maxRetries int, waits *[]time.Duration) *retryTransport {
+	return &retryTransport{
+		base: http.DefaultTransport,
+		sleep: func(ctx context.Context, d time.Duration) error {
+			*waits = append(*waits, d)
+			return nil
+		},
+		now:        time.Now,
+		minBackoff: retryMinBackoff,
+		maxBackoff: retryMaxBackoff,
+		maxRetries: maxRetries,
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		body          string
+		retryAfter    string
+		failures      int32
+		maxRetries    int
+		expectedCalls int32
+		expectedCode  int
+	}{
+		{
+			name:          "GET succeeds after rate limiting",
+			method:        http.MethodGet,
+			failures:      2,
+			maxRetries:    4,
+			expectedCalls: 3,
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "PUT body is replayed",
+			method:        http.MethodPut,
+			body:          `{"name":"test"}`,
+			failures:      1,
+			maxRetries:    4,
+			expectedCalls: 2,
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "POST is never replayed",
+			method:        http.MethodPost,
+			body:          `{"name":"test"}`,
+			failures:      1,
+			maxRetries:    4,
+			expectedCalls: 1,
+			expectedCode:  http.StatusTooManyRequests,
+		},
+		{
+			name:          "retry budget is exhausted",
+			method:        http.MethodDelete,
+			failures:      10,
+			maxRetries:    2,
+			expectedCalls: 3,
+			expectedCode:  http.StatusTooManyRequests,
+		},
+		{
+			name:          "Retry-After beyond the maximum backoff is not retried",
+			method:        http.MethodGet,
+			retryAfter:    "3600",
+			failures:      1,
+			maxRetries:    4,
+			expectedCalls: 1,
+			expectedCode:  http.StatusTooManyRequests,
+		},
+		{
+			name:          "Retry-After header is honored",
+			method:        http.MethodGet,
+			retryAfter:    "7",
+			failures:      1,
+			maxRetries:    1,
+			expectedCalls: 2,
+			expectedCode:  http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tt.body {
+					t.Errorf("unexpected body %q in call %d", body, calls)
+				}
+				if atomic.AddInt32(&calls, 1) <= tt.failures {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			var waits []time.Duration
+			client := &http.Client{Transport: newTestRetryTransport(tt.maxRetries, &waits)}
+
+			req, err := http.NewRequest(tt.method, server.URL, io.NopCloser(strings.NewReader(tt.body)))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedCode {
+				t.Errorf("expected status %d, got %d", tt.expectedCode, resp.StatusCode)
+			}
+			if calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+			for _, wait := range waits {
+				if tt.retryAfter != "" && wait != 7*time.Second {
+					t.Errorf("expected Retry-After wait of 7s, got %s", wait)
+				}
+				if wait < retryMinBackoff/2 || wait > retryMaxBackoff {
+					t.Errorf("wait %s out of bounds", wait)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportRetryAfterTooLong(t *testing.T) {
+	transport := newTestRetryTransport(1, &[]time.Duration{})
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	if wait, ok := transport.backoff(0, resp); ok {
+		t.Errorf("expected no retry for a Retry-After beyond %s, got a wait of %s", retryMaxBackoff, wait)
+	}
+	resp.Header.Set("Retry-After", "30")
+	if wait, ok := transport.backoff(0, resp); !ok || wait != retryMaxBackoff {
+		t.Errorf("expected a retry after %s, got %s (retry: %t)", retryMaxBackoff, wait, ok)
+	}
+}
+
+func TestRetryTransportContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	transport := newRetryTransport(http.DefaultTransport, 3).(*retryTransport)
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		cancel()
+		return sleepWithContext(ctx, d)
+	}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Fatal("expected error after context cancellation")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, time.October, 18, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"10", 10 * time.Second, true},
+		{"-1", 0, false},
+		{"Wed, 18 Oct 2023 10:00:30 GMT", 30 * time.Second, true},
+		{"Wed, 18 Oct 2023 09:59:30 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		wait, ok := parseRetryAfter(tt.value, now)
+		if wait != tt.expected || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = (%s, %v); want (%s, %v)", tt.value, wait, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestNewRetryTransportDisabled(t *testing.T) {
+	if transport := newRetryTransport(http.DefaultTransport, 0); transport != http.DefaultTransport {
+		t.Errorf("expected base transport to be returned when retries are disabled, got %T", transport)
+	}
+}
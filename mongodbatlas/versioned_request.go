@@ -0,0 +1,24 @@
+package mongodbatlas
+
+import (
+	"context"
+
+	matlas "go.mongodb.org/atlas/mongodbatlas"
+)
+
+// doVersionedRequest sends a request to an endpoint of the versioned Atlas Admin API that the clients used by the
+// provider don't implement yet. mediaType selects the version of the endpoint, body is sent as JSON if it isn't nil
+// and the response is decoded in v if it isn't nil.
+func doVersionedRequest(ctx context.Context, conn *matlas.Client, mediaType, method, path string, body, v any) error {
+	req, err := conn.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", mediaType)
+	if body != nil {
+		req.Header.Set("Content-Type", mediaType)
+	}
+
+	_, err = conn.Do(ctx, req, v)
+	return err
+}